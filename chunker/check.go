@@ -0,0 +1,299 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// maxProblems is the number of type mismatches and malformed inputs listed by the report of a
+// Checker. The others are only counted.
+const maxProblems = 20
+
+// Checker checks the data files of the loaders against a DQL schema, for their dry runs. It counts
+// the NQuads of each predicate, and keeps the type mismatches, the predicates missing from the
+// schema and the malformed input found, without loading anything.
+type Checker struct {
+	sync.Mutex
+	// namespace is forced on the NQuads, unless it is math.MaxUint64.
+	namespace  uint64
+	schema     map[string]*pb.SchemaUpdate
+	namespaces map[uint64]struct{}
+	preds      map[string]*predicateCount
+	// transform is applied to the NQuads before they are checked, if set.
+	transform *Transform
+
+	mismatches []string
+	malformed  []string
+	nMismatch  int
+	nMalformed int
+}
+
+type predicateCount struct {
+	nquads     uint64
+	mismatches uint64
+	unknown    bool
+}
+
+// NewChecker returns a Checker for the schema, parsed with the namespace forced on the NQuads, or
+// math.MaxUint64 to keep their namespace.
+func NewChecker(sch *schema.ParsedSchema, namespace uint64) *Checker {
+	c := &Checker{
+		namespace:  namespace,
+		schema:     make(map[string]*pb.SchemaUpdate),
+		namespaces: make(map[uint64]struct{}),
+		preds:      make(map[string]*predicateCount),
+	}
+	for _, su := range sch.Preds {
+		c.schema[su.Predicate] = su
+	}
+	return c
+}
+
+// SetTransform sets the transform applied to the NQuads before they are checked, as the loaders
+// apply it before loading them.
+func (c *Checker) SetTransform(t *Transform) {
+	c.transform = t
+}
+
+// CheckFile parses the file with the chunker, and checks its NQuads. The malformed chunks of RDF
+// files are parsed on after the malformed lines, so that all of them are reported.
+func (c *Checker) CheckFile(file string, r *bufio.Reader, ck Chunker) {
+	nqs := ck.NQuads()
+	nqs.SetJSONPredicates(c.isJSON)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for batch := range nqs.Ch() {
+			if c.transform != nil {
+				var err error
+				if batch, err = c.transform.Apply(batch); err != nil {
+					c.addMalformed(file, 0, err)
+				}
+			}
+			for _, nq := range batch {
+				c.check(file, nq)
+			}
+		}
+	}()
+
+	_, lines := ck.(*rdfChunker)
+	line := 1
+	for {
+		chunk, err := ck.Chunk(r)
+		if err != nil && err != io.EOF {
+			c.addMalformed(file, 0, err)
+			break
+		}
+		var data []byte
+		if chunk != nil {
+			data = chunk.Bytes()
+		}
+		for len(data) > 0 {
+			before := chunk.Len()
+			perr := ck.Parse(chunk)
+			if perr == nil {
+				break
+			}
+			if !lines {
+				c.addMalformed(file, 0, perr)
+				break
+			}
+			// The line in error is the last line read from the chunk.
+			read := bytes.TrimSuffix(data[:len(data)-chunk.Len()], []byte{'\n'})
+			c.addMalformed(file, line+bytes.Count(read, []byte{'\n'}), perr)
+			if chunk.Len() == 0 || chunk.Len() == before {
+				break
+			}
+		}
+		line += bytes.Count(data, []byte{'\n'})
+		if err == io.EOF {
+			break
+		}
+	}
+	nqs.Flush()
+	<-done
+}
+
+// isJSON returns whether the predicate is of type json in the schema.
+func (c *Checker) isJSON(namespace uint64, pred string) bool {
+	if c.namespace != math.MaxUint64 {
+		namespace = c.namespace
+	}
+	su, ok := c.schema[x.NamespaceAttr(namespace, pred)]
+	return ok && types.TypeID(su.ValueType) == types.JSONID
+}
+
+func (c *Checker) check(file string, nq *api.NQuad) {
+	if c.namespace != math.MaxUint64 {
+		nq.Namespace = c.namespace
+	}
+	attr := x.NamespaceAttr(nq.Namespace, nq.Predicate)
+
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.namespaces[nq.Namespace]; !ok {
+		c.namespaces[nq.Namespace] = struct{}{}
+		for _, su := range schema.CompleteInitialSchema(nq.Namespace) {
+			if _, ok := c.schema[su.Predicate]; !ok {
+				c.schema[su.Predicate] = su
+			}
+		}
+	}
+	pc, ok := c.preds[attr]
+	if !ok {
+		pc = &predicateCount{}
+		c.preds[attr] = pc
+	}
+	pc.nquads++
+
+	su, ok := c.schema[attr]
+	if !ok {
+		pc.unknown = true
+		return
+	}
+	if err := typeCheck(nq, su); err != nil {
+		pc.mismatches++
+		c.nMismatch++
+		if len(c.mismatches) < maxProblems {
+			c.mismatches = append(c.mismatches, fmt.Sprintf("%s: <%s> <%s>: %v", file,
+				nq.Subject, nq.Predicate, err))
+		}
+	}
+}
+
+// typeCheck returns why the NQuad doesn't match the schema of its predicate, if it doesn't.
+func typeCheck(nq *api.NQuad, su *pb.SchemaUpdate) error {
+	schemaType := types.TypeID(su.ValueType)
+	if nq.ObjectValue == nil {
+		if schemaType != types.UidID && schemaType != types.DefaultID {
+			return fmt.Errorf("the object is a node, but the predicate is of type %s",
+				schemaType.Name())
+		}
+		return nil
+	}
+	if schemaType == types.UidID {
+		return errors.New("the object is a value, but the predicate is of type uid")
+	}
+	if nq.Lang != "" && !su.Lang {
+		return errors.New("the value has a language tag, but the predicate has no @lang")
+	}
+	storage, err := types.StorageValue(nq.ObjectValue)
+	if err != nil {
+		return err
+	}
+	if schemaType == types.DefaultID {
+		return nil
+	}
+	if _, err := types.Convert(storage, schemaType); err != nil {
+		return fmt.Errorf("the value isn't a valid %s: %w", schemaType.Name(), err)
+	}
+	return nil
+}
+
+func (c *Checker) addMalformed(file string, line int, err error) {
+	c.Lock()
+	defer c.Unlock()
+	c.nMalformed++
+	if len(c.malformed) >= maxProblems {
+		return
+	}
+	if line > 0 {
+		c.malformed = append(c.malformed, fmt.Sprintf("%s:%d: %v", file, line, err))
+	} else {
+		c.malformed = append(c.malformed, fmt.Sprintf("%s: %v", file, err))
+	}
+}
+
+// Failed returns whether type mismatches or malformed input have been found. The predicates
+// missing from the schema aren't failures, as their type is guessed by the loaders.
+func (c *Checker) Failed() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.nMismatch > 0 || c.nMalformed > 0
+}
+
+// Report writes the counts of the NQuads of each predicate, and the problems found.
+func (c *Checker) Report(w io.Writer) error {
+	c.Lock()
+	defer c.Unlock()
+
+	attrs := make([]string, 0, len(c.preds))
+	var nquads uint64
+	var unknown []string
+	for attr, pc := range c.preds {
+		attrs = append(attrs, attr)
+		nquads += pc.nquads
+		if pc.unknown {
+			unknown = append(unknown, attr)
+		}
+	}
+	sort.Strings(attrs)
+	sort.Strings(unknown)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "Predicate\tN-Quads\tType mismatches\t")
+	for _, attr := range attrs {
+		pc := c.preds[attr]
+		note := ""
+		if pc.unknown {
+			note = "not in the schema"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", formatAttr(attr), pc.nquads, pc.mismatches, note)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	list := func(title string, items []string, total int) {
+		if total == 0 {
+			return
+		}
+		fmt.Fprintf(w, "\n%s:\n", title)
+		for _, item := range items {
+			fmt.Fprintf(w, "  %s\n", item)
+		}
+		if total > len(items) {
+			fmt.Fprintf(w, "  ... and %d more\n", total-len(items))
+		}
+	}
+	unknownNames := make([]string, 0, len(unknown))
+	for _, attr := range unknown {
+		unknownNames = append(unknownNames, formatAttr(attr))
+	}
+	list("Predicates not in the schema", unknownNames, len(unknownNames))
+	list("Type mismatches", c.mismatches, c.nMismatch)
+	list("Malformed input", c.malformed, c.nMalformed)
+
+	_, err := fmt.Fprintf(w, "\nN-Quads: %d, predicates: %d, not in the schema: %d, "+
+		"type mismatches: %d, malformed: %d\n", nquads, len(attrs), len(unknown), c.nMismatch,
+		c.nMalformed)
+	return err
+}
+
+// formatAttr formats the predicate with its namespace, unless it's the root namespace.
+func formatAttr(attr string) string {
+	ns, name := x.ParseNamespaceAttr(attr)
+	if ns == x.RootNamespace {
+		return name
+	}
+	return fmt.Sprintf("[%#x] %s", ns, name)
+}
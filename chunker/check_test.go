@@ -0,0 +1,91 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/schema"
+)
+
+func TestChecker(t *testing.T) {
+	sch, err := schema.ParseWithNamespace(`
+		name: string @lang .
+		age: int .
+		friend: [uid] .
+	`, 0)
+	require.NoError(t, err)
+
+	data := `<a> <name> "Alice" .
+<a> <age> "30" .
+<a> <age> "thirty" .
+<a> <friend> <b> .
+<a> <friend> "b" .
+<b> <nick> "Bobby" .
+<b> <name> "Bob" .
+this is not rdf
+<b> <dgraph.type> "Person" .
+<b> <age> <c> .
+<b> <name> "Robert"@fr
+`
+	c := NewChecker(sch, 0)
+	c.CheckFile("data.rdf", bufioReader(data), NewChunker(RdfFormat, 2))
+	require.True(t, c.Failed())
+
+	var out strings.Builder
+	require.NoError(t, c.Report(&out))
+	report := out.String()
+	for _, s := range []string{
+		"age          3        2",
+		"name         2        0",
+		"nick         1        0                not in the schema",
+		"Predicates not in the schema:\n  nick\n",
+		`data.rdf: <a> <age>: the value isn't a valid int: strconv.ParseInt: parsing "thirty"`,
+		"data.rdf: <a> <friend>: the object is a value, but the predicate is of type uid",
+		"data.rdf: <b> <age>: the object is a node, but the predicate is of type int",
+		"data.rdf:8: while parsing line \"this is not rdf\\n\"",
+		"data.rdf:11: while parsing line \"<b> <name> \\\"Robert\\\"@fr\\n\"",
+		"N-Quads: 9, predicates: 5, not in the schema: 1, type mismatches: 3, malformed: 2",
+	} {
+		require.Contains(t, report, s)
+	}
+}
+
+func TestCheckerNamespaces(t *testing.T) {
+	sch, err := schema.Parse("[0x2] name: string .")
+	require.NoError(t, err)
+
+	data := `<a> <name> "Alice" <0x2> .
+<a> <name> "Bob" .
+`
+	c := NewChecker(sch, math.MaxUint64)
+	c.CheckFile("data.rdf", bufioReader(data), NewChunker(RdfFormat, 10))
+	require.False(t, c.Failed())
+
+	var out strings.Builder
+	require.NoError(t, c.Report(&out))
+	require.Contains(t, out.String(), "Predicates not in the schema:\n  name\n")
+	require.Contains(t, out.String(), "[0x2] name")
+}
+
+func TestCheckerJSON(t *testing.T) {
+	sch, err := schema.ParseWithNamespace("age: int .", 0)
+	require.NoError(t, err)
+
+	c := NewChecker(sch, 0)
+	c.CheckFile("data.json", bufioReader(`[{"age": 30}, {"age": "x"}]`), NewChunker(JsonFormat, 10))
+	var out strings.Builder
+	require.NoError(t, c.Report(&out))
+	require.Contains(t, out.String(), "type mismatches: 1, malformed: 0")
+
+	c = NewChecker(sch, 0)
+	c.CheckFile("data.json", bufioReader(`[{"age": }]`), NewChunker(JsonFormat, 10))
+	require.True(t, c.Failed())
+}
@@ -34,6 +34,8 @@ type Chunker interface {
 type rdfChunker struct {
 	lexer *lex.Lexer
 	nqs   *NQuadBuffer
+	// graphs maps the graph labels which aren't namespace IDs. Without it, they are invalid.
+	graphs *GraphMapping
 }
 
 func (rc *rdfChunker) NQuads() *NQuadBuffer {
@@ -59,6 +61,18 @@ const (
 	RdfFormat
 	// JsonFormat is a constant to denote the input to the live/bulk loader is in the JSON format.
 	JsonFormat
+	// CsvFormat is a constant to denote the input to the live/bulk loader is in the CSV or TSV
+	// format. Its chunkers are created by NewCSVChunker.
+	CsvFormat
+	// ParquetFormat is a constant to denote the input to the live/bulk loader is in the Parquet
+	// format. Its chunkers are created by NewParquetChunker.
+	ParquetFormat
+	// TurtleFormat is a constant to denote the input to the live/bulk loader is in the Turtle or
+	// TriG format. Its chunkers are created by NewTurtleChunker to map the named graphs.
+	TurtleFormat
+	// JsonldFormat is a constant to denote the input to the live/bulk loader is in the JSON-LD
+	// format. Its chunkers are created by NewJSONLDChunker to map the named graphs.
+	JsonldFormat
 )
 
 // NewChunker returns a new chunker for the specified format.
@@ -73,12 +87,32 @@ func NewChunker(inputFormat InputFormat, batchSize int) Chunker {
 		return &jsonChunker{
 			nqs: NewNQuadBuffer(batchSize),
 		}
+	case CsvFormat:
+		x.Panic(errors.New("CSV input needs a mapping, use NewCSVChunker"))
+		return nil
+	case ParquetFormat:
+		x.Panic(errors.New("Parquet input needs a mapping, use NewParquetChunker"))
+		return nil
+	case TurtleFormat:
+		return NewTurtleChunker(nil, batchSize)
+	case JsonldFormat:
+		return NewJSONLDChunker(nil, batchSize)
 	default:
 		x.Panic(errors.New("unknown input format"))
 		return nil
 	}
 }
 
+// NewRDFChunker returns a new chunker for N-Quads, whose graph labels which aren't namespace IDs
+// are mapped by graphs.
+func NewRDFChunker(graphs *GraphMapping, batchSize int) Chunker {
+	return &rdfChunker{
+		nqs:    NewNQuadBuffer(batchSize),
+		lexer:  &lex.Lexer{},
+		graphs: graphs,
+	}
+}
+
 // Chunk reads the input line by line until one of the following 3 conditions happens
 // 1) the EOF is reached
 // 2) 1e5 lines have been read
@@ -131,18 +165,29 @@ func (rc *rdfChunker) Parse(chunkBuf *bytes.Buffer) error {
 		return nil
 	}
 
+	// links holds the subjects linked to their graph in this chunk.
+	links := make(map[string]struct{})
 	for chunkBuf.Len() > 0 {
 		str, err := chunkBuf.ReadString('\n')
 		if err != nil && err != io.EOF {
 			x.Check(err)
 		}
 
-		nq, err := ParseRDF(str, rc.lexer)
+		nq, graph, err := parseRDF(str, rc.lexer)
+		if err == nil && graph != "" && rc.graphs == nil {
+			err = fmt.Errorf("invalid namespace ID. Input: [%s]", strings.TrimSpace(str))
+		}
 		switch {
 		case err == ErrEmpty:
 			continue // blank line or comment
 		case err != nil:
 			return fmt.Errorf("while parsing line %q: %w", str, err)
+		case graph != "":
+			link := rc.graphs.apply(&nq, graph, links)
+			rc.nqs.Push(&nq)
+			if link != nil {
+				rc.nqs.Push(link)
+			}
 		default:
 			rc.nqs.Push(&nq)
 		}
@@ -397,16 +442,27 @@ func IsJSONData(r *bufio.Reader) (bool, error) {
 	return err == nil, nil
 }
 
-// DataFormat returns a file's data format (RDF, JSON, or unknown) based on the filename
-// or the user-provided format option. The file extension has precedence.
+// DataFormat returns a file's data format (RDF, JSON, CSV, Parquet, Turtle, JSON-LD or unknown)
+// based on the filename or the user-provided format option. The file extension has precedence.
 func DataFormat(filename string, format string) InputFormat {
 	format = strings.ToLower(format)
 	filename = strings.TrimSuffix(strings.ToLower(filename), ".gz")
 	switch {
-	case strings.HasSuffix(filename, ".rdf") || format == "rdf":
+	case strings.HasSuffix(filename, ".rdf") || strings.HasSuffix(filename, ".nq") ||
+		strings.HasSuffix(filename, ".nt") || format == "rdf":
 		return RdfFormat
 	case strings.HasSuffix(filename, ".json") || format == "json":
 		return JsonFormat
+	case strings.HasSuffix(filename, ".csv") || strings.HasSuffix(filename, ".tsv") ||
+		format == "csv" || format == "tsv":
+		return CsvFormat
+	case strings.HasSuffix(filename, ".parquet") || format == "parquet":
+		return ParquetFormat
+	case strings.HasSuffix(filename, ".ttl") || strings.HasSuffix(filename, ".trig") ||
+		format == "turtle" || format == "trig":
+		return TurtleFormat
+	case strings.HasSuffix(filename, ".jsonld") || format == "jsonld":
+		return JsonldFormat
 	default:
 		return UnknownFormat
 	}
@@ -0,0 +1,213 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+
+	"github.com/hypermodeinc/dgraph/v25/lex"
+)
+
+func (f *FileMapping) delimiter(file string) rune {
+	if f.Delimiter != "" {
+		r, _ := utf8.DecodeRuneInString(f.Delimiter)
+		return r
+	}
+	if strings.HasSuffix(strings.TrimSuffix(strings.ToLower(file), ".gz"), ".tsv") {
+		return '\t'
+	}
+	return ','
+}
+
+// csvChunkPrefix starts the chunks of CSV records, followed by the index of the entry of the
+// mapping and by the delimiter. The header record comes next, so that each chunk can be parsed
+// on its own.
+const csvChunkPrefix = "#csv:"
+
+type csvChunker struct {
+	nqs     *NQuadBuffer
+	mapping *Mapping
+
+	file   string
+	entry  int
+	comma  rune
+	src    *bufio.Reader
+	reader *csv.Reader
+	header []string
+
+	// Chunks which weren't made by Chunk, like the GraphQL schema sent by the bulk loader, are
+	// parsed as RDF.
+	rdf *rdfChunker
+}
+
+// NewCSVChunker returns a new chunker for the CSV or TSV file, read according to its entry of the
+// mapping. If file is empty, the chunker can only parse the chunks made by other chunkers.
+func NewCSVChunker(mapping *Mapping, file string, batchSize int) (Chunker, error) {
+	nqs := NewNQuadBuffer(batchSize)
+	cc := &csvChunker{
+		nqs:     nqs,
+		mapping: mapping,
+		file:    file,
+		rdf:     &rdfChunker{nqs: nqs, lexer: &lex.Lexer{}},
+	}
+	if file == "" {
+		return cc, nil
+	}
+	entry, err := mapping.fileMapping(file)
+	if err != nil {
+		return nil, err
+	}
+	cc.entry = entry
+	cc.comma = mapping.Files[entry].delimiter(file)
+	return cc, nil
+}
+
+func (cc *csvChunker) NQuads() *NQuadBuffer {
+	return cc.nqs
+}
+
+func newCSVReader(r io.Reader, comma rune) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.ReuseRecord = true
+	if comma == '\t' {
+		reader.LazyQuotes = true
+	}
+	return reader
+}
+
+// Chunk reads records of the file until about 1MB of them have been read or the end of the file
+// is reached.
+func (cc *csvChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if cc.file == "" {
+		return nil, errors.New("the CSV chunker has no file to read")
+	}
+	if cc.src != r {
+		cc.src = r
+		cc.reader = newCSVReader(r, cc.comma)
+		cc.header = cc.mapping.Files[cc.entry].Header
+		if len(cc.header) == 0 {
+			header, err := cc.reader.Read()
+			if err != nil {
+				return nil, fmt.Errorf("while reading the header of %s: %w", cc.file, err)
+			}
+			cc.header = append([]string{}, header...)
+		}
+	}
+
+	out := new(bytes.Buffer)
+	if _, err := fmt.Fprintf(out, "%s%d:%s\n", csvChunkPrefix, cc.entry,
+		strconv.QuoteRune(cc.comma)); err != nil {
+		return nil, err
+	}
+	w := csv.NewWriter(out)
+	w.Comma = cc.comma
+	if err := w.Write(cc.header); err != nil {
+		return nil, err
+	}
+	for out.Len() < 1<<20 {
+		record, err := cc.reader.Read()
+		if err == io.EOF {
+			w.Flush()
+			return out, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("while reading %s: %w", cc.file, err)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+		w.Flush()
+	}
+	return out, w.Error()
+}
+
+// Parse is not thread-safe. Only call it serially, because it reuses the lexer of the RDF parser.
+func (cc *csvChunker) Parse(chunkBuf *bytes.Buffer) error {
+	if chunkBuf == nil || chunkBuf.Len() == 0 {
+		return nil
+	}
+	if !bytes.HasPrefix(chunkBuf.Bytes(), []byte(csvChunkPrefix)) {
+		return cc.rdf.Parse(chunkBuf)
+	}
+
+	line, err := chunkBuf.ReadString('\n')
+	if err != nil {
+		return errors.New("truncated CSV chunk")
+	}
+	idx, comma, _ := strings.Cut(strings.TrimSpace(strings.TrimPrefix(line, csvChunkPrefix)), ":")
+	entry, err := strconv.Atoi(idx)
+	if err != nil || entry < 0 || entry >= len(cc.mapping.Files) {
+		return fmt.Errorf("invalid CSV chunk %q", line)
+	}
+	delim, err := strconv.Unquote(comma)
+	if err != nil {
+		return fmt.Errorf("invalid CSV chunk %q", line)
+	}
+	r, _ := utf8.DecodeRuneInString(delim)
+	reader := newCSVReader(chunkBuf, r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return err
+	}
+	p, err := newCSVRecordParser(cc.mapping.Files[entry], header)
+	if err != nil {
+		return err
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		nqs, err := p.parse(record)
+		if err != nil {
+			return fmt.Errorf("while parsing record %q: %w", record, err)
+		}
+		cc.nqs.Push(nqs...)
+	}
+}
+
+type csvRecordParser struct {
+	f       *FileMapping
+	columns map[*ColumnMapping]int
+}
+
+func newCSVRecordParser(f *FileMapping, header []string) (*csvRecordParser, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(name)] = i
+	}
+	p := &csvRecordParser{f: f, columns: make(map[*ColumnMapping]int)}
+	for _, c := range f.columns() {
+		i, ok := index[c.Column]
+		if !ok {
+			return nil, fmt.Errorf("column %q of the files with pattern %q not found in the"+
+				" header %q", c.Column, f.Pattern, header)
+		}
+		p.columns[c] = i
+	}
+	return p, nil
+}
+
+func (p *csvRecordParser) parse(record []string) ([]*api.NQuad, error) {
+	return p.f.mapRecord(func(c *ColumnMapping) []string {
+		return []string{record[p.columns[c]]}
+	})
+}
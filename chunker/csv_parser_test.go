@@ -0,0 +1,175 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+)
+
+const testCSVMapping = `{
+	"files": [{
+		"pattern": "people*.csv",
+		"subject": {"column": "id", "xid": "person"},
+		"types": ["Person"],
+		"columns": [
+			{"column": "name", "predicate": "name", "lang": "en"},
+			{"column": "age", "predicate": "age", "type": "int"},
+			{"column": "tags", "predicate": "tag", "separator": ";"},
+			{"column": "friends", "predicate": "friend", "xid": "person", "separator": ";"}
+		]
+	}, {
+		"pattern": "owners.tsv",
+		"subject": {"column": "uid", "uid": true},
+		"columns": [{"column": "pet", "predicate": "pet", "xid": "pet"}]
+	}, {
+		"pattern": "pets.txt",
+		"delimiter": "|",
+		"header": ["id", "name"],
+		"subject": {"column": "id", "xid": "pet"},
+		"columns": [{"column": "name", "predicate": "name"}]
+	}]
+}`
+
+// chunkAndParseCSV chunks the data of the file and parses the chunks on another chunker, like the
+// bulk loader does.
+func chunkAndParseCSV(t *testing.T, mapping *Mapping, file, data string) []*api.NQuad {
+	ck, err := NewCSVChunker(mapping, file, 0)
+	require.NoError(t, err)
+	var chunks []*bytes.Buffer
+	r := bufioReader(data)
+	for {
+		chunk, err := ck.Chunk(r)
+		if chunk != nil {
+			chunks = append(chunks, chunk)
+		}
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+
+	parser, err := NewCSVChunker(mapping, "", 0)
+	require.NoError(t, err)
+	for _, chunk := range chunks {
+		require.NoError(t, parser.Parse(chunk))
+	}
+	parser.NQuads().Flush()
+	var nqs []*api.NQuad
+	for batch := range parser.NQuads().Ch() {
+		nqs = append(nqs, batch...)
+	}
+	return nqs
+}
+
+func strVal(s string) *api.Value {
+	return &api.Value{Val: &api.Value_DefaultVal{DefaultVal: s}}
+}
+
+func TestParseMapping(t *testing.T) {
+	m, err := ParseMapping([]byte(testCSVMapping))
+	require.NoError(t, err)
+	require.Len(t, m.Files, 3)
+
+	tests := []struct {
+		mapping string
+		err     string
+	}{
+		{`{"files": []}`, "the mapping has no files"},
+		{`{"files": [{"pattern": "a.csv", "columns": []}]}`, "no subject column"},
+		{`{"files": [{"pattern": "a.csv", "subject": {"column": "id"}}]}`,
+			`the subject column "id" must have an xid or be a uid`},
+		{`{"files": [{"pattern": "a.csv", "delimiter": "||", "subject": {"column": "id", "uid": true}}]}`,
+			`the delimiter "||" must be a single character`},
+		{`{"files": [{"pattern": "a.csv", "subject": {"column": "id", "uid": true},
+			"columns": [{"column": "a", "predicate": "a", "type": "number"}]}]}`,
+			`column "a" has an invalid type "number"`},
+		{`{"files": [{"pattern": "a.csv", "subject": {"column": "id", "uid": true},
+			"columns": [{"column": "a", "predicate": "a", "uid": true, "xid": "b"}]}]}`,
+			`column "a" can't have both an xid and be a uid`},
+		{`{"files": [{"pattern": "a.csv", "subject": {"column": "id", "uid": true},
+			"columns": [{"column": "a"}]}]}`, "columns must have a column and a predicate"},
+		{`{"files": [{"pattern": "a.csv", "unknown": 1}]}`, `unknown field "unknown"`},
+		{`{"graphs": {}}`, "the graphs need a predicate or namespaces"},
+	}
+	for _, tc := range tests {
+		_, err := ParseMapping([]byte(tc.mapping))
+		require.ErrorContains(t, err, tc.err, tc.mapping)
+	}
+}
+
+func TestCSVChunker(t *testing.T) {
+	m, err := ParseMapping([]byte(testCSVMapping))
+	require.NoError(t, err)
+
+	data := "id,name,age,tags,friends,unmapped\n" +
+		"1,\"Alice, \"\"Al\"\"\nSmith\",30,a;b,2;3,x\n" +
+		"2,Bob,,,,y\n"
+	nqs := chunkAndParseCSV(t, m, "/data/people.1.csv.gz", data)
+	require.Equal(t, []*api.NQuad{
+		{Subject: "_:person.1", Predicate: "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "Person"}}},
+		{Subject: "_:person.1", Predicate: "name", Lang: "en",
+			ObjectValue: strVal("Alice, \"Al\"\nSmith")},
+		{Subject: "_:person.1", Predicate: "age",
+			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 30}}},
+		{Subject: "_:person.1", Predicate: "tag", ObjectValue: strVal("a")},
+		{Subject: "_:person.1", Predicate: "tag", ObjectValue: strVal("b")},
+		{Subject: "_:person.1", Predicate: "friend", ObjectId: "_:person.2"},
+		{Subject: "_:person.1", Predicate: "friend", ObjectId: "_:person.3"},
+		{Subject: "_:person.2", Predicate: "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "Person"}}},
+		{Subject: "_:person.2", Predicate: "name", Lang: "en", ObjectValue: strVal("Bob")},
+	}, nqs)
+
+	nqs = chunkAndParseCSV(t, m, "owners.tsv", "uid\tpet\n0x1\tfluffy\n")
+	require.Equal(t, []*api.NQuad{
+		{Subject: "0x1", Predicate: "pet", ObjectId: "_:pet.fluffy"},
+	}, nqs)
+
+	// The header comes from the mapping.
+	nqs = chunkAndParseCSV(t, m, "pets.txt", "fluffy|Fluffy\n")
+	require.Equal(t, []*api.NQuad{
+		{Subject: "_:pet.fluffy", Predicate: "name", ObjectValue: strVal("Fluffy")},
+	}, nqs)
+}
+
+func TestCSVChunkerErrors(t *testing.T) {
+	m, err := ParseMapping([]byte(testCSVMapping))
+	require.NoError(t, err)
+
+	_, err = NewCSVChunker(m, "cars.csv", 0)
+	require.ErrorContains(t, err, "no entry of the mapping matches the file cars.csv")
+
+	ck, err := NewCSVChunker(m, "people.csv", 0)
+	require.NoError(t, err)
+	chunk, err := ck.Chunk(bufioReader("id,name\n1,Alice\n"))
+	require.Equal(t, io.EOF, err)
+	require.ErrorContains(t, ck.Parse(chunk), `column "age" of the files with pattern "people*.csv"`+
+		` not found in the header`)
+
+	ck, err = NewCSVChunker(m, "people.csv", 0)
+	require.NoError(t, err)
+	chunk, err = ck.Chunk(bufioReader("id,name,age,tags,friends\n1,Alice,old,,\n"))
+	require.Equal(t, io.EOF, err)
+	require.ErrorContains(t, ck.Parse(chunk), `column "age"`)
+}
+
+func TestCSVChunkerRDF(t *testing.T) {
+	m, err := ParseMapping([]byte(testCSVMapping))
+	require.NoError(t, err)
+	ck, err := NewCSVChunker(m, "", 0)
+	require.NoError(t, err)
+	require.NoError(t, ck.Parse(bytes.NewBufferString(`_:a <name> "A" .`)))
+	ck.NQuads().Flush()
+	nqs := <-ck.NQuads().Ch()
+	require.Len(t, nqs, 1)
+	require.Equal(t, "name", nqs[0].Predicate)
+}
@@ -262,6 +262,32 @@ func handleBasicType(k string, v interface{}, op int, nq *api.NQuad) error {
 
 }
 
+// isJSONDocument returns whether the value of the predicate is an object or an array to be kept
+// as a JSON document.
+func (buf *NQuadBuffer) isJSONDocument(namespace uint64, pred string, v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return buf.isJSONPred != nil && buf.isJSONPred(namespace, pred)
+	}
+	return false
+}
+
+func handleJSONDocument(v interface{}, nq *api.NQuad) error {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("error while trying to parse value: %+v as json val", v)
+	}
+	doc := strings.TrimSuffix(b.String(), "\n")
+	val, err := types.ObjectValue(types.JSONID, doc)
+	if err != nil {
+		return err
+	}
+	nq.ObjectValue = val
+	return nil
+}
+
 func (buf *NQuadBuffer) checkForDeletion(mr mapResponse, m map[string]interface{}, op int) {
 	// Since uid is the only key, this must be S * * deletion.
 	if op == DeleteNquads && len(mr.uid) > 0 && len(m) == 1 && len(mr.rawFacets) == 0 {
@@ -312,12 +338,17 @@ func tryParseAsGeo(b []byte, nq *api.NQuad) (bool, error) {
 // NQuadBuffer batches up batchSize NQuads per push to channel, accessible via Ch(). If batchSize is
 // negative, it only does one push to Ch() during Flush.
 type NQuadBuffer struct {
-	batchSize int
-	nquads    []*api.NQuad
-	nqCh      chan []*api.NQuad
-	predHints map[string]pb.Metadata_HintType
+	batchSize  int
+	nquads     []*api.NQuad
+	pushed     int
+	nqCh       chan []*api.NQuad
+	predHints  map[string]pb.Metadata_HintType
+	isJSONPred JSONPredicates
 }
 
+// JSONPredicates returns whether a predicate of a namespace is of type json.
+type JSONPredicates func(namespace uint64, pred string) bool
+
 // NewNQuadBuffer returns a new NQuadBuffer instance with the specified batch size.
 func NewNQuadBuffer(batchSize int) *NQuadBuffer {
 	buf := &NQuadBuffer{
@@ -331,6 +362,13 @@ func NewNQuadBuffer(batchSize int) *NQuadBuffer {
 	return buf
 }
 
+// SetJSONPredicates sets the function telling which predicates are of type json. The objects and
+// arrays given as values of those predicates are kept as JSON documents, instead of being taken
+// as nodes and lists of values.
+func (buf *NQuadBuffer) SetJSONPredicates(isJSON JSONPredicates) {
+	buf.isJSONPred = isJSON
+}
+
 // Ch returns a channel containing slices of NQuads which can be consumed by the caller.
 func (buf *NQuadBuffer) Ch() <-chan []*api.NQuad {
 	return buf.nqCh
@@ -338,6 +376,7 @@ func (buf *NQuadBuffer) Ch() <-chan []*api.NQuad {
 
 // Push can be passed one or more NQuad pointers, which get pushed to the buffer.
 func (buf *NQuadBuffer) Push(nqs ...*api.NQuad) {
+	buf.pushed += len(nqs)
 	for _, nq := range nqs {
 		buf.nquads = append(buf.nquads, nq)
 		if buf.batchSize > 0 && len(buf.nquads) >= buf.batchSize {
@@ -347,6 +386,12 @@ func (buf *NQuadBuffer) Push(nqs ...*api.NQuad) {
 	}
 }
 
+// Pushed returns the number of NQuads pushed to the buffer so far, including those still waiting
+// for their batch to fill up.
+func (buf *NQuadBuffer) Pushed() int {
+	return buf.pushed
+}
+
 // Metadata returns the parse metadata that has been aggregated so far..
 func (buf *NQuadBuffer) Metadata() *pb.Metadata {
 	return &pb.Metadata{
@@ -511,7 +556,8 @@ func (buf *NQuadBuffer) mapToNquads(m map[string]interface{}, op int, parentPred
 		}
 
 		prefix := pred + x.FacetDelimiter
-		if _, ok := v.([]interface{}); !ok {
+		isDoc := buf.isJSONDocument(namespace, pred, v)
+		if _, ok := v.([]interface{}); !ok || isDoc {
 			fts, err := parseScalarFacets(mr.rawFacets, prefix)
 			if err != nil {
 				return mr, err
@@ -523,6 +569,15 @@ func (buf *NQuadBuffer) mapToNquads(m map[string]interface{}, op int, parentPred
 		// mutations that's the only way to send language for a value.
 		nq.Predicate, nq.Lang = x.PredicateLang(nq.Predicate)
 
+		if isDoc {
+			if err := handleJSONDocument(v, &nq); err != nil {
+				return mr, err
+			}
+			buf.Push(&nq)
+			buf.PushPredHint(pred, pb.Metadata_SINGLE)
+			continue
+		}
+
 		switch v := v.(type) {
 		// these int64/float64 cases are needed for FastParseJSON, which doesn't use json.Number
 		case int64, float64:
@@ -790,7 +845,16 @@ func (buf *NQuadBuffer) ParseJSON(b []byte, op int) error {
 // ParseJSON is a convenience wrapper function to get all NQuads in one call. This can however, lead
 // to high memory usage. So be careful using this.
 func ParseJSON(b []byte, op int) ([]*api.NQuad, *pb.Metadata, error) {
+	return ParseJSONWithPredicates(b, op, nil)
+}
+
+// ParseJSONWithPredicates is like ParseJSON, but keeps the objects and arrays given as values of
+// the predicates of type json as JSON documents.
+func ParseJSONWithPredicates(b []byte, op int, isJSON JSONPredicates) ([]*api.NQuad,
+	*pb.Metadata, error) {
+
 	buf := NewNQuadBuffer(-1)
+	buf.SetJSONPredicates(isJSON)
 	err := buf.FastParseJSON(b, op)
 	if err != nil {
 		return nil, nil, err
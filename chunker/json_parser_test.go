@@ -1505,6 +1505,33 @@ func TestNquadsJsonValidVector(t *testing.T) {
 	exp.verify()
 }
 
+func TestNquadsFromJsonDocuments(t *testing.T) {
+	data := []byte(`{"uid": "_:a", "name": "shirt", "attrs": {"color": "red", "sizes": [1, 2]},
+		"attrs|since": 2020, "tags": ["x", "y"], "owner": {"name": "alice"}}`)
+	isJSON := func(namespace uint64, pred string) bool { return pred == "attrs" || pred == "tags" }
+
+	for _, parse := range []func(buf *NQuadBuffer) error{
+		func(buf *NQuadBuffer) error { return buf.ParseJSON(data, SetNquads) },
+		func(buf *NQuadBuffer) error { return buf.FastParseJSON(data, SetNquads) },
+	} {
+		buf := NewNQuadBuffer(1000)
+		buf.SetJSONPredicates(isJSON)
+		require.NoError(t, parse(buf))
+
+		docs := make(map[string]*api.NQuad)
+		for _, nq := range buf.nquads {
+			if nq.Predicate == "attrs" || nq.Predicate == "tags" {
+				docs[nq.Predicate] = nq
+			}
+		}
+		// The documents are single values, and the objects of other predicates are still nodes.
+		require.Len(t, buf.nquads, 5)
+		require.Equal(t, `{"color":"red","sizes":[1,2]}`, docs["attrs"].ObjectValue.GetStrVal())
+		require.Len(t, docs["attrs"].Facets, 1)
+		require.Equal(t, `["x","y"]`, docs["tags"].ObjectValue.GetStrVal())
+	}
+}
+
 func TestMain(m *testing.M) {
 	conf := dgraphtest.NewClusterConfig().WithNumAlphas(1).WithNumZeros(1).WithReplicas(1).WithACL(time.Hour)
 	c, err := dgraphtest.NewLocalCluster(conf)
@@ -0,0 +1,586 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hypermodeinc/dgraph/v25/lex"
+)
+
+// jsonldChunker reads JSON-LD documents. Chunk expands their nodes into N-Quads, so the chunks are
+// parsed as RDF like the chunks of the Turtle documents. The types of the nodes become their
+// dgraph.type and the language of the strings becomes their language tag.
+type jsonldChunker struct {
+	*rdfChunker
+	p *jsonldParser
+	// bnode prefixes the blank nodes made for the nodes without @id.
+	bnode  string
+	blanks int
+}
+
+// NewJSONLDChunker returns a new chunker for JSON-LD documents. The triples of the named graphs
+// are mapped by graphs. If graphs is nil, they are loaded as if they were in the default graph.
+// Only the contexts embedded in the documents are read, as the remote ones can't be fetched.
+func NewJSONLDChunker(graphs *GraphMapping, batchSize int) Chunker {
+	return &jsonldChunker{
+		rdfChunker: &rdfChunker{
+			nqs:    NewNQuadBuffer(batchSize),
+			lexer:  &lex.Lexer{},
+			graphs: graphs,
+		},
+		bnode: fmt.Sprintf("_:jsonld.%x.", rand.Uint64()),
+	}
+}
+
+// Chunk reads nodes of the document until about 1MB of N-Quads have been written or the end of
+// the document is reached.
+func (jc *jsonldChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if jc.p == nil || jc.p.r != r {
+		dec := json.NewDecoder(r)
+		dec.UseNumber()
+		jc.p = &jsonldParser{r: r, dec: dec, jc: jc, ctx: &jsonldContext{}}
+	}
+	out := new(bytes.Buffer)
+	jc.p.out = out
+	for out.Len() < 1<<20 {
+		switch err := jc.p.next(); {
+		case err == io.EOF:
+			return out, io.EOF
+		case err != nil:
+			return nil, fmt.Errorf("while reading the JSON-LD document: %w", err)
+		}
+	}
+	return out, nil
+}
+
+// jsonldTerm is the definition of a term of a context.
+type jsonldTerm struct {
+	id string
+	// typ is @id or @vocab for the references to nodes, @json for JSON literals, or the datatype
+	// of the values.
+	typ string
+	// lang is the language of the strings, which overrides the default one of the context if set.
+	lang      *string
+	container string
+}
+
+type jsonldContext struct {
+	// terms holds nil for the terms mapped to null, whose properties are dropped.
+	terms map[string]*jsonldTerm
+	vocab string
+	base  *url.URL
+	lang  string
+}
+
+// process returns the context updated by the local context, which is an object, an array of
+// objects or null.
+func (c *jsonldContext) process(local any) (*jsonldContext, error) {
+	switch local := local.(type) {
+	case nil:
+		return &jsonldContext{}, nil
+	case []any:
+		var err error
+		for _, l := range local {
+			if c, err = c.process(l); err != nil {
+				return nil, err
+			}
+		}
+		return c, nil
+	case string:
+		return nil, fmt.Errorf("the remote context %q can't be loaded", local)
+	case map[string]any:
+	default:
+		return nil, fmt.Errorf("invalid context %v", local)
+	}
+
+	nc := &jsonldContext{terms: make(map[string]*jsonldTerm), vocab: c.vocab, base: c.base,
+		lang: c.lang}
+	for k, v := range c.terms {
+		nc.terms[k] = v
+	}
+	m := local.(map[string]any)
+	for key, v := range m {
+		switch key {
+		case "@vocab":
+			s, _ := v.(string)
+			nc.vocab = s
+		case "@base":
+			s, _ := v.(string)
+			base, err := url.Parse(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid @base %q: %w", s, err)
+			}
+			nc.base = base
+		case "@language":
+			s, _ := v.(string)
+			nc.lang = s
+		}
+	}
+	for key, v := range m {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		switch v := v.(type) {
+		case nil:
+			nc.terms[key] = nil
+		case string:
+			nc.terms[key] = &jsonldTerm{id: v}
+		case map[string]any:
+			term := &jsonldTerm{}
+			term.id, _ = v["@id"].(string)
+			term.typ, _ = v["@type"].(string)
+			term.container, _ = v["@container"].(string)
+			if lang, ok := v["@language"]; ok {
+				s, _ := lang.(string)
+				term.lang = &s
+			}
+			if _, ok := v["@reverse"]; ok {
+				return nil, fmt.Errorf("the reverse term %q isn't supported", key)
+			}
+			nc.terms[key] = term
+		default:
+			return nil, fmt.Errorf("invalid definition of the term %q", key)
+		}
+	}
+	return nc, nil
+}
+
+// expand expands a term, a compact IRI or a relative IRI, which is resolved against the
+// vocabulary for the properties and the types, and against the base for the node IDs. The terms
+// mapped to null expand to an empty string, and the names which can't be expanded are kept.
+func (c *jsonldContext) expand(s string, vocab bool) string {
+	return c.expandDepth(s, vocab, 0)
+}
+
+func (c *jsonldContext) expandDepth(s string, vocab bool, depth int) string {
+	if strings.HasPrefix(s, "@") || depth > 8 {
+		return s
+	}
+	if term, ok := c.terms[s]; ok && vocab {
+		switch {
+		case term == nil:
+			return ""
+		case term.id == "" || term.id == s:
+			return c.expandPrefixed(s, vocab, depth)
+		default:
+			return c.expandDepth(term.id, vocab, depth+1)
+		}
+	}
+	return c.expandPrefixed(s, vocab, depth)
+}
+
+func (c *jsonldContext) expandPrefixed(s string, vocab bool, depth int) string {
+	if prefix, suffix, ok := strings.Cut(s, ":"); ok {
+		if prefix == "_" || strings.HasPrefix(suffix, "//") {
+			return s
+		}
+		if term, ok := c.terms[prefix]; ok && term != nil && term.id != "" && term.id != prefix {
+			return c.expandDepth(term.id, true, depth+1) + suffix
+		}
+		return s
+	}
+	if vocab {
+		if c.vocab != "" {
+			return c.vocab + s
+		}
+		return s
+	}
+	if c.base != nil {
+		if u, err := url.Parse(s); err == nil {
+			return c.base.ResolveReference(u).String()
+		}
+	}
+	return s
+}
+
+const (
+	jsonldStart = iota
+	jsonldArray
+	jsonldObject
+	jsonldGraph
+	jsonldDone
+)
+
+// jsonldParser reads the nodes of a JSON-LD document, which is an array of nodes or an object
+// holding the nodes in its @graph, one at a time.
+type jsonldParser struct {
+	r   *bufio.Reader
+	dec *json.Decoder
+	jc  *jsonldChunker
+	out *bytes.Buffer
+
+	state int
+	// ctx is the context of the top object, and top holds its other properties. They describe
+	// the graph named by its @id, which must come before the @graph, or a single node.
+	ctx   *jsonldContext
+	top   map[string]any
+	label string
+}
+
+func (p *jsonldParser) expectDelim(delim json.Delim) error {
+	tok, err := p.dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %v, found %v", delim, tok)
+	}
+	return nil
+}
+
+// next reads the next node, or the next part of the structure of the document.
+func (p *jsonldParser) next() error {
+	switch p.state {
+	case jsonldStart:
+		tok, err := p.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('['):
+			p.state = jsonldArray
+		case json.Delim('{'):
+			p.state, p.top = jsonldObject, make(map[string]any)
+		default:
+			return errors.New("the document must be an object or an array")
+		}
+		return nil
+
+	case jsonldArray, jsonldGraph:
+		if !p.dec.More() {
+			if p.state == jsonldArray {
+				p.state = jsonldDone
+			} else {
+				p.state = jsonldObject
+			}
+			return p.expectDelim(']')
+		}
+		var node map[string]any
+		if err := p.dec.Decode(&node); err != nil {
+			return err
+		}
+		_, err := p.node(p.ctx, node, p.label)
+		return err
+
+	case jsonldObject:
+		if !p.dec.More() {
+			p.state = jsonldDone
+			if err := p.expectDelim('}'); err != nil {
+				return err
+			}
+			if len(p.top) == 0 {
+				return nil
+			}
+			if _, ok := p.top["@id"]; ok && len(p.top) == 1 {
+				return nil
+			}
+			_, err := p.node(p.ctx, p.top, "")
+			return err
+		}
+		tok, err := p.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch key := tok.(string); key {
+		case "@context":
+			var local any
+			if err := p.dec.Decode(&local); err != nil {
+				return err
+			}
+			p.ctx, err = p.ctx.process(local)
+			return err
+		case "@graph":
+			if err := p.expectDelim('['); err != nil {
+				return err
+			}
+			if id, ok := p.top["@id"].(string); ok && p.jc.graphs != nil {
+				p.label = p.ref(p.ctx.expand(id, false))
+			}
+			p.state = jsonldGraph
+			return nil
+		default:
+			var v any
+			if err := p.dec.Decode(&v); err != nil {
+				return err
+			}
+			p.top[key] = v
+			return nil
+		}
+
+	default:
+		return io.EOF
+	}
+}
+
+// ref returns the N-Quad term of a node ID.
+func (p *jsonldParser) ref(id string) string {
+	if strings.HasPrefix(id, "_:") {
+		return id
+	}
+	return "<" + id + ">"
+}
+
+// node writes the triples of the node and of its nested nodes, and returns the node. The label is
+// written after the triples. It is the graph of the node, or its namespace if it has one.
+func (p *jsonldParser) node(ctx *jsonldContext, node map[string]any, label string) (string,
+	error) {
+
+	if local, ok := node["@context"]; ok {
+		var err error
+		if ctx, err = ctx.process(local); err != nil {
+			return "", err
+		}
+	}
+
+	var subject string
+	if id, ok := node["@id"].(string); ok {
+		subject = p.ref(ctx.expand(id, false))
+	} else {
+		p.jc.blanks++
+		subject = p.jc.bnode + strconv.Itoa(p.jc.blanks)
+	}
+	// Like in the JSON documents, the namespace property sets the namespace of the node unless
+	// the context defines it.
+	_, hasNamespace := node["namespace"]
+	if _, ok := ctx.terms["namespace"]; ok {
+		hasNamespace = false
+	}
+	if hasNamespace {
+		n, err := strconv.ParseUint(fmt.Sprint(node["namespace"]), 0, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid namespace %v", node["namespace"])
+		}
+		label = fmt.Sprintf("<%#x>", n)
+	}
+
+	var typs []any
+	switch t := node["@type"].(type) {
+	case string:
+		typs = []any{t}
+	case []any:
+		typs = t
+	}
+	for _, t := range typs {
+		if s, ok := t.(string); ok {
+			p.emit(subject, "<dgraph.type>", quoteLiteral(ctx.expand(s, true)), label)
+		}
+	}
+
+	for _, key := range sortedKeys(node) {
+		if strings.HasPrefix(key, "@") || (key == "namespace" && hasNamespace) {
+			continue
+		}
+		predicate := ctx.expand(key, true)
+		if predicate == "" {
+			continue
+		}
+		objects, err := p.objects(ctx, ctx.terms[key], node[key], label)
+		if err != nil {
+			return "", fmt.Errorf("property %q: %w", key, err)
+		}
+		for _, object := range objects {
+			p.emit(subject, "<"+predicate+">", object, label)
+		}
+	}
+
+	if graph, ok := node["@graph"]; ok {
+		nodes, _ := graph.([]any)
+		if m, ok := graph.(map[string]any); ok {
+			nodes = []any{m}
+		}
+		// The nodes of a graph without @id are in the graph of the node.
+		graphLabel := label
+		if _, ok := node["@id"]; ok && p.jc.graphs != nil && !hasNamespace {
+			graphLabel = subject
+		}
+		for _, n := range nodes {
+			if m, ok := n.(map[string]any); ok {
+				if _, err := p.node(ctx, m, graphLabel); err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+	return subject, nil
+}
+
+// objects returns the N-Quad terms of the values of a property.
+func (p *jsonldParser) objects(ctx *jsonldContext, term *jsonldTerm, v any,
+	label string) ([]string, error) {
+
+	var typ, container string
+	lang := ctx.lang
+	if term != nil {
+		typ, container = term.typ, term.container
+		if term.lang != nil {
+			lang = *term.lang
+		}
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		var objects []string
+		for _, e := range v {
+			o, err := p.objects(ctx, term, e, label)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, o...)
+		}
+		if container == "@list" {
+			return []string{p.list(objects, label)}, nil
+		}
+		return objects, nil
+	case string:
+		switch typ {
+		case "@id":
+			return []string{p.ref(ctx.expand(v, false))}, nil
+		case "@vocab":
+			return []string{p.ref(ctx.expand(v, true))}, nil
+		case "@json":
+			return jsonLiteral(v)
+		case "":
+			if lang != "" {
+				return []string{quoteLiteral(v) + "@" + lang}, nil
+			}
+			return []string{quoteLiteral(v)}, nil
+		}
+		return []string{typedLiteral(v, ctx.expand(typ, true))}, nil
+	case json.Number:
+		switch {
+		case typ == "@json":
+			return jsonLiteral(v)
+		case typ != "":
+			return []string{typedLiteral(v.String(), ctx.expand(typ, true))}, nil
+		case strings.ContainsAny(v.String(), ".eE"):
+			return []string{typedLiteral(v.String(), xsdNamespace+"double")}, nil
+		default:
+			return []string{typedLiteral(v.String(), xsdNamespace+"integer")}, nil
+		}
+	case bool:
+		if typ == "@json" {
+			return jsonLiteral(v)
+		}
+		return []string{typedLiteral(strconv.FormatBool(v), xsdNamespace+"boolean")}, nil
+	case map[string]any:
+		if typ == "@json" {
+			return jsonLiteral(v)
+		}
+		if val, ok := v["@value"]; ok {
+			vterm := &jsonldTerm{}
+			vterm.typ, _ = v["@type"].(string)
+			if l, ok := v["@language"].(string); ok {
+				vterm.lang = &l
+			} else {
+				empty := ""
+				vterm.lang = &empty
+			}
+			return p.objects(ctx, vterm, val, label)
+		}
+		if list, ok := v["@list"]; ok {
+			items, _ := list.([]any)
+			objects, err := p.objects(ctx, &jsonldTerm{typ: typ, lang: &lang}, items, label)
+			if err != nil {
+				return nil, err
+			}
+			return []string{p.list(objects, label)}, nil
+		}
+		if set, ok := v["@set"]; ok {
+			return p.objects(ctx, term, set, label)
+		}
+		if container == "@language" {
+			var objects []string
+			for _, l := range sortedKeys(v) {
+				s, ok := v[l].(string)
+				if !ok {
+					continue
+				}
+				objects = append(objects, quoteLiteral(s)+"@"+l)
+			}
+			return objects, nil
+		}
+		subject, err := p.node(ctx, v, label)
+		if err != nil {
+			return nil, err
+		}
+		return []string{subject}, nil
+	default:
+		return nil, fmt.Errorf("invalid value %v", v)
+	}
+}
+
+// list writes the chain of rdf:first and rdf:rest edges of a list, and returns its first node.
+func (p *jsonldParser) list(objects []string, label string) string {
+	head := "<" + rdfNamespace + "nil>"
+	var node string
+	for _, object := range objects {
+		p.jc.blanks++
+		next := p.jc.bnode + strconv.Itoa(p.jc.blanks)
+		if node == "" {
+			head = next
+		} else {
+			p.emit(node, "<"+rdfNamespace+"rest>", next, label)
+		}
+		node = next
+		p.emit(node, "<"+rdfNamespace+"first>", object, label)
+	}
+	if node != "" {
+		p.emit(node, "<"+rdfNamespace+"rest>", "<"+rdfNamespace+"nil>", label)
+	}
+	return head
+}
+
+func (p *jsonldParser) emit(subject, predicate, object, label string) {
+	p.out.WriteString(subject)
+	p.out.WriteByte(' ')
+	p.out.WriteString(predicate)
+	p.out.WriteByte(' ')
+	p.out.WriteString(object)
+	if label != "" {
+		p.out.WriteByte(' ')
+		p.out.WriteString(label)
+	}
+	p.out.WriteString(" .\n")
+}
+
+// typedLiteral returns the literal with its datatype, which is dropped if Dgraph doesn't know it
+// so that the value is loaded as an untyped string.
+func typedLiteral(v, datatype string) string {
+	if _, ok := typeMap[datatype]; ok {
+		return quoteLiteral(v) + "^^<" + datatype + ">"
+	}
+	return quoteLiteral(v)
+}
+
+func jsonLiteral(v any) ([]string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return []string{typedLiteral(string(b), "xs:json")}, nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+)
+
+// chunkAndParseJSONLD chunks the document and parses the chunks on another chunker, like the bulk
+// loader does.
+func chunkAndParseJSONLD(t *testing.T, graphs *GraphMapping, doc string) []*api.NQuad {
+	ck := NewJSONLDChunker(graphs, 0)
+	ck.(*jsonldChunker).bnode = "_:b"
+	var chunks []*bytes.Buffer
+	r := bufioReader(doc)
+	for {
+		chunk, err := ck.Chunk(r)
+		if chunk != nil {
+			chunks = append(chunks, chunk)
+		}
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+
+	parser := NewJSONLDChunker(graphs, 0)
+	for _, chunk := range chunks {
+		require.NoError(t, parser.Parse(chunk))
+	}
+	parser.NQuads().Flush()
+	var nqs []*api.NQuad
+	for batch := range parser.NQuads().Ch() {
+		nqs = append(nqs, batch...)
+	}
+	return nqs
+}
+
+func TestJSONLDChunker(t *testing.T) {
+	doc := `{
+	"@context": {
+		"@vocab": "http://schema.org/",
+		"@language": "en",
+		"ex": "http://example.org/",
+		"xsd": "http://www.w3.org/2001/XMLSchema#",
+		"knows": {"@id": "ex:knows", "@type": "@id"},
+		"born": {"@id": "ex:born", "@type": "xsd:dateTime"},
+		"code": {"@id": "ex:code", "@language": null},
+		"tags": {"@id": "ex:tags", "@container": "@list"},
+		"title": {"@id": "ex:title", "@container": "@language"},
+		"ignored": null
+	},
+	"@graph": [{
+		"@id": "ex:alice",
+		"@type": ["Person", "ex:Admin"],
+		"name": "Alice",
+		"age": 30,
+		"score": 1.5,
+		"active": true,
+		"knows": ["ex:bob"],
+		"born": "1990-05-02T15:04:05Z",
+		"code": "A1",
+		"nick": {"@value": "Ali", "@language": "fr"},
+		"tags": ["a", "b"],
+		"title": {"de": "Frau", "fr": "Madame"},
+		"ignored": "x",
+		"friend": {"name": "Carol"}
+	}]
+}`
+	nqs := chunkAndParseJSONLD(t, nil, doc)
+
+	born, err := typedValue("1990-05-02T15:04:05Z", typeMap[xsdNamespace+"dateTime"])
+	require.NoError(t, err)
+	s := func(p string) string { return "http://schema.org/" + p }
+	ex := func(p string) string { return "http://example.org/" + p }
+	alice := ex("alice")
+	typ := func(t string) *api.Value {
+		return &api.Value{Val: &api.Value_DefaultVal{DefaultVal: t}}
+	}
+	require.Equal(t, []*api.NQuad{
+		{Subject: alice, Predicate: "dgraph.type", ObjectValue: typ(s("Person"))},
+		{Subject: alice, Predicate: "dgraph.type", ObjectValue: typ(ex("Admin"))},
+		{Subject: alice, Predicate: s("active"),
+			ObjectValue: &api.Value{Val: &api.Value_BoolVal{BoolVal: true}}},
+		{Subject: alice, Predicate: s("age"),
+			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 30}}},
+		{Subject: alice, Predicate: ex("born"), ObjectValue: born},
+		{Subject: alice, Predicate: ex("code"), ObjectValue: strVal("A1")},
+		{Subject: "_:b1", Predicate: s("name"), Lang: "en", ObjectValue: strVal("Carol")},
+		{Subject: alice, Predicate: s("friend"), ObjectId: "_:b1"},
+		{Subject: alice, Predicate: ex("knows"), ObjectId: ex("bob")},
+		{Subject: alice, Predicate: s("name"), Lang: "en", ObjectValue: strVal("Alice")},
+		{Subject: alice, Predicate: s("nick"), Lang: "fr", ObjectValue: strVal("Ali")},
+		{Subject: alice, Predicate: s("score"),
+			ObjectValue: &api.Value{Val: &api.Value_DoubleVal{DoubleVal: 1.5}}},
+		{Subject: "_:b2", Predicate: rdfNamespace + "first", Lang: "en", ObjectValue: strVal("a")},
+		{Subject: "_:b2", Predicate: rdfNamespace + "rest", ObjectId: "_:b3"},
+		{Subject: "_:b3", Predicate: rdfNamespace + "first", Lang: "en", ObjectValue: strVal("b")},
+		{Subject: "_:b3", Predicate: rdfNamespace + "rest", ObjectId: rdfNamespace + "nil"},
+		{Subject: alice, Predicate: ex("tags"), ObjectId: "_:b2"},
+		{Subject: alice, Predicate: ex("title"), Lang: "de", ObjectValue: strVal("Frau")},
+		{Subject: alice, Predicate: ex("title"), Lang: "fr", ObjectValue: strVal("Madame")},
+	}, nqs)
+}
+
+func TestJSONLDChunkerGraphs(t *testing.T) {
+	// The nodes of the top array have their own context, and the export of Dgraph sets the
+	// namespace of the nodes.
+	doc := `[
+	{"@context": {"ex": "http://example.org/"}, "@id": "ex:g", "@graph": [
+		{"@id": "ex:a", "ex:p": "one"}
+	]},
+	{"@id": "0x1", "namespace": "0x2", "@type": "Person", "name": "Bob"}
+]`
+	graphs := &GraphMapping{Predicate: "graph"}
+	nqs := chunkAndParseJSONLD(t, graphs, doc)
+	typ := &api.Value{Val: &api.Value_DefaultVal{DefaultVal: "Person"}}
+	require.Equal(t, []*api.NQuad{
+		{Subject: "http://example.org/a", Predicate: "http://example.org/p",
+			ObjectValue: strVal("one")},
+		{Subject: "http://example.org/a", Predicate: "graph", ObjectId: "http://example.org/g"},
+		{Subject: "0x1", Predicate: "dgraph.type", ObjectValue: typ, Namespace: 2},
+		{Subject: "0x1", Predicate: "name", ObjectValue: strVal("Bob"), Namespace: 2},
+	}, nqs)
+}
+
+func TestJSONLDChunkerErrors(t *testing.T) {
+	tests := []struct {
+		doc string
+		err string
+	}{
+		{`"a"`, "the document must be an object or an array"},
+		{`{"@context": "http://schema.org/", "@graph": []}`,
+			`the remote context "http://schema.org/" can't be loaded`},
+		{`[{"@id": "a", "namespace": "x"}]`, "invalid namespace x"},
+		{`[{"@id": "a", "b": }]`, "invalid character"},
+	}
+	for _, tc := range tests {
+		ck := NewJSONLDChunker(nil, 0)
+		_, err := ck.Chunk(bufioReader(tc.doc))
+		require.ErrorContains(t, err, tc.err, tc.doc)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+
+	"github.com/hypermodeinc/dgraph/v25/types"
+)
+
+// Mapping describes how the records of tabular files, like CSV, TSV and Parquet files, are turned
+// into N-Quads. Each file is read according to the first entry whose pattern matches its name.
+// The named graphs of N-Quads and TriG files are mapped by its graphs.
+//
+//	{
+//	  "files": [{
+//	    "pattern": "people*.csv",
+//	    "subject": {"column": "id", "xid": "person"},
+//	    "types": ["Person"],
+//	    "columns": [
+//	      {"column": "name", "predicate": "name", "lang": "en"},
+//	      {"column": "born", "predicate": "born", "type": "datetime"},
+//	      {"column": "tags", "predicate": "tag", "separator": ";"}
+//	    ]
+//	  }, {
+//	    "pattern": "friends*.parquet",
+//	    "subject": {"column": "from", "xid": "person"},
+//	    "columns": [{"column": "to.id", "predicate": "friend", "xid": "person"}]
+//	  }],
+//	  "graphs": {
+//	    "predicate": "graph",
+//	    "namespaces": {"http://example.org/vendor": 2}
+//	  }
+//	}
+type Mapping struct {
+	Files  []*FileMapping `json:"files"`
+	Graphs *GraphMapping  `json:"graphs,omitempty"`
+}
+
+// GraphMapping maps the named graphs, which Dgraph doesn't have. The triples of a graph are
+// loaded into the namespace of the graph, if it has one, and their subjects are linked to the
+// graph by the predicate, if it is set. The graphs are named by their IRI, or by their label for
+// blank nodes, like _:g1.
+type GraphMapping struct {
+	Predicate  string            `json:"predicate,omitempty"`
+	Namespaces map[string]uint64 `json:"namespaces,omitempty"`
+}
+
+// FileMapping maps the columns of the files matching its pattern. Each record is a node, whose
+// subject is given by the subject column, and each mapped column of the record is an edge from it.
+type FileMapping struct {
+	// Pattern is matched against the base name of the files, without the .gz extension.
+	Pattern string `json:"pattern"`
+	// Delimiter separates the fields of the records of CSV files. It defaults to a tab for .tsv
+	// files and to a comma otherwise.
+	Delimiter string `json:"delimiter,omitempty"`
+	// Header names the columns of CSV files having no header record.
+	Header []string `json:"header,omitempty"`
+	// Subject is the column identifying the nodes. Only its column, xid and uid are used.
+	Subject *ColumnMapping `json:"subject"`
+	// Types are the types of the nodes, set as their dgraph.type.
+	Types   []string         `json:"types,omitempty"`
+	Columns []*ColumnMapping `json:"columns"`
+}
+
+// ColumnMapping maps a column to a predicate. The values of the column are literals of the given
+// type, or references to nodes if xid or uid is set. The columns nested in the groups of Parquet
+// files are named by their path, like address.city. The values of list columns, and of the
+// columns nested in lists, are all mapped.
+type ColumnMapping struct {
+	Column    string `json:"column"`
+	Predicate string `json:"predicate,omitempty"`
+	// Type is the type of the values, like int or datetime. Untyped values are converted to the
+	// type of the predicate in the schema.
+	Type string `json:"type,omitempty"`
+	// Lang is the language tag of the values.
+	Lang string `json:"lang,omitempty"`
+	// Separator splits a value into multiple values.
+	Separator string `json:"separator,omitempty"`
+	// Xid makes the values external ids of nodes, which become blank nodes named after the xid
+	// and the value. The same xid and value refer to the same node in all the files.
+	Xid string `json:"xid,omitempty"`
+	// Uid makes the values uids of nodes.
+	Uid bool `json:"uid,omitempty"`
+
+	typ types.TypeID
+}
+
+// ReadMapping reads the mapping from a JSON file.
+func ReadMapping(file string) (*Mapping, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("while reading the mapping file %s: %w", file, err)
+	}
+	m, err := ParseMapping(b)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing the mapping file %s: %w", file, err)
+	}
+	return m, nil
+}
+
+// ParseMapping parses and validates a mapping.
+func ParseMapping(b []byte) (*Mapping, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var m Mapping
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	if len(m.Files) == 0 && m.Graphs == nil {
+		return nil, errors.New("the mapping has no files")
+	}
+	if m.Graphs != nil && m.Graphs.Predicate == "" && len(m.Graphs.Namespaces) == 0 {
+		return nil, errors.New("the graphs need a predicate or namespaces")
+	}
+	for _, f := range m.Files {
+		if err := f.validate(); err != nil {
+			return nil, fmt.Errorf("files with pattern %q: %w", f.Pattern, err)
+		}
+	}
+	return &m, nil
+}
+
+// apply loads the N-Quad of the graph into the namespace of the graph, and returns the N-Quad
+// linking its subject to the graph unless the link is in links already.
+func (g *GraphMapping) apply(nq *api.NQuad, graph string, links map[string]struct{}) *api.NQuad {
+	if ns, ok := g.Namespaces[graph]; ok {
+		nq.Namespace = ns
+	}
+	if g.Predicate == "" {
+		return nil
+	}
+	key := fmt.Sprintf("%d\x00%s\x00%s", nq.Namespace, nq.Subject, graph)
+	if _, ok := links[key]; ok {
+		return nil
+	}
+	links[key] = struct{}{}
+	return &api.NQuad{
+		Subject:   nq.Subject,
+		Predicate: g.Predicate,
+		ObjectId:  graph,
+		Namespace: nq.Namespace,
+	}
+}
+
+func (f *FileMapping) validate() error {
+	if _, err := filepath.Match(f.Pattern, ""); err != nil || f.Pattern == "" {
+		return errors.New("invalid pattern")
+	}
+	if f.Delimiter != "" && utf8.RuneCountInString(f.Delimiter) != 1 {
+		return fmt.Errorf("the delimiter %q must be a single character", f.Delimiter)
+	}
+	if f.Subject == nil || f.Subject.Column == "" {
+		return errors.New("no subject column")
+	}
+	if f.Subject.Xid == "" && !f.Subject.Uid {
+		return fmt.Errorf("the subject column %q must have an xid or be a uid", f.Subject.Column)
+	}
+	for _, c := range f.Columns {
+		if c.Column == "" || c.Predicate == "" {
+			return errors.New("columns must have a column and a predicate")
+		}
+		if c.Xid != "" && c.Uid {
+			return fmt.Errorf("column %q can't have both an xid and be a uid", c.Column)
+		}
+		c.typ = types.DefaultID
+		if c.Type != "" {
+			typ, ok := types.TypeForName(c.Type)
+			if !ok || typ == types.UidID {
+				return fmt.Errorf("column %q has an invalid type %q", c.Column, c.Type)
+			}
+			c.typ = typ
+		}
+	}
+	return nil
+}
+
+// fileMapping returns the index of the entry of the mapping for the file.
+func (m *Mapping) fileMapping(file string) (int, error) {
+	name := strings.TrimSuffix(filepath.Base(file), ".gz")
+	for i, f := range m.Files {
+		if ok, _ := filepath.Match(f.Pattern, name); ok {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no entry of the mapping matches the file %s", file)
+}
+
+// columns returns the subject column followed by the mapped columns.
+func (f *FileMapping) columns() []*ColumnMapping {
+	return append([]*ColumnMapping{f.Subject}, f.Columns...)
+}
+
+// mappedNode returns the node referred to by the value of a column having an xid or being a uid.
+func mappedNode(c *ColumnMapping, val string) string {
+	if c.Uid {
+		return val
+	}
+	return "_:" + c.Xid + "." + val
+}
+
+// mapRecord returns the N-Quads of a record, given the values of its columns.
+func (f *FileMapping) mapRecord(values func(c *ColumnMapping) []string) ([]*api.NQuad, error) {
+	var subject string
+	if vals := values(f.Subject); len(vals) > 0 {
+		subject = strings.TrimSpace(vals[0])
+	}
+	if subject == "" {
+		return nil, fmt.Errorf("empty subject column %q", f.Subject.Column)
+	}
+	subject = mappedNode(f.Subject, subject)
+
+	var nqs []*api.NQuad
+	for _, typ := range f.Types {
+		nqs = append(nqs, &api.NQuad{
+			Subject:     subject,
+			Predicate:   "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: typ}},
+		})
+	}
+	for _, c := range f.Columns {
+		for _, field := range values(c) {
+			vals := []string{field}
+			if c.Separator != "" {
+				vals = strings.Split(field, c.Separator)
+			}
+			for _, val := range vals {
+				if c.Xid != "" || c.Uid || c.typ != types.StringID {
+					val = strings.TrimSpace(val)
+				}
+				if val == "" {
+					continue
+				}
+				nq := &api.NQuad{Subject: subject, Predicate: c.Predicate, Lang: c.Lang}
+				if c.Xid != "" || c.Uid {
+					nq.ObjectId = mappedNode(c, val)
+				} else {
+					ov, err := typedValue(val, c.typ)
+					if err != nil {
+						return nil, fmt.Errorf("column %q: %w", c.Column, err)
+					}
+					nq.ObjectValue = ov
+				}
+				nqs = append(nqs, nq)
+			}
+		}
+	}
+	return nqs, nil
+}
@@ -0,0 +1,294 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/hypermodeinc/dgraph/v25/lex"
+)
+
+// parquetChunkPrefix starts the chunks of Parquet records, followed by the index of the entry of
+// the mapping. Each following line is a JSON object holding the values of the mapped columns of
+// a record, so that each chunk can be parsed on its own.
+const parquetChunkPrefix = "#parquet:"
+
+type parquetChunker struct {
+	nqs     *NQuadBuffer
+	mapping *Mapping
+
+	file  string
+	entry int
+	src   *bufio.Reader
+	// Parquet files are read from their end, so they are copied to a temporary file first.
+	tmp    *os.File
+	schema *parquet.Schema
+	reader *parquet.Reader
+
+	// Chunks which weren't made by Chunk, like the GraphQL schema sent by the bulk loader, are
+	// parsed as RDF.
+	rdf *rdfChunker
+}
+
+// NewParquetChunker returns a new chunker for the Parquet file, read according to its entry of
+// the mapping. Each row of the file is a node. If file is empty, the chunker can only parse the
+// chunks made by other chunkers.
+func NewParquetChunker(mapping *Mapping, file string, batchSize int) (Chunker, error) {
+	nqs := NewNQuadBuffer(batchSize)
+	pc := &parquetChunker{
+		nqs:     nqs,
+		mapping: mapping,
+		file:    file,
+		rdf:     &rdfChunker{nqs: nqs, lexer: &lex.Lexer{}},
+	}
+	if file == "" {
+		return pc, nil
+	}
+	entry, err := mapping.fileMapping(file)
+	if err != nil {
+		return nil, err
+	}
+	pc.entry = entry
+	return pc, nil
+}
+
+func (pc *parquetChunker) NQuads() *NQuadBuffer {
+	return pc.nqs
+}
+
+func (pc *parquetChunker) open(r *bufio.Reader) error {
+	pc.close()
+	pc.src = r
+
+	tmp, err := os.CreateTemp("", "dgraph-parquet-*")
+	if err != nil {
+		return err
+	}
+	pc.tmp = tmp
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return fmt.Errorf("while reading %s: %w", pc.file, err)
+	}
+	f, err := parquet.OpenFile(tmp, size)
+	if err != nil {
+		return fmt.Errorf("while opening %s: %w", pc.file, err)
+	}
+	pc.schema = f.Schema()
+	for _, c := range pc.mapping.Files[pc.entry].columns() {
+		if !parquetColumnExists(pc.schema, strings.Split(c.Column, ".")) {
+			return fmt.Errorf("column %q not found in the schema of %s", c.Column, pc.file)
+		}
+	}
+	pc.reader = parquet.NewReader(f)
+	return nil
+}
+
+func (pc *parquetChunker) close() {
+	if pc.reader != nil {
+		_ = pc.reader.Close()
+		pc.reader = nil
+	}
+	if pc.tmp != nil {
+		_ = pc.tmp.Close()
+		_ = os.Remove(pc.tmp.Name())
+		pc.tmp = nil
+	}
+}
+
+// Chunk reads rows of the file until about 1MB of their mapped values have been read or the end
+// of the file is reached.
+func (pc *parquetChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if pc.file == "" {
+		return nil, errors.New("the Parquet chunker has no file to read")
+	}
+	if pc.src != r {
+		if err := pc.open(r); err != nil {
+			pc.close()
+			return nil, err
+		}
+	}
+
+	out := new(bytes.Buffer)
+	if _, err := fmt.Fprintf(out, "%s%d\n", parquetChunkPrefix, pc.entry); err != nil {
+		return nil, err
+	}
+	columns := pc.mapping.Files[pc.entry].columns()
+	enc := json.NewEncoder(out)
+	for out.Len() < 1<<20 {
+		row := make(map[string]any)
+		err := pc.reader.Read(&row)
+		if err == io.EOF {
+			pc.close()
+			return out, io.EOF
+		}
+		if err != nil {
+			pc.close()
+			return nil, fmt.Errorf("while reading %s: %w", pc.file, err)
+		}
+		record := make(map[string][]string, len(columns))
+		for _, c := range columns {
+			record[c.Column] = parquetValues(pc.schema, row, strings.Split(c.Column, "."))
+		}
+		if err := enc.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// Parse is not thread-safe. Only call it serially, because it reuses the lexer of the RDF parser.
+func (pc *parquetChunker) Parse(chunkBuf *bytes.Buffer) error {
+	if chunkBuf == nil || chunkBuf.Len() == 0 {
+		return nil
+	}
+	if !bytes.HasPrefix(chunkBuf.Bytes(), []byte(parquetChunkPrefix)) {
+		return pc.rdf.Parse(chunkBuf)
+	}
+
+	line, err := chunkBuf.ReadString('\n')
+	if err != nil {
+		return errors.New("truncated Parquet chunk")
+	}
+	entry, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, parquetChunkPrefix)))
+	if err != nil || entry < 0 || entry >= len(pc.mapping.Files) {
+		return fmt.Errorf("invalid Parquet chunk %q", line)
+	}
+	f := pc.mapping.Files[entry]
+
+	dec := json.NewDecoder(chunkBuf)
+	for {
+		var record map[string][]string
+		if err := dec.Decode(&record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		nqs, err := f.mapRecord(func(c *ColumnMapping) []string {
+			return record[c.Column]
+		})
+		if err != nil {
+			return fmt.Errorf("while parsing record %v: %w", record, err)
+		}
+		pc.nqs.Push(nqs...)
+	}
+}
+
+func isParquetList(node parquet.Node) bool {
+	lt := node.Type().LogicalType()
+	return lt != nil && lt.List != nil && len(node.Fields()) == 1 &&
+		len(node.Fields()[0].Fields()) == 1
+}
+
+// parquetElement returns the node of the elements of a list.
+func parquetElement(node parquet.Node) parquet.Node {
+	return node.Fields()[0].Fields()[0]
+}
+
+func parquetField(node parquet.Node, name string) parquet.Node {
+	for _, field := range node.Fields() {
+		if field.Name() == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// parquetColumnExists tells whether the path leads to a leaf column of the node, going through
+// the lists on the way.
+func parquetColumnExists(node parquet.Node, path []string) bool {
+	for isParquetList(node) {
+		node = parquetElement(node)
+	}
+	if node.Leaf() {
+		return len(path) == 0
+	}
+	if len(path) == 0 {
+		return false
+	}
+	field := parquetField(node, path[0])
+	return field != nil && parquetColumnExists(field, path[1:])
+}
+
+// parquetValues returns the values of the column at the path of the value read for the node. The
+// values of the lists on the way are all returned.
+func parquetValues(node parquet.Node, v any, path []string) []string {
+	if v == nil {
+		return nil
+	}
+	if list, ok := v.([]any); ok {
+		elem := node
+		if isParquetList(node) {
+			elem = parquetElement(node)
+		}
+		var vals []string
+		for _, e := range list {
+			vals = append(vals, parquetValues(elem, e, path)...)
+		}
+		return vals
+	}
+	if node.Leaf() {
+		return []string{parquetString(node, v)}
+	}
+	group, ok := v.(map[string]any)
+	if !ok || len(path) == 0 {
+		return nil
+	}
+	field := parquetField(node, path[0])
+	if field == nil {
+		return nil
+	}
+	return parquetValues(field, group[path[0]], path[1:])
+}
+
+// parquetString formats a value of a leaf column, turning timestamps and dates into RFC 3339.
+func parquetString(node parquet.Node, v any) string {
+	if lt := node.Type().LogicalType(); lt != nil {
+		switch n, ok := parquetInt(v); {
+		case ok && lt.Timestamp != nil:
+			var t time.Time
+			switch unit := lt.Timestamp.Unit; {
+			case unit.Millis != nil:
+				t = time.UnixMilli(n)
+			case unit.Micros != nil:
+				t = time.UnixMicro(n)
+			default:
+				t = time.Unix(0, n)
+			}
+			return t.UTC().Format(time.RFC3339Nano)
+		case ok && lt.Date != nil:
+			return time.Unix(n*24*60*60, 0).UTC().Format(time.DateOnly)
+		}
+	}
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func parquetInt(v any) (int64, bool) {
+	switch v := v.(type) {
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
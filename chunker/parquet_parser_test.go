@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+)
+
+type testParquetAddress struct {
+	City string `parquet:"city"`
+}
+
+type testParquetFriend struct {
+	ID string `parquet:"id"`
+}
+
+type testParquetPerson struct {
+	ID      int64               `parquet:"id"`
+	Name    string              `parquet:"name"`
+	Score   *float64            `parquet:"score,optional"`
+	Born    time.Time           `parquet:"born,timestamp"`
+	Tags    []string            `parquet:"tags,list"`
+	Address testParquetAddress  `parquet:"address"`
+	Friends []testParquetFriend `parquet:"friends,list"`
+}
+
+const testParquetMapping = `{
+	"files": [{
+		"pattern": "people*.parquet",
+		"subject": {"column": "id", "xid": "person"},
+		"types": ["Person"],
+		"columns": [
+			{"column": "name", "predicate": "name"},
+			{"column": "score", "predicate": "score", "type": "float"},
+			{"column": "born", "predicate": "born"},
+			{"column": "tags", "predicate": "tag"},
+			{"column": "address.city", "predicate": "city"},
+			{"column": "friends.id", "predicate": "friend", "xid": "person"}
+		]
+	}]
+}`
+
+func TestParquetChunker(t *testing.T) {
+	m, err := ParseMapping([]byte(testParquetMapping))
+	require.NoError(t, err)
+
+	score := 1.5
+	born := time.Date(1990, 5, 2, 15, 4, 5, 0, time.UTC)
+	var file bytes.Buffer
+	w := parquet.NewGenericWriter[testParquetPerson](&file)
+	_, err = w.Write([]testParquetPerson{
+		{ID: 1, Name: "Alice", Score: &score, Born: born, Tags: []string{"a", "b"},
+			Address: testParquetAddress{City: "Paris"},
+			Friends: []testParquetFriend{{ID: "2"}, {ID: "3"}}},
+		{ID: 2, Name: "Bob", Born: born},
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	ck, err := NewParquetChunker(m, "/data/people.parquet", 0)
+	require.NoError(t, err)
+	chunk, err := ck.Chunk(bufio.NewReader(&file))
+	require.Equal(t, io.EOF, err)
+
+	parser, err := NewParquetChunker(m, "", 0)
+	require.NoError(t, err)
+	require.NoError(t, parser.Parse(chunk))
+	parser.NQuads().Flush()
+	nqs := <-parser.NQuads().Ch()
+
+	typ := &api.Value{Val: &api.Value_StrVal{StrVal: "Person"}}
+	require.Equal(t, []*api.NQuad{
+		{Subject: "_:person.1", Predicate: "dgraph.type", ObjectValue: typ},
+		{Subject: "_:person.1", Predicate: "name", ObjectValue: strVal("Alice")},
+		{Subject: "_:person.1", Predicate: "score",
+			ObjectValue: &api.Value{Val: &api.Value_DoubleVal{DoubleVal: 1.5}}},
+		{Subject: "_:person.1", Predicate: "born", ObjectValue: strVal("1990-05-02T15:04:05Z")},
+		{Subject: "_:person.1", Predicate: "tag", ObjectValue: strVal("a")},
+		{Subject: "_:person.1", Predicate: "tag", ObjectValue: strVal("b")},
+		{Subject: "_:person.1", Predicate: "city", ObjectValue: strVal("Paris")},
+		{Subject: "_:person.1", Predicate: "friend", ObjectId: "_:person.2"},
+		{Subject: "_:person.1", Predicate: "friend", ObjectId: "_:person.3"},
+		{Subject: "_:person.2", Predicate: "dgraph.type", ObjectValue: typ},
+		{Subject: "_:person.2", Predicate: "name", ObjectValue: strVal("Bob")},
+		{Subject: "_:person.2", Predicate: "born", ObjectValue: strVal("1990-05-02T15:04:05Z")},
+	}, nqs)
+}
+
+func TestParquetChunkerUnknownColumn(t *testing.T) {
+	m, err := ParseMapping([]byte(`{"files": [{"pattern": "*.parquet",
+		"subject": {"column": "id", "xid": "person"},
+		"columns": [{"column": "address.zip", "predicate": "zip"}]}]}`))
+	require.NoError(t, err)
+
+	var file bytes.Buffer
+	w := parquet.NewGenericWriter[testParquetPerson](&file)
+	_, err = w.Write([]testParquetPerson{{ID: 1}})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	ck, err := NewParquetChunker(m, "people.parquet", 0)
+	require.NoError(t, err)
+	_, err = ck.Chunk(bufio.NewReader(&file))
+	require.ErrorContains(t, err, `column "address.zip" not found in the schema of people.parquet`)
+}
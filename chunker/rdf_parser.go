@@ -69,19 +69,29 @@ func isSpaceRune(r rune) bool {
 // ParseRDF parses a mutation string and returns the N-Quad representation for it.
 // It parses N-Quad statements based on http://www.w3.org/TR/n-quads/.
 func ParseRDF(line string, l *lex.Lexer) (api.NQuad, error) {
+	rnq, graph, err := parseRDF(line, l)
+	if err == nil && graph != "" {
+		return rnq, fmt.Errorf("invalid namespace ID. Input: [%s]", line)
+	}
+	return rnq, err
+}
+
+// parseRDF parses the N-Quad like ParseRDF, and also returns its graph label if it isn't a
+// namespace ID.
+func parseRDF(line string, l *lex.Lexer) (api.NQuad, string, error) {
 	var rnq api.NQuad
 	line = strings.TrimSpace(line)
 	if len(line) == 0 {
-		return rnq, ErrEmpty
+		return rnq, "", ErrEmpty
 	}
 
 	l.Reset(line)
 	l.Run(lexText)
 	if err := l.ValidateResult(); err != nil {
-		return rnq, err
+		return rnq, "", err
 	}
 	it := l.NewIterator()
-	var oval string
+	var oval, graph string
 	var seenOval bool
 	var vend bool
 	isCommentLine := false
@@ -96,13 +106,13 @@ L:
 		case itemSubjectFunc:
 			var err error
 			if rnq.Subject, err = parseFunction(it); err != nil {
-				return rnq, err
+				return rnq, "", err
 			}
 
 		case itemObjectFunc:
 			var err error
 			if rnq.ObjectId, err = parseFunction(it); err != nil {
-				return rnq, err
+				return rnq, "", err
 			}
 
 		case itemPredicate:
@@ -126,7 +136,7 @@ L:
 			var err error
 			oval, err = strconv.Unquote(item.Val)
 			if err != nil {
-				return rnq, fmt.Errorf("while unquoting: %w", err)
+				return rnq, "", fmt.Errorf("while unquoting: %w", err)
 			}
 			seenOval = true
 
@@ -135,36 +145,27 @@ L:
 
 		case itemObjectType:
 			if rnq.Predicate == x.Star || rnq.Subject == x.Star {
-				return rnq, errors.New("if predicate/subject is *, value should be * as well")
+				return rnq, "", errors.New("if predicate/subject is *, value should be * as well")
 			}
 
 			val := strings.TrimFunc(item.Val, isSpaceRune)
 			// TODO: Check if this condition is required.
 			if val == "*" {
-				return rnq, errors.New("itemObject can't be *")
+				return rnq, "", errors.New("itemObject can't be *")
 			}
 			// Lets find out the storage type from the type map.
 			t, ok := typeMap[val]
 			if !ok {
-				return rnq, fmt.Errorf("unrecognized rdf type %s", val)
+				return rnq, "", fmt.Errorf("unrecognized rdf type %s", val)
 			}
 			if oval == "" && t != types.StringID {
-				return rnq, errors.New("invalid ObjectValue")
+				return rnq, "", errors.New("invalid ObjectValue")
 			}
-			src := types.ValueForType(types.StringID)
-			src.Value = []byte(oval)
-			// if this is a password value dont re-encrypt. issue#2765
-			if t == types.PasswordID {
-				src.Tid = t
-			}
-			p, err := types.Convert(src, t)
+			ov, err := typedValue(oval, t)
 			if err != nil {
-				return rnq, err
-			}
-
-			if rnq.ObjectValue, err = types.ObjectValue(t, p.Value); err != nil {
-				return rnq, err
+				return rnq, "", err
 			}
+			rnq.ObjectValue = ov
 		case itemComment:
 			isCommentLine = true
 			vend = true
@@ -172,13 +173,13 @@ L:
 		case itemValidEnd:
 			vend = true
 			if !it.Next() {
-				return rnq, fmt.Errorf("invalid end of input. Input: [%s]", line)
+				return rnq, "", fmt.Errorf("invalid end of input. Input: [%s]", line)
 			}
 			// RDF spec says N-Quads should be terminated with a newline. Since we break the input
 			// by newline already. We should get EOF or # after dot(.)
 			item = it.Item()
 			if !(item.Typ == lex.ItemEOF || item.Typ == itemComment) {
-				return rnq, fmt.Errorf("invalid end of input. Expected newline or # after ."+
+				return rnq, "", fmt.Errorf("invalid end of input. Expected newline or # after ."+
 					" Input: [%s]", line)
 			}
 			break L
@@ -187,23 +188,24 @@ L:
 			s := strings.TrimFunc(item.Val, isSpaceRune)
 			namespace, err := strconv.ParseUint(s, 0, 64)
 			if err != nil {
-				return rnq, fmt.Errorf("invalid namespace ID. Input: [%s]", line)
+				graph = s
+				continue
 			}
 			rnq.Namespace = namespace
 
 		case itemLeftRound:
 			it.Prev() // backup '('
 			if err := parseFacetsRDF(it, &rnq); err != nil {
-				return rnq, fmt.Errorf("could not parse facet: %w", err)
+				return rnq, "", fmt.Errorf("could not parse facet: %w", err)
 			}
 		}
 	}
 
 	if !vend {
-		return rnq, fmt.Errorf("invalid end of input. Input: [%s]", line)
+		return rnq, "", fmt.Errorf("invalid end of input. Input: [%s]", line)
 	}
 	if isCommentLine {
-		return rnq, ErrEmpty
+		return rnq, "", ErrEmpty
 	}
 	// We only want to set default value if we have seen ObjectValue within "" and if we didn't
 	// already set it.
@@ -211,16 +213,16 @@ L:
 		rnq.ObjectValue = &api.Value{Val: &api.Value_DefaultVal{DefaultVal: oval}}
 	}
 	if len(rnq.Subject) == 0 || len(rnq.Predicate) == 0 {
-		return rnq, fmt.Errorf("empty required fields in NQuad. Input: [%s]", line)
+		return rnq, "", fmt.Errorf("empty required fields in NQuad. Input: [%s]", line)
 	}
 	if len(rnq.ObjectId) == 0 && rnq.ObjectValue == nil {
-		return rnq, fmt.Errorf("no Object in NQuad. Input: [%s]", line)
+		return rnq, "", fmt.Errorf("no Object in NQuad. Input: [%s]", line)
 	}
 	if !sane(rnq.Subject) || !sane(rnq.Predicate) || !sane(rnq.ObjectId) {
-		return rnq, fmt.Errorf("NQuad failed sanity check:%+v", rnq)
+		return rnq, "", fmt.Errorf("NQuad failed sanity check:%+v", rnq)
 	}
 
-	return rnq, nil
+	return rnq, graph, nil
 }
 
 // parseFunction parses uid(<var name>) and returns
@@ -342,6 +344,21 @@ func calculateTypeHints(nqs []*api.NQuad) *pb.Metadata {
 	return &pb.Metadata{PredHints: predHints}
 }
 
+// typedValue converts the string value of a literal into a value of the given type.
+func typedValue(val string, t types.TypeID) (*api.Value, error) {
+	src := types.ValueForType(types.StringID)
+	src.Value = []byte(val)
+	// if this is a password value dont re-encrypt. issue#2765
+	if t == types.PasswordID {
+		src.Tid = t
+	}
+	p, err := types.Convert(src, t)
+	if err != nil {
+		return nil, err
+	}
+	return types.ObjectValue(t, p.Value)
+}
+
 var typeMap = map[string]types.TypeID{
 	"xs:password":        types.PasswordID,
 	"xs:string":          types.StringID,
@@ -357,6 +374,10 @@ var typeMap = map[string]types.TypeID{
 	"xs:decimal":         types.BigFloatID,
 	"geo:geojson":        types.GeoID,
 	"xs:[]float32":       types.VFloatID,
+	"xs:duration":        types.DurationID,
+	"xs:uuid":            types.UUIDID,
+	"xs:ip":              types.IPID,
+	"xs:json":            types.JSONID,
 	"http://www.w3.org/2001/XMLSchema#string":          types.StringID,
 	"http://www.w3.org/2001/XMLSchema#dateTime":        types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#date":            types.DateTimeID,
@@ -368,4 +389,5 @@ var typeMap = map[string]types.TypeID{
 	"http://www.w3.org/2001/XMLSchema#float":           types.FloatID,
 	"http://www.w3.org/2001/XMLSchema#gYear":           types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#gYearMonth":      types.DateTimeID,
+	"http://www.w3.org/2001/XMLSchema#duration":        types.DurationID,
 }
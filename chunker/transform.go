@@ -0,0 +1,226 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hypermodeinc/dgraph/v25/types"
+)
+
+// Transform rewrites the N-Quads parsed from the data files before they are loaded. The steps are
+// applied to each N-Quad in the order of the fields below: predicates are dropped by their name
+// in the data, then renamed and rewritten, the values are converted and the types are set after
+// the final name of the predicates, and the xids are prefixed last.
+//
+//	drop: [internal_id]
+//	rename:
+//	  fullName: name
+//	rewrite:
+//	  - match: "^http://schema.org/(.*)$"
+//	    replace: "$1"
+//	convert:
+//	  age: int
+//	types:
+//	  - type: Person
+//	    predicates: [name, email]
+//	xid_prefix: crm.
+type Transform struct {
+	// Drop lists the predicates whose N-Quads are dropped.
+	Drop []string `yaml:"drop"`
+	// Rename maps predicates to their new name.
+	Rename map[string]string `yaml:"rename"`
+	// Rewrite replaces the matches of regular expressions in the predicates, applying the rules in
+	// order.
+	Rewrite []*RewriteRule `yaml:"rewrite"`
+	// Convert maps predicates to the type their values are converted to, like int or datetime.
+	Convert map[string]string `yaml:"convert"`
+	// Types sets the dgraph.type of the nodes having any of the predicates of a rule.
+	Types []*TypeRule `yaml:"types"`
+	// XidPrefix is prepended to the xids of the nodes, and to the labels of blank nodes, so that
+	// data sets loaded one after another don't share their nodes. Uids are kept.
+	XidPrefix string `yaml:"xid_prefix"`
+
+	drop    map[string]struct{}
+	convert map[string]types.TypeID
+	types   map[string][]string
+}
+
+// RewriteRule replaces the matches of the regular expression Match in the predicates by Replace,
+// which can refer to the submatches like $1.
+type RewriteRule struct {
+	Match   string `yaml:"match"`
+	Replace string `yaml:"replace"`
+
+	re *regexp.Regexp
+}
+
+// TypeRule sets the type of the nodes having any of its predicates.
+type TypeRule struct {
+	Type       string   `yaml:"type"`
+	Predicates []string `yaml:"predicates"`
+}
+
+// ReadTransform reads the transform from a YAML file.
+func ReadTransform(file string) (*Transform, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("while reading the transform file %s: %w", file, err)
+	}
+	t, err := ParseTransform(b)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing the transform file %s: %w", file, err)
+	}
+	return t, nil
+}
+
+// ParseTransform parses and validates a transform.
+func ParseTransform(b []byte) (*Transform, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	var t Transform
+	if err := dec.Decode(&t); err == io.EOF {
+		return nil, errors.New("the transform is empty")
+	} else if err != nil {
+		return nil, err
+	}
+
+	t.drop = make(map[string]struct{}, len(t.Drop))
+	for _, pred := range t.Drop {
+		t.drop[pred] = struct{}{}
+	}
+	for _, r := range t.Rewrite {
+		if r.Match == "" {
+			return nil, errors.New("rewrite rules must have a match")
+		}
+		re, err := regexp.Compile(r.Match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rewrite rule %q: %w", r.Match, err)
+		}
+		r.re = re
+	}
+	t.convert = make(map[string]types.TypeID, len(t.Convert))
+	for pred, name := range t.Convert {
+		typ, ok := types.TypeForName(name)
+		if !ok || typ == types.UidID || typ == types.PasswordID {
+			return nil, fmt.Errorf("predicate %q is converted to an invalid type %q", pred, name)
+		}
+		t.convert[pred] = typ
+	}
+	t.types = make(map[string][]string)
+	for _, r := range t.Types {
+		if r.Type == "" || len(r.Predicates) == 0 {
+			return nil, errors.New("type rules must have a type and predicates")
+		}
+		for _, pred := range r.Predicates {
+			t.types[pred] = append(t.types[pred], r.Type)
+		}
+	}
+	return &t, nil
+}
+
+// predicate returns the new name of the predicate.
+func (t *Transform) predicate(pred string) string {
+	if name, ok := t.Rename[pred]; ok {
+		pred = name
+	}
+	for _, r := range t.Rewrite {
+		pred = r.re.ReplaceAllString(pred, r.Replace)
+	}
+	return pred
+}
+
+// node returns the node with its xid prefixed, unless it's a uid or refers to a variable.
+func (t *Transform) node(id string) string {
+	if t.XidPrefix == "" || id == "" {
+		return id
+	}
+	if uid, err := strconv.ParseUint(id, 0, 64); err == nil && uid != 0 {
+		return id
+	}
+	if strings.HasPrefix(id, "uid(") || strings.HasPrefix(id, "val(") {
+		return id
+	}
+	if label, ok := strings.CutPrefix(id, "_:"); ok {
+		return "_:" + t.XidPrefix + label
+	}
+	return t.XidPrefix + id
+}
+
+// convertValue converts the value of the N-Quad to the type.
+func convertValue(nq *api.NQuad, typ types.TypeID) error {
+	storage, err := types.StorageValue(nq.ObjectValue)
+	if err != nil {
+		return err
+	}
+	val, err := types.Convert(storage, typ)
+	if err != nil {
+		return fmt.Errorf("the value of <%s> <%s> isn't a valid %s: %w",
+			nq.Subject, nq.Predicate, typ.Name(), err)
+	}
+	ov, err := types.ObjectValue(typ, val.Value)
+	if err != nil {
+		return err
+	}
+	nq.ObjectValue = ov
+	return nil
+}
+
+// Apply transforms a batch of N-Quads, in place. The N-Quads whose value can't be converted are
+// dropped, and the first of the errors is returned along with the other N-Quads.
+func (t *Transform) Apply(nqs []*api.NQuad) ([]*api.NQuad, error) {
+	type node struct {
+		subject   string
+		namespace uint64
+		typ       string
+	}
+	typed := make(map[node]struct{})
+	var typeNqs []*api.NQuad
+	var firstErr error
+
+	out := nqs[:0]
+	for _, nq := range nqs {
+		if _, ok := t.drop[nq.Predicate]; ok {
+			continue
+		}
+		nq.Predicate = t.predicate(nq.Predicate)
+		if typ, ok := t.convert[nq.Predicate]; ok && nq.ObjectValue != nil {
+			if err := convertValue(nq, typ); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+		}
+		nq.Subject = t.node(nq.Subject)
+		nq.ObjectId = t.node(nq.ObjectId)
+		for _, typ := range t.types[nq.Predicate] {
+			n := node{subject: nq.Subject, namespace: nq.Namespace, typ: typ}
+			if _, ok := typed[n]; ok {
+				continue
+			}
+			typed[n] = struct{}{}
+			typeNqs = append(typeNqs, &api.NQuad{
+				Subject:     nq.Subject,
+				Predicate:   "dgraph.type",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: typ}},
+				Namespace:   nq.Namespace,
+			})
+		}
+		out = append(out, nq)
+	}
+	return append(out, typeNqs...), firstErr
+}
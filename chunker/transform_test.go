@@ -0,0 +1,71 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransform(t *testing.T) {
+	tr, err := ParseTransform([]byte(`
+drop: [internal_id]
+rename:
+  fullName: name
+rewrite:
+  - match: "^http://schema.org/(.*)$"
+    replace: "$1"
+convert:
+  age: int
+types:
+  - type: Person
+    predicates: [name, email]
+xid_prefix: crm.
+`))
+	require.NoError(t, err)
+
+	str := func(s string) *api.Value {
+		return &api.Value{Val: &api.Value_DefaultVal{DefaultVal: s}}
+	}
+	nqs := []*api.NQuad{
+		{Subject: "_:a", Predicate: "internal_id", ObjectValue: str("42")},
+		{Subject: "_:a", Predicate: "fullName", ObjectValue: str("Alice")},
+		{Subject: "_:a", Predicate: "http://schema.org/email", ObjectValue: str("a@b.c")},
+		{Subject: "_:a", Predicate: "age", ObjectValue: str("30")},
+		{Subject: "_:b", Predicate: "age", ObjectValue: str("thirty")},
+		{Subject: "alice", Predicate: "friend", ObjectId: "0x2a"},
+	}
+	nqs, err = tr.Apply(nqs)
+	require.ErrorContains(t, err, `the value of <_:b> <age> isn't a valid int`)
+
+	person := &api.Value{Val: &api.Value_StrVal{StrVal: "Person"}}
+	age := &api.Value{Val: &api.Value_IntVal{IntVal: 30}}
+	require.Equal(t, []*api.NQuad{
+		{Subject: "_:crm.a", Predicate: "name", ObjectValue: str("Alice")},
+		{Subject: "_:crm.a", Predicate: "email", ObjectValue: str("a@b.c")},
+		{Subject: "_:crm.a", Predicate: "age", ObjectValue: age},
+		{Subject: "crm.alice", Predicate: "friend", ObjectId: "0x2a"},
+		{Subject: "_:crm.a", Predicate: "dgraph.type", ObjectValue: person},
+	}, nqs)
+}
+
+func TestParseTransformErrors(t *testing.T) {
+	for _, tc := range []struct {
+		transform string
+		err       string
+	}{
+		{"", "the transform is empty"},
+		{"renames: {a: b}", "field renames not found"},
+		{"rewrite: [{match: '('}]", "invalid rewrite rule"},
+		{"convert: {age: number}", `predicate "age" is converted to an invalid type "number"`},
+		{"types: [{type: Person}]", "type rules must have a type and predicates"},
+	} {
+		_, err := ParseTransform([]byte(tc.transform))
+		require.ErrorContains(t, err, tc.err, tc.transform)
+	}
+}
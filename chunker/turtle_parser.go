@@ -0,0 +1,683 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hypermodeinc/dgraph/v25/lex"
+)
+
+const (
+	rdfNamespace = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema#"
+
+	// eof is returned by the parser when the document has no more runes.
+	eof = -1
+)
+
+// turtleChunker reads Turtle and TriG documents. Chunk turns their statements into N-Quads, with
+// the prefixes and the base of the document resolved, so the chunks are parsed as RDF like the
+// chunks of the other RDF files.
+type turtleChunker struct {
+	*rdfChunker
+	p *turtleParser
+	// bnode prefixes the blank nodes made for the anonymous nodes and the collections, keeping
+	// them apart from the ones of other documents and loads. The labelled blank nodes are kept
+	// as they are, like in the N-Quads files.
+	bnode  string
+	blanks int
+}
+
+// NewTurtleChunker returns a new chunker for Turtle and TriG documents. The triples of the named
+// graphs of TriG documents are mapped by graphs. If graphs is nil, they are loaded as if they
+// were in the default graph.
+func NewTurtleChunker(graphs *GraphMapping, batchSize int) Chunker {
+	return &turtleChunker{
+		rdfChunker: &rdfChunker{
+			nqs:    NewNQuadBuffer(batchSize),
+			lexer:  &lex.Lexer{},
+			graphs: graphs,
+		},
+		bnode: fmt.Sprintf("_:ttl.%x.", rand.Uint64()),
+	}
+}
+
+// Chunk reads statements of the document until about 1MB of N-Quads have been written or the end
+// of the document is reached.
+func (tc *turtleChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if tc.p == nil || tc.p.r != r {
+		tc.p = &turtleParser{r: r, line: 1, prefixes: make(map[string]string), tc: tc}
+	}
+	out := new(bytes.Buffer)
+	tc.p.out = out
+	for out.Len() < 1<<20 {
+		err := tc.p.statement()
+		if err == nil {
+			err = tc.p.err
+		}
+		switch {
+		case err == io.EOF:
+			return out, io.EOF
+		case err != nil:
+			return nil, fmt.Errorf("at line %d: %w", tc.p.line, err)
+		}
+	}
+	return out, nil
+}
+
+// turtleParser parses the statements of a Turtle or TriG document one at a time.
+type turtleParser struct {
+	r    *bufio.Reader
+	err  error
+	line int
+	tc   *turtleChunker
+	out  *bytes.Buffer
+
+	base     *url.URL
+	prefixes map[string]string
+	// inGraph tells whether the statements are read in a graph block, whose label is graph.
+	// The label is empty for the blocks of the default graph.
+	inGraph bool
+	graph   string
+}
+
+func (p *turtleParser) next() rune {
+	r, _, err := p.r.ReadRune()
+	if err != nil {
+		if err != io.EOF {
+			p.err = err
+		}
+		return eof
+	}
+	if r == '\n' {
+		p.line++
+	}
+	return r
+}
+
+func (p *turtleParser) peek() rune {
+	r, _, err := p.r.ReadRune()
+	if err != nil {
+		if err != io.EOF {
+			p.err = err
+		}
+		return eof
+	}
+	_ = p.r.UnreadRune()
+	return r
+}
+
+// peekString tells whether the document continues with s.
+func (p *turtleParser) peekString(s string) bool {
+	b, _ := p.r.Peek(len(s))
+	return string(b) == s
+}
+
+// ws skips the white space and the comments.
+func (p *turtleParser) ws() {
+	for {
+		switch r := p.peek(); {
+		case r == '#':
+			for r != '\n' && r != eof {
+				r = p.next()
+			}
+		case r == ' ' || r == '\t' || r == '\r' || r == '\n':
+			p.next()
+		default:
+			return
+		}
+	}
+}
+
+func (p *turtleParser) expect(r rune) error {
+	p.ws()
+	if got := p.next(); got != r {
+		return fmt.Errorf("expected '%c', found %s", r, runeString(got))
+	}
+	return nil
+}
+
+func runeString(r rune) string {
+	if r == eof {
+		return "end of file"
+	}
+	return fmt.Sprintf("'%c'", r)
+}
+
+// statement reads a directive, a group of triples or the start or the end of a graph block. It
+// returns io.EOF at the end of the document.
+func (p *turtleParser) statement() error {
+	p.ws()
+	switch r := p.peek(); {
+	case r == eof:
+		if p.inGraph {
+			return errors.New("unexpected end of file in a graph block")
+		}
+		return io.EOF
+	case r == '}' && p.inGraph:
+		p.next()
+		p.inGraph, p.graph = false, ""
+		return nil
+	case r == '{' && !p.inGraph:
+		p.next()
+		p.inGraph = true
+		return nil
+	case r == '@' && !p.inGraph:
+		p.next()
+		return p.directive(p.name(), true)
+	case r == '[':
+		subject, err := p.blankNodePropertyList()
+		if err != nil {
+			return err
+		}
+		p.ws()
+		if c := p.peek(); c != '.' && c != '}' {
+			if err := p.predicateObjectList(subject); err != nil {
+				return err
+			}
+		}
+		return p.end()
+	case r == '(':
+		subject, err := p.collection()
+		if err != nil {
+			return err
+		}
+		if err := p.predicateObjectList(subject); err != nil {
+			return err
+		}
+		return p.end()
+	}
+
+	var subject string
+	if r := p.peek(); r == '<' {
+		iri, err := p.iri()
+		if err != nil {
+			return err
+		}
+		subject = iri
+	} else {
+		name := p.name()
+		if keyword := strings.ToUpper(name); !p.inGraph &&
+			(keyword == "PREFIX" || keyword == "BASE") {
+			return p.directive(strings.ToLower(name), false)
+		} else if keyword == "GRAPH" && !p.inGraph {
+			p.ws()
+			graph, err := p.subject()
+			if err != nil {
+				return err
+			}
+			if err := p.expect('{'); err != nil {
+				return err
+			}
+			p.inGraph, p.graph = true, graph
+			return nil
+		}
+		term, err := p.nameTerm(name)
+		if err != nil {
+			return err
+		}
+		subject = term
+	}
+
+	p.ws()
+	if p.peek() == '{' && !p.inGraph {
+		p.next()
+		p.inGraph, p.graph = true, subject
+		return nil
+	}
+	if err := p.predicateObjectList(subject); err != nil {
+		return err
+	}
+	return p.end()
+}
+
+// end reads the dot ending the triples, which is optional for the last triples of graph blocks.
+func (p *turtleParser) end() error {
+	p.ws()
+	if p.inGraph && p.peek() == '}' {
+		return nil
+	}
+	return p.expect('.')
+}
+
+// directive reads a prefix or a base directive, which ends with a dot in the Turtle syntax and
+// has no dot in the SPARQL syntax.
+func (p *turtleParser) directive(name string, dot bool) error {
+	p.ws()
+	switch name {
+	case "prefix":
+		prefix := p.name()
+		if !strings.HasSuffix(prefix, ":") || strings.Count(prefix, ":") != 1 {
+			return fmt.Errorf("invalid prefix %q", prefix)
+		}
+		p.ws()
+		iri, err := p.iri()
+		if err != nil {
+			return err
+		}
+		p.prefixes[strings.TrimSuffix(prefix, ":")] = strings.Trim(iri, "<>")
+	case "base":
+		iri, err := p.iri()
+		if err != nil {
+			return err
+		}
+		base, err := url.Parse(strings.Trim(iri, "<>"))
+		if err != nil {
+			return fmt.Errorf("invalid base %s: %w", iri, err)
+		}
+		p.base = base
+	default:
+		return fmt.Errorf("unknown directive %q", name)
+	}
+	if dot {
+		return p.expect('.')
+	}
+	return nil
+}
+
+// isNameRune tells whether the rune can be part of a prefixed name or of a blank node label.
+func isNameRune(r rune) bool {
+	return r == '%' || r == '\\' || (r != eof && isPNChar(r))
+}
+
+// name reads a keyword, a prefixed name or a blank node label. Dots are part of the name only if
+// they are followed by another character of the name, and escaped characters are unescaped.
+func (p *turtleParser) name() string {
+	var sb strings.Builder
+	for {
+		r := p.peek()
+		switch {
+		case r == '.':
+			b, _ := p.r.Peek(2)
+			if len(b) < 2 || !isNameRune(rune(b[1])) && b[1] < utf8.RuneSelf {
+				return sb.String()
+			}
+		case r == '\\':
+			p.next()
+			r = p.peek()
+			if r == eof {
+				return sb.String()
+			}
+		case !isNameRune(r):
+			return sb.String()
+		}
+		sb.WriteRune(p.next())
+	}
+}
+
+// nameTerm returns the N-Quad term of a prefixed name or of a blank node label.
+func (p *turtleParser) nameTerm(name string) (string, error) {
+	if strings.HasPrefix(name, "_:") && len(name) > 2 {
+		return name, nil
+	}
+	prefix, local, ok := strings.Cut(name, ":")
+	if !ok {
+		if name == "" {
+			return "", fmt.Errorf("unexpected %s", runeString(p.peek()))
+		}
+		return "", fmt.Errorf("unexpected %q", name)
+	}
+	ns, ok := p.prefixes[prefix]
+	if !ok {
+		return "", fmt.Errorf("undefined prefix %q", prefix)
+	}
+	return "<" + p.resolve(ns+local) + ">", nil
+}
+
+// iri reads an IRI between angle brackets and returns it resolved against the base.
+func (p *turtleParser) iri() (string, error) {
+	if err := p.expect('<'); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for {
+		r := p.next()
+		switch {
+		case r == '>':
+			return "<" + p.resolve(sb.String()) + ">", nil
+		case r == '\\':
+			u, err := p.uchar()
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(u)
+		case r == eof || r <= ' ' || strings.ContainsRune(`<"{}|^`+"`", r):
+			return "", fmt.Errorf("invalid character %s in IRI", runeString(r))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+}
+
+// uchar reads the \u and \U escapes, whose backslash has been read.
+func (p *turtleParser) uchar() (rune, error) {
+	n := 4
+	switch p.next() {
+	case 'u':
+	case 'U':
+		n = 8
+	default:
+		return 0, errors.New("invalid escape sequence")
+	}
+	var hex strings.Builder
+	for range n {
+		hex.WriteRune(p.next())
+	}
+	u, err := strconv.ParseUint(hex.String(), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid escape sequence %q", hex.String())
+	}
+	return rune(u), nil
+}
+
+// resolve resolves the relative IRIs against the base. The relative IRIs are kept as they are if
+// there is no base, so that they are loaded like in the N-Quads files.
+func (p *turtleParser) resolve(iri string) string {
+	if p.base == nil {
+		return iri
+	}
+	u, err := url.Parse(iri)
+	if err != nil || u.IsAbs() {
+		return iri
+	}
+	return p.base.ResolveReference(u).String()
+}
+
+// subject reads an IRI, a prefixed name or a blank node label.
+func (p *turtleParser) subject() (string, error) {
+	if p.peek() == '<' {
+		return p.iri()
+	}
+	return p.nameTerm(p.name())
+}
+
+func (p *turtleParser) predicateObjectList(subject string) error {
+	for {
+		p.ws()
+		var predicate string
+		var err error
+		if p.peek() == '<' {
+			predicate, err = p.iri()
+		} else if name := p.name(); name == "a" {
+			predicate = "<" + rdfNamespace + "type>"
+		} else if predicate, err = p.nameTerm(name); err == nil && predicate[0] != '<' {
+			err = fmt.Errorf("the predicate %s isn't an IRI", predicate)
+		}
+		if err != nil {
+			return err
+		}
+
+		for {
+			object, err := p.object()
+			if err != nil {
+				return err
+			}
+			p.emit(subject, predicate, object)
+			p.ws()
+			if p.peek() != ',' {
+				break
+			}
+			p.next()
+		}
+
+		if p.peek() != ';' {
+			return nil
+		}
+		for p.peek() == ';' {
+			p.next()
+			p.ws()
+		}
+		if r := p.peek(); r == '.' || r == ']' || r == '}' || r == eof {
+			return nil
+		}
+	}
+}
+
+func (p *turtleParser) object() (string, error) {
+	p.ws()
+	switch r := p.peek(); {
+	case r == '<':
+		return p.iri()
+	case r == '[':
+		return p.blankNodePropertyList()
+	case r == '(':
+		return p.collection()
+	case r == '"' || r == '\'':
+		return p.literal()
+	case r == '+' || r == '-' || r == '.' || (r >= '0' && r <= '9'):
+		return p.number()
+	}
+	switch name := p.name(); name {
+	case "true", "false":
+		return `"` + name + `"^^<` + xsdNamespace + "boolean>", nil
+	default:
+		return p.nameTerm(name)
+	}
+}
+
+func (p *turtleParser) blank() string {
+	p.tc.blanks++
+	return p.tc.bnode + strconv.Itoa(p.tc.blanks)
+}
+
+// blankNodePropertyList reads the triples of an anonymous node and returns the node.
+func (p *turtleParser) blankNodePropertyList() (string, error) {
+	p.next() // '['
+	node := p.blank()
+	p.ws()
+	if p.peek() == ']' {
+		p.next()
+		return node, nil
+	}
+	if err := p.predicateObjectList(node); err != nil {
+		return "", err
+	}
+	return node, p.expect(']')
+}
+
+// collection reads a list of objects, which becomes a chain of rdf:first and rdf:rest edges
+// ending with rdf:nil, and returns its first node.
+func (p *turtleParser) collection() (string, error) {
+	p.next() // '('
+	head := "<" + rdfNamespace + "nil>"
+	var node string
+	for {
+		p.ws()
+		if p.peek() == ')' {
+			p.next()
+			break
+		}
+		object, err := p.object()
+		if err != nil {
+			return "", err
+		}
+		next := p.blank()
+		if node == "" {
+			head = next
+		} else {
+			p.emit(node, "<"+rdfNamespace+"rest>", next)
+		}
+		node = next
+		p.emit(node, "<"+rdfNamespace+"first>", object)
+	}
+	if node != "" {
+		p.emit(node, "<"+rdfNamespace+"rest>", "<"+rdfNamespace+"nil>")
+	}
+	return head, nil
+}
+
+// literal reads a string with its language tag or its datatype. The datatypes which Dgraph
+// doesn't know are dropped, so that their values are loaded as untyped strings.
+func (p *turtleParser) literal() (string, error) {
+	q := p.next()
+	delim := string(q)
+	if p.peekString(delim + delim) {
+		p.next()
+		p.next()
+		delim = strings.Repeat(delim, 3)
+	}
+	var sb strings.Builder
+	for {
+		r := p.next()
+		switch {
+		case r == eof:
+			return "", errors.New("unexpected end of file in a string")
+		case r == q && len(delim) == 1:
+		case r == q && p.peekString(delim[1:]) && !p.peekString(delim):
+			p.next()
+			p.next()
+		case r == '\\':
+			e, err := p.echar()
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(e)
+			continue
+		case (r == '\n' || r == '\r') && len(delim) == 1:
+			return "", errors.New("unexpected end of line in a string")
+		default:
+			sb.WriteRune(r)
+			continue
+		}
+		break
+	}
+	value := quoteLiteral(sb.String())
+
+	switch {
+	case p.peek() == '@':
+		p.next()
+		var lang strings.Builder
+		for r := p.peek(); r == '-' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+			(r >= '0' && r <= '9'); r = p.peek() {
+			lang.WriteRune(p.next())
+		}
+		if lang.Len() == 0 {
+			return "", errors.New("empty language tag")
+		}
+		return value + "@" + lang.String(), nil
+	case p.peekString("^^"):
+		p.next()
+		p.next()
+		datatype, err := p.subject()
+		if err != nil {
+			return "", err
+		}
+		if _, ok := typeMap[strings.Trim(datatype, "<>")]; ok {
+			return value + "^^" + datatype, nil
+		}
+	}
+	return value, nil
+}
+
+// echar reads the escapes of the strings, whose backslash has been read.
+func (p *turtleParser) echar() (rune, error) {
+	switch r := p.peek(); r {
+	case 't':
+		p.next()
+		return '\t', nil
+	case 'b':
+		p.next()
+		return '\b', nil
+	case 'n':
+		p.next()
+		return '\n', nil
+	case 'r':
+		p.next()
+		return '\r', nil
+	case 'f':
+		p.next()
+		return '\f', nil
+	case '"', '\'', '\\':
+		p.next()
+		return r, nil
+	default:
+		return p.uchar()
+	}
+}
+
+// number reads an integer, a decimal or a double. The decimals are loaded as floats.
+func (p *turtleParser) number() (string, error) {
+	var sb strings.Builder
+	digits := func() {
+		for r := p.peek(); r >= '0' && r <= '9'; r = p.peek() {
+			sb.WriteRune(p.next())
+		}
+	}
+	if r := p.peek(); r == '+' || r == '-' {
+		sb.WriteRune(p.next())
+	}
+	digits()
+	datatype := "integer"
+	if p.peek() == '.' {
+		if b, _ := p.r.Peek(2); len(b) == 2 && b[1] >= '0' && b[1] <= '9' {
+			sb.WriteRune(p.next())
+			digits()
+			datatype = "double"
+		}
+	}
+	if r := p.peek(); r == 'e' || r == 'E' {
+		sb.WriteRune(p.next())
+		if r := p.peek(); r == '+' || r == '-' {
+			sb.WriteRune(p.next())
+		}
+		digits()
+		datatype = "double"
+	}
+	n := strings.TrimLeft(sb.String(), "+-")
+	if n == "" || n == "." || strings.HasSuffix(n, "e") || strings.HasSuffix(n, "E") {
+		return "", fmt.Errorf("invalid number %q", sb.String())
+	}
+	return `"` + sb.String() + `"^^<` + xsdNamespace + datatype + ">", nil
+}
+
+// emit writes the N-Quad of the triple, labelled with the graph if the graphs are mapped.
+func (p *turtleParser) emit(subject, predicate, object string) {
+	p.out.WriteString(subject)
+	p.out.WriteByte(' ')
+	p.out.WriteString(predicate)
+	p.out.WriteByte(' ')
+	p.out.WriteString(object)
+	if p.graph != "" && p.tc.graphs != nil {
+		p.out.WriteByte(' ')
+		p.out.WriteString(p.graph)
+	}
+	p.out.WriteString(" .\n")
+}
+
+// quoteLiteral quotes the string with the escapes understood by the RDF parser.
+func quoteLiteral(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r < ' ' || r == 0x7f:
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
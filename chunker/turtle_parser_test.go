@@ -0,0 +1,184 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package chunker
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/types"
+)
+
+// chunkAndParseTurtle chunks the document and parses the chunks on another chunker, like the bulk
+// loader does.
+func chunkAndParseTurtle(t *testing.T, graphs *GraphMapping, doc string) []*api.NQuad {
+	ck := NewTurtleChunker(graphs, 0)
+	ck.(*turtleChunker).bnode = "_:b"
+	var chunks []*bytes.Buffer
+	r := bufioReader(doc)
+	for {
+		chunk, err := ck.Chunk(r)
+		if chunk != nil {
+			chunks = append(chunks, chunk)
+		}
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+
+	parser := NewTurtleChunker(graphs, 0)
+	for _, chunk := range chunks {
+		require.NoError(t, parser.Parse(chunk))
+	}
+	parser.NQuads().Flush()
+	var nqs []*api.NQuad
+	for batch := range parser.NQuads().Ch() {
+		nqs = append(nqs, batch...)
+	}
+	return nqs
+}
+
+func TestTurtleChunker(t *testing.T) {
+	doc := `
+@prefix ex: <http://example.org/> .
+@base <http://example.org/people/> .
+PREFIX foaf: <http://xmlns.com/foaf/0.1/>
+
+# Alice knows Bob and Carol.
+<alice> a foaf:Person ;
+	foaf:name "Alice"@en, 'Alicia'@es ;
+	foaf:knows ex:bob, [ foaf:name "Carol" ] ;
+	ex:age 30 ;
+	ex:score -1.5 ;
+	ex:admin true ;
+	ex:bio """Likes "graphs"
+and tea.""" ;
+	ex:born "1990-05-02"^^<http://www.w3.org/2001/XMLSchema#date> ;
+	ex:code "A1"^^ex:code ;
+	ex:tags ( "a" ex:b ) ;
+	ex:none () .
+_:x ex:v.1 ex:a.b.
+`
+	nqs := chunkAndParseTurtle(t, nil, doc)
+
+	born, err := typedValue("1990-05-02", types.DateTimeID)
+	require.NoError(t, err)
+	iri := func(s string) string { return "http://example.org/" + s }
+	foaf := func(s string) string { return "http://xmlns.com/foaf/0.1/" + s }
+	alice := iri("people/alice")
+	require.Equal(t, []*api.NQuad{
+		{Subject: alice, Predicate: rdfNamespace + "type", ObjectId: foaf("Person")},
+		{Subject: alice, Predicate: foaf("name"), Lang: "en", ObjectValue: strVal("Alice")},
+		{Subject: alice, Predicate: foaf("name"), Lang: "es", ObjectValue: strVal("Alicia")},
+		{Subject: alice, Predicate: foaf("knows"), ObjectId: iri("bob")},
+		{Subject: "_:b1", Predicate: foaf("name"), ObjectValue: strVal("Carol")},
+		{Subject: alice, Predicate: foaf("knows"), ObjectId: "_:b1"},
+		{Subject: alice, Predicate: iri("age"),
+			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 30}}},
+		{Subject: alice, Predicate: iri("score"),
+			ObjectValue: &api.Value{Val: &api.Value_DoubleVal{DoubleVal: -1.5}}},
+		{Subject: alice, Predicate: iri("admin"),
+			ObjectValue: &api.Value{Val: &api.Value_BoolVal{BoolVal: true}}},
+		{Subject: alice, Predicate: iri("bio"),
+			ObjectValue: strVal("Likes \"graphs\"\nand tea.")},
+		{Subject: alice, Predicate: iri("born"), ObjectValue: born},
+		{Subject: alice, Predicate: iri("code"), ObjectValue: strVal("A1")},
+		{Subject: "_:b2", Predicate: rdfNamespace + "first", ObjectValue: strVal("a")},
+		{Subject: "_:b2", Predicate: rdfNamespace + "rest", ObjectId: "_:b3"},
+		{Subject: "_:b3", Predicate: rdfNamespace + "first", ObjectId: iri("b")},
+		{Subject: "_:b3", Predicate: rdfNamespace + "rest", ObjectId: rdfNamespace + "nil"},
+		{Subject: alice, Predicate: iri("tags"), ObjectId: "_:b2"},
+		{Subject: alice, Predicate: iri("none"), ObjectId: rdfNamespace + "nil"},
+		{Subject: "_:x", Predicate: iri("v.1"), ObjectId: iri("a.b")},
+	}, nqs)
+}
+
+func TestTriGChunker(t *testing.T) {
+	doc := `
+@prefix ex: <http://example.org/> .
+ex:a ex:p "default" .
+ex:g1 { ex:a ex:p "one" . ex:b ex:p "two" ; ex:q "three" }
+GRAPH ex:g2 { ex:a ex:p "four" . }
+{ ex:c ex:p "five" }
+`
+	graphs := &GraphMapping{
+		Predicate:  "graph",
+		Namespaces: map[string]uint64{"http://example.org/g2": 2},
+	}
+	nqs := chunkAndParseTurtle(t, graphs, doc)
+	a, b, c := "http://example.org/a", "http://example.org/b", "http://example.org/c"
+	p, q := "http://example.org/p", "http://example.org/q"
+	g1, g2 := "http://example.org/g1", "http://example.org/g2"
+	require.Equal(t, []*api.NQuad{
+		{Subject: a, Predicate: p, ObjectValue: strVal("default")},
+		{Subject: a, Predicate: p, ObjectValue: strVal("one")},
+		{Subject: a, Predicate: "graph", ObjectId: g1},
+		{Subject: b, Predicate: p, ObjectValue: strVal("two")},
+		{Subject: b, Predicate: "graph", ObjectId: g1},
+		{Subject: b, Predicate: q, ObjectValue: strVal("three")},
+		{Subject: a, Predicate: p, ObjectValue: strVal("four"), Namespace: 2},
+		{Subject: a, Predicate: "graph", ObjectId: g2, Namespace: 2},
+		{Subject: c, Predicate: p, ObjectValue: strVal("five")},
+	}, nqs)
+
+	// Without a mapping, the triples of the named graphs are loaded as the default graph.
+	nqs = chunkAndParseTurtle(t, nil, doc)
+	require.Len(t, nqs, 6)
+	for _, nq := range nqs {
+		require.Zero(t, nq.Namespace)
+	}
+}
+
+func TestTurtleChunkerErrors(t *testing.T) {
+	tests := []struct {
+		doc string
+		err string
+	}{
+		{"ex:a ex:b ex:c .", `at line 1: undefined prefix "ex"`},
+		{"@prefix ex: <http://example.org/> .\n<a> ex:b \"c\"",
+			"at line 2: expected '.', found end of file"},
+		{"<a> <b> \"c\n\" .", "unexpected end of line in a string"},
+		{"<a> <b> <c d> .", "invalid character ' ' in IRI"},
+		{"<g> { <a> <b> <c> .", "unexpected end of file in a graph block"},
+		{"@foo <a> .", `unknown directive "foo"`},
+	}
+	for _, tc := range tests {
+		ck := NewTurtleChunker(nil, 0)
+		_, err := ck.Chunk(bufioReader(tc.doc))
+		require.ErrorContains(t, err, tc.err, tc.doc)
+	}
+}
+
+func TestRDFChunkerGraphs(t *testing.T) {
+	data := "<a> <p> \"1\" <http://example.org/g> .\n" +
+		"<a> <q> \"2\" <http://example.org/g> .\n" +
+		"<b> <p> \"3\" _:g .\n" +
+		"<c> <p> \"4\" <0x3> .\n"
+
+	ck := NewRDFChunker(&GraphMapping{
+		Predicate:  "graph",
+		Namespaces: map[string]uint64{"_:g": 2},
+	}, 0)
+	require.NoError(t, ck.Parse(bytes.NewBufferString(data)))
+	ck.NQuads().Flush()
+	require.Equal(t, []*api.NQuad{
+		{Subject: "a", Predicate: "p", ObjectValue: strVal("1")},
+		{Subject: "a", Predicate: "graph", ObjectId: "http://example.org/g"},
+		{Subject: "a", Predicate: "q", ObjectValue: strVal("2")},
+		{Subject: "b", Predicate: "p", ObjectValue: strVal("3"), Namespace: 2},
+		{Subject: "b", Predicate: "graph", ObjectId: "_:g", Namespace: 2},
+		{Subject: "c", Predicate: "p", ObjectValue: strVal("4"), Namespace: 3},
+	}, <-ck.NQuads().Ch())
+
+	// Without a mapping, the graph labels must be namespace IDs.
+	ck = NewChunker(RdfFormat, 0)
+	require.ErrorContains(t, ck.Parse(bytes.NewBufferString(data)), "invalid namespace ID")
+}
@@ -72,11 +72,13 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"fulltext",
 		"func",
 		"ge",
+		"geodistance",
 		"gt",
 		"index",
 		"intersects",
 		"le",
 		"len",
+		"length",
 		"ln",
 		"logbase",
 		"lt",
@@ -85,6 +87,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"min",
 		"mutation",
 		"near",
+		"nearest",
 		"ngram",
 		"not",
 		"offset",
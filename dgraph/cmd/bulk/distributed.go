@@ -0,0 +1,574 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package bulk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// A distributed load runs the map phase and the reduce jobs of the groups on workers, which can be
+// on other hosts, coordinated by a leader over HTTP on the --http address of the leader. The
+// workers map their share of the data files into --shared-dir, with the uids of the xids assigned
+// by the leader. The leader then merges the map shards into reduce shards, which the workers reduce
+// into the --out directory, also shared, and finally writes the schema.
+const (
+	registerPath = "/bulk/register"
+	xidsPath     = "/bulk/xids"
+	progressPath = "/bulk/progress"
+	mappedPath   = "/bulk/mapped"
+	reducePath   = "/bulk/reduce"
+	reducedPath  = "/bulk/reduced"
+)
+
+type registerResponse struct {
+	Id           int    `json:"id"`
+	Workers      int    `json:"workers"`
+	WriteTs      uint64 `json:"write_ts"`
+	MapShards    int    `json:"map_shards"`
+	ReduceShards int    `json:"reduce_shards"`
+	SkipMap      bool   `json:"skip_map"`
+}
+
+type xidEntry struct {
+	Namespace uint64 `json:"namespace"`
+	Xid       string `json:"xid"`
+}
+
+type xidResponse struct {
+	Uids []uint64 `json:"uids"`
+	New  []bool   `json:"new"`
+}
+
+type workerProgress struct {
+	Id          int   `json:"id"`
+	NQuads      int64 `json:"nquads"`
+	Errors      int64 `json:"errors"`
+	MapEdges    int64 `json:"map_edges"`
+	ReduceEdges int64 `json:"reduce_edges"`
+	ReduceKeys  int64 `json:"reduce_keys"`
+}
+
+type mappedRequest struct {
+	Id int `json:"id"`
+	// Meta is the marshaled pb.BulkMeta of the worker, holding its schema.
+	Meta       []byte   `json:"meta"`
+	Namespaces []uint64 `json:"namespaces"`
+}
+
+type reduceResponse struct {
+	// Shard is the reduce shard to reduce, or -1 if all of them are assigned.
+	Shard int `json:"shard"`
+	// Meta is the marshaled pb.BulkMeta of the leader, holding the schema of all the workers.
+	Meta []byte `json:"meta"`
+}
+
+type reducedRequest struct {
+	Id    int `json:"id"`
+	Shard int `json:"shard"`
+	// Lists are the predicates forced to be lists by the reduce job.
+	Lists [][]byte `json:"lists"`
+}
+
+// leader coordinates the workers of a distributed load.
+type leader struct {
+	*loader
+	sync.Mutex
+	workers   []workerProgress
+	mapped    int
+	mappedCh  chan struct{} // Closed when all the workers have mapped their data files.
+	meta      []byte
+	metaCh    chan struct{} // Closed when the reduce shards are ready to be reduced.
+	nextShard int
+	reduced   int
+	reducedCh chan struct{} // Closed when all the reduce shards are reduced.
+	xidsDB    *badger.DB    // The DB of the xid map, if it's persisted with --xidmap.
+	// xidsLock guards the xid map, which is closed after the map phase while late requests of
+	// the workers may still use it.
+	xidsLock sync.RWMutex
+}
+
+func newLeader(ld *loader) *leader {
+	l := &leader{
+		loader:    ld,
+		mappedCh:  make(chan struct{}),
+		metaCh:    make(chan struct{}),
+		reducedCh: make(chan struct{}),
+	}
+	if !ld.opt.SkipMapPhase {
+		l.xidsDB = ld.openXidMap()
+	}
+	http.HandleFunc(registerPath, l.handle(l.register))
+	http.HandleFunc(xidsPath, l.handle(l.assignXids))
+	http.HandleFunc(progressPath, l.handle(l.updateProgress))
+	http.HandleFunc(mappedPath, l.handle(l.workerMapped))
+	http.HandleFunc(reducePath, l.handle(l.assignShard))
+	http.HandleFunc(reducedPath, l.handle(l.shardReduced))
+	fmt.Printf("Waiting for %d map worker(s) at %s\n", ld.opt.MapWorkers, ld.opt.HttpAddr)
+	return l
+}
+
+// handle returns the HTTP handler decoding the JSON request for fn, and encoding its response.
+func (l *leader) handle(fn func(req []byte) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := io.ReadAll(r.Body)
+		if err == nil {
+			var resp interface{}
+			if resp, err = fn(req); err == nil {
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					fmt.Printf("Error while sending the response to %s: %v\n", r.RemoteAddr, err)
+				}
+				return
+			}
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func (l *leader) register([]byte) (interface{}, error) {
+	l.Lock()
+	defer l.Unlock()
+	if len(l.workers) == l.opt.MapWorkers {
+		return nil, errors.Errorf("all the %d workers are registered", l.opt.MapWorkers)
+	}
+	id := len(l.workers)
+	l.workers = append(l.workers, workerProgress{Id: id})
+	fmt.Printf("Registered worker %d of %d\n", id+1, l.opt.MapWorkers)
+	return &registerResponse{
+		Id:           id,
+		Workers:      l.opt.MapWorkers,
+		WriteTs:      l.writeTs,
+		MapShards:    l.opt.MapShards,
+		ReduceShards: l.opt.ReduceShards,
+		SkipMap:      l.opt.SkipMapPhase,
+	}, nil
+}
+
+func (l *leader) assignXids(req []byte) (interface{}, error) {
+	var xids []xidEntry
+	if err := json.Unmarshal(req, &xids); err != nil {
+		return nil, err
+	}
+	l.xidsLock.RLock()
+	defer l.xidsLock.RUnlock()
+	if l.xids == nil {
+		return nil, errors.New("the map phase is over")
+	}
+	resp := &xidResponse{Uids: make([]uint64, len(xids)), New: make([]bool, len(xids))}
+	for i, e := range xids {
+		resp.Uids[i], resp.New[i] = l.xids.AssignUid(x.NamespaceAttr(e.Namespace, e.Xid))
+	}
+	return resp, nil
+}
+
+func (l *leader) updateProgress(req []byte) (interface{}, error) {
+	var p workerProgress
+	if err := json.Unmarshal(req, &p); err != nil {
+		return nil, err
+	}
+	l.Lock()
+	defer l.Unlock()
+	if p.Id < 0 || p.Id >= len(l.workers) {
+		return nil, errors.Errorf("unknown worker %d", p.Id)
+	}
+	l.workers[p.Id] = p
+	l.setProgress()
+	return struct{}{}, nil
+}
+
+// setProgress sets the progress of the leader to the total progress of the workers.
+func (l *leader) setProgress() {
+	var sum workerProgress
+	for _, w := range l.workers {
+		sum.NQuads += w.NQuads
+		sum.Errors += w.Errors
+		sum.MapEdges += w.MapEdges
+		sum.ReduceEdges += w.ReduceEdges
+		sum.ReduceKeys += w.ReduceKeys
+	}
+	if !l.opt.SkipMapPhase {
+		// The edges mapped are otherwise read from the bulk meta file.
+		atomic.StoreInt64(&l.prog.nquadCount, sum.NQuads)
+		atomic.StoreInt64(&l.prog.errCount, sum.Errors)
+		atomic.StoreInt64(&l.prog.mapEdgeCount, sum.MapEdges)
+	}
+	atomic.StoreInt64(&l.prog.reduceEdgeCount, sum.ReduceEdges)
+	atomic.StoreInt64(&l.prog.reduceKeyCount, sum.ReduceKeys)
+}
+
+// workerMapped merges the schema of the worker, which has mapped its data files.
+func (l *leader) workerMapped(req []byte) (interface{}, error) {
+	var r mappedRequest
+	if err := json.Unmarshal(req, &r); err != nil {
+		return nil, err
+	}
+	var meta pb.BulkMeta
+	if err := proto.Unmarshal(r.Meta, &meta); err != nil {
+		return nil, err
+	}
+	l.Lock()
+	defer l.Unlock()
+	if r.Id < 0 || r.Id >= len(l.workers) {
+		return nil, errors.Errorf("unknown worker %d", r.Id)
+	}
+
+	l.schema.Lock()
+	for pred, sch := range meta.SchemaMap {
+		if existing, ok := l.schema.schemaMap[pred]; ok {
+			existing.List = existing.List || sch.List
+			continue
+		}
+		l.schema.schemaMap[pred] = sch
+	}
+	types := make(map[string]struct{}, len(l.schema.types))
+	for _, typ := range l.schema.types {
+		types[typ.TypeName] = struct{}{}
+	}
+	for _, typ := range meta.Types {
+		if _, ok := types[typ.TypeName]; !ok {
+			l.schema.types = append(l.schema.types, typ)
+		}
+	}
+	l.schema.Unlock()
+	for _, ns := range r.Namespaces {
+		l.namespaces.Store(ns, struct{}{})
+	}
+
+	l.workers[r.Id].MapEdges = meta.EdgeCount
+	l.setProgress()
+	l.mapped++
+	fmt.Printf("Worker %d has mapped its data files\n", r.Id)
+	if l.mapped == l.opt.MapWorkers {
+		close(l.mappedCh)
+	}
+	return struct{}{}, nil
+}
+
+// assignShard assigns the next reduce shard to the worker, once the reduce shards are ready.
+func (l *leader) assignShard([]byte) (interface{}, error) {
+	<-l.metaCh
+	l.Lock()
+	defer l.Unlock()
+	resp := &reduceResponse{Shard: -1}
+	if l.nextShard < l.opt.ReduceShards {
+		resp.Shard, resp.Meta = l.nextShard, l.meta
+		l.nextShard++
+	}
+	return resp, nil
+}
+
+func (l *leader) shardReduced(req []byte) (interface{}, error) {
+	var r reducedRequest
+	if err := json.Unmarshal(req, &r); err != nil {
+		return nil, err
+	}
+	for _, pred := range r.Lists {
+		l.schema.setSchemaAsList(string(pred))
+	}
+	l.Lock()
+	defer l.Unlock()
+	l.reduced++
+	fmt.Printf("Worker %d has reduced shard %d\n", r.Id, r.Shard)
+	if l.reduced == l.opt.ReduceShards {
+		close(l.reducedCh)
+	}
+	return struct{}{}, nil
+}
+
+// mapStage waits for the workers to map their data files.
+func (l *leader) mapStage() {
+	l.prog.setPhase(mapPhase)
+	<-l.mappedCh
+	l.xidsLock.Lock()
+	defer l.xidsLock.Unlock()
+	l.closeXidMap(l.xidsDB)
+}
+
+// reduceStage hands the reduce shards out to the workers, and waits for them to be reduced.
+func (l *leader) reduceStage() {
+	l.prog.setPhase(reducePhase)
+	l.schema.RLock()
+	meta, err := proto.Marshal(&pb.BulkMeta{
+		EdgeCount: atomic.LoadInt64(&l.prog.mapEdgeCount),
+		SchemaMap: l.schema.schemaMap,
+		Types:     l.schema.types,
+	})
+	l.schema.RUnlock()
+	x.Check(err)
+	l.Lock()
+	l.meta = meta
+	l.Unlock()
+	close(l.metaCh)
+	<-l.reducedCh
+
+	// Open the DBs reduced by the workers to write the schema.
+	r := reducer{state: l.state}
+	for i := range l.opt.ReduceShards {
+		r.createBadger(i)
+	}
+}
+
+// workerClient is the client of a worker of a distributed load to its leader.
+type workerClient struct {
+	leader  string
+	id      int
+	workers int
+	client  *http.Client
+}
+
+// registerWorker registers the worker with the leader, waiting for the leader to be up.
+func registerWorker(addr string) (*workerClient, *registerResponse) {
+	w := &workerClient{leader: "http://" + addr, client: &http.Client{}}
+	var resp registerResponse
+	for {
+		err := w.call(registerPath, struct{}{}, &resp)
+		if err == nil {
+			break
+		}
+		fmt.Printf("Error registering with the leader at %s, retrying: %v\n", addr, err)
+		time.Sleep(time.Second)
+	}
+	w.id, w.workers = resp.Id, resp.Workers
+	fmt.Printf("Registered as worker %d of %d\n", w.id+1, w.workers)
+	return w, &resp
+}
+
+// call posts the request to the path of the leader, and decodes its response into resp.
+func (w *workerClient) call(path string, req, resp interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	hr, err := w.client.Post(w.leader+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer hr.Body.Close()
+	data, err := io.ReadAll(hr.Body)
+	if err != nil {
+		return err
+	}
+	if hr.StatusCode != http.StatusOK {
+		return errors.Errorf("%s: %s", hr.Status, bytes.TrimSpace(data))
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.Unmarshal(data, resp)
+}
+
+// share returns the data files mapped by the worker.
+func (w *workerClient) share(files []string) []string {
+	var share []string
+	for i, file := range files {
+		if i%w.workers == w.id {
+			share = append(share, file)
+		}
+	}
+	fmt.Printf("Mapping %d of the %d data files\n", len(share), len(files))
+	return share
+}
+
+// reportProgress reports the progress of the worker to the leader until the returned function is
+// called.
+func (w *workerClient) reportProgress(prog *progress) func() {
+	report := func() {
+		p := workerProgress{
+			Id:          w.id,
+			NQuads:      atomic.LoadInt64(&prog.nquadCount),
+			Errors:      atomic.LoadInt64(&prog.errCount),
+			MapEdges:    atomic.LoadInt64(&prog.mapEdgeCount),
+			ReduceEdges: atomic.LoadInt64(&prog.reduceEdgeCount),
+			ReduceKeys:  atomic.LoadInt64(&prog.reduceKeyCount),
+		}
+		if err := w.call(progressPath, &p, nil); err != nil {
+			fmt.Printf("Error reporting the progress to the leader: %v\n", err)
+		}
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		t := time.NewTicker(5 * time.Second)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				report()
+			case <-done:
+				report()
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// resolveXids assigns the uids of the xids of the N-Quads by the leader, so that all the workers
+// assign the same uids to the same xids.
+func (m *mapper) resolveXids(nqs []*api.NQuad) {
+	var xids []xidEntry
+	seen := make(map[string]struct{})
+	add := func(xid string, ns uint64) {
+		if !m.opt.NewUids {
+			if _, err := strconv.ParseUint(xid, 0, 64); err == nil {
+				return
+			}
+		}
+		key := x.NamespaceAttr(ns, xid)
+		if _, ok := seen[key]; ok || m.xids.CheckUid(key) {
+			return
+		}
+		seen[key] = struct{}{}
+		xids = append(xids, xidEntry{Namespace: ns, Xid: xid})
+	}
+	for _, nq := range nqs {
+		ns := nq.Namespace
+		if m.opt.Namespace != math.MaxUint64 {
+			ns = m.opt.Namespace
+		}
+		add(nq.Subject, ns)
+		if nq.ObjectValue == nil {
+			add(nq.ObjectId, ns)
+		}
+	}
+	if len(xids) == 0 {
+		return
+	}
+
+	var resp xidResponse
+	x.Checkf(m.worker.call(xidsPath, xids, &resp), "Unable to assign the uids of the xids")
+	x.AssertTrue(len(resp.Uids) == len(xids) && len(resp.New) == len(xids))
+	for i, e := range xids {
+		m.xids.SetUid(x.NamespaceAttr(e.Namespace, e.Xid), resp.Uids[i])
+		if resp.New[i] {
+			m.storeXid(e.Xid, e.Namespace)
+		}
+	}
+}
+
+// runWorker runs the map phase and the reduce jobs assigned by the leader of a distributed load.
+func runWorker(opt *BulkOptions) {
+	w, reg := registerWorker(opt.Leader)
+	opt.MapShards, opt.ReduceShards = reg.MapShards, reg.ReduceShards
+	// The leader keeps the xid map, and creates the output directories.
+	opt.ClientDir = ""
+	for i := range opt.ReduceShards {
+		opt.shardOutputDirs = append(opt.shardOutputDirs,
+			filepath.Join(opt.OutDir, strconv.Itoa(i), "p"))
+	}
+
+	ld := newLoader(opt)
+	ld.worker = w
+	ld.writeTs = reg.WriteTs
+	stop := w.reportProgress(ld.prog)
+
+	if !reg.SkipMap {
+		ld.mapStage()
+		meta, err := proto.Marshal(&pb.BulkMeta{
+			EdgeCount: atomic.LoadInt64(&ld.prog.mapEdgeCount),
+			SchemaMap: ld.schema.schemaMap,
+			Types:     ld.schema.types,
+		})
+		x.Check(err)
+		req := &mappedRequest{Id: w.id, Meta: meta}
+		ld.namespaces.Range(func(key, value interface{}) bool {
+			req.Namespaces = append(req.Namespaces, key.(uint64))
+			return true
+		})
+		x.Checkf(w.call(mappedPath, req, nil), "Unable to report the map phase to the leader")
+	}
+
+	ld.prog.setPhase(reducePhase)
+	r := reducer{
+		state:     ld.state,
+		streamIds: make(map[string]uint32),
+	}
+	for {
+		var resp reduceResponse
+		x.Checkf(w.call(reducePath, struct{}{}, &resp), "Unable to get a reduce shard")
+		if resp.Shard < 0 {
+			break
+		}
+		var meta pb.BulkMeta
+		x.Check(proto.Unmarshal(resp.Meta, &meta))
+		ld.schema.schemaMap, ld.schema.types = meta.SchemaMap, meta.Types
+		lists := make(map[string]bool, len(meta.SchemaMap))
+		for pred, sch := range meta.SchemaMap {
+			lists[pred] = sch.GetList()
+		}
+
+		fmt.Printf("Reducing shard %d\n", resp.Shard)
+		dirs := readShardDirs(filepath.Join(opt.shardsDir(), reduceShardDir))
+		x.AssertTrue(len(dirs) == opt.ReduceShards)
+		db := r.createBadgerInternal(opt.shardOutputDirs[resp.Shard], true)
+		r.reduceShard(dirs[resp.Shard], db, r.createTmpBadger())
+		x.Check(db.Close())
+
+		req := &reducedRequest{Id: w.id, Shard: resp.Shard}
+		for pred, sch := range ld.schema.schemaMap {
+			if sch.GetList() && !lists[pred] {
+				req.Lists = append(req.Lists, []byte(pred))
+			}
+		}
+		x.Checkf(w.call(reducedPath, req, nil), "Unable to report the reduce job to the leader")
+	}
+	stop()
+	ld.cleanup()
+}
+
+// shardsDir returns the directory of the map and reduce shards, which is shared by the workers of
+// a distributed load.
+func (opt *BulkOptions) shardsDir() string {
+	if opt.SharedDir != "" {
+		return opt.SharedDir
+	}
+	return opt.TmpDir
+}
+
+// checkDistributed checks the options of a distributed load.
+func checkDistributed(opt *BulkOptions) {
+	if opt.MapWorkers == 0 && opt.Leader == "" {
+		return
+	}
+	var err error
+	switch {
+	case opt.MapWorkers > 0 && opt.Leader != "":
+		err = errors.New("--map-workers is set on the leader, and --leader on the workers")
+	case opt.SharedDir == "":
+		err = errors.New("a distributed load needs --shared-dir")
+	case opt.Incremental:
+		err = errors.New("--incremental can't be distributed")
+	case opt.MapWorkers < 0:
+		err = errors.New("--map-workers must be positive")
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid flags: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package bulk
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	"github.com/hypermodeinc/dgraph/v25/filestore"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// dryRun parses the data files and checks them against the schema, without writing anything. It
+// prints the report of the checks, and returns whether the data matches the schema.
+func dryRun(opt *BulkOptions) bool {
+	st := &state{opt: opt}
+	if opt.MappingFile != "" {
+		var err error
+		st.mapping, err = chunker.ReadMapping(opt.MappingFile)
+		x.Check(err)
+	}
+
+	fs := filestore.NewFileStore(opt.DataFiles)
+	files := fs.FindDataFiles(opt.DataFiles, dataFileSuffixes)
+	if len(files) == 0 {
+		fmt.Printf("No data files found in %s.\n", opt.DataFiles)
+		return false
+	}
+	// As in the map phase, all the files have the format of the first one.
+	loadType := chunker.DataFormat(files[0], opt.DataFormat)
+	if loadType == chunker.UnknownFormat {
+		fmt.Printf("Need --format=rdf, --format=json, --format=csv, --format=parquet, "+
+			"--format=turtle or --format=jsonld to load %s\n", files[0])
+		return false
+	}
+	if (loadType == chunker.CsvFormat || loadType == chunker.ParquetFormat) && st.mapping == nil {
+		fmt.Printf("Need --mapping to load %s\n", files[0])
+		return false
+	}
+
+	key := opt.EncryptionKey
+	if !opt.Encrypted {
+		key = nil
+	}
+	c := chunker.NewChecker(readSchema(opt), opt.Namespace)
+	if opt.TransformFile != "" {
+		t, err := chunker.ReadTransform(opt.TransformFile)
+		x.Check(err)
+		c.SetTransform(t)
+	}
+	for i, file := range files {
+		fmt.Printf("Checking file (%d out of %d): %s\n", i+1, len(files), file)
+		r, cleanup := fs.ChunkReader(file, key)
+		c.CheckFile(file, r, st.newChunker(loadType, file))
+		cleanup()
+	}
+
+	fmt.Println()
+	x.Check(c.Report(os.Stdout))
+	return !c.Failed()
+}
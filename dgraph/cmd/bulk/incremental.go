@@ -0,0 +1,361 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// overwriteBatch is the number of nodes whose values are checked by a query of checkOverwrites.
+const overwriteBatch = 1000
+
+// clusterState is what an incremental load needs to know of the cluster it loads into, so that its
+// output is keyed consistently with the existing data.
+type clusterState struct {
+	// groups is the number of groups of the cluster, and so the number of reduce shards.
+	groups int
+	// tablets maps the predicates of the cluster to the group serving them.
+	tablets map[string]uint32
+	// schema holds the schema of the predicates of the cluster in the namespace loaded.
+	schema map[string]*pb.SchemaUpdate
+	// types holds the types of the cluster in the namespace loaded.
+	types map[string]*pb.TypeUpdate
+	// maxUid is the largest uid leased by the cluster before the load. Only the nodes up to it
+	// can have values in the cluster.
+	maxUid uint64
+
+	sync.Mutex
+	// overwrites holds, by predicate, the nodes of the cluster given a value of an indexed scalar
+	// predicate of the cluster, whose existing value would keep its index entries.
+	overwrites map[string]map[uint64]struct{}
+}
+
+type clusterSchema struct {
+	Predicates []struct {
+		Predicate  string   `json:"predicate"`
+		Type       string   `json:"type"`
+		Index      bool     `json:"index"`
+		Tokenizer  []string `json:"tokenizer"`
+		Reverse    bool     `json:"reverse"`
+		Count      bool     `json:"count"`
+		List       bool     `json:"list"`
+		Upsert     bool     `json:"upsert"`
+		Lang       bool     `json:"lang"`
+		NoConflict bool     `json:"no_conflict"`
+		Unique     bool     `json:"unique"`
+	} `json:"schema"`
+	Types []struct {
+		Name   string `json:"name"`
+		Fields []struct {
+			Name string `json:"name"`
+		} `json:"fields"`
+	} `json:"types"`
+}
+
+// readClusterState reads the tablets of the cluster from zero, and the schema of the namespace
+// loaded from the alpha of the connection string.
+func readClusterState(opt *BulkOptions) (*clusterState, error) {
+	zero := connectToZero(opt.ZeroAddr)
+	defer func() {
+		if err := zero.Close(); err != nil {
+			fmt.Printf("Error while closing the connection to zero: %v\n", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	stream, err := pb.NewZeroClient(zero).StreamMembership(ctx, &api.Payload{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while connecting to zero at %s", opt.ZeroAddr)
+	}
+	ms, err := stream.Recv()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the membership state from zero")
+	}
+
+	cs := &clusterState{
+		groups:     len(ms.GetGroups()),
+		tablets:    make(map[string]uint32),
+		schema:     make(map[string]*pb.SchemaUpdate),
+		types:      make(map[string]*pb.TypeUpdate),
+		maxUid:     ms.GetMaxUID(),
+		overwrites: make(map[string]map[uint64]struct{}),
+	}
+	for gid, group := range ms.GetGroups() {
+		// The output directories and the reduce shards are numbered after the groups.
+		if gid == 0 || int(gid) > cs.groups {
+			return nil, errors.Errorf("the groups of the cluster aren't numbered from 1 to %d",
+				cs.groups)
+		}
+		for pred := range group.GetTablets() {
+			cs.tablets[pred] = gid
+		}
+	}
+
+	dg, err := dgo.Open(opt.ConnStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while connecting to alpha at %s", opt.ConnStr)
+	}
+	defer dg.Close()
+	resp, err := dg.NewReadOnlyTxn().Query(ctx, "schema {}")
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the schema of the cluster")
+	}
+	ns := opt.Namespace
+	if ns == math.MaxUint64 {
+		ns = x.RootNamespace
+	}
+	if err := cs.setSchema(resp.GetJson(), ns); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// setSchema sets the schema of the cluster from the response of a schema query in the namespace.
+func (cs *clusterState) setSchema(data []byte, ns uint64) error {
+	var sch clusterSchema
+	if err := json.Unmarshal(data, &sch); err != nil {
+		return errors.Wrapf(err, "while reading the schema of the cluster")
+	}
+	for _, p := range sch.Predicates {
+		tid, ok := types.TypeForName(p.Type)
+		if !ok {
+			return errors.Errorf("predicate %q of the cluster has an unknown type %q",
+				p.Predicate, p.Type)
+		}
+		su := &pb.SchemaUpdate{
+			ValueType:  tid.Enum(),
+			List:       p.List,
+			Count:      p.Count,
+			Upsert:     p.Upsert,
+			Lang:       p.Lang,
+			NoConflict: p.NoConflict,
+			Unique:     p.Unique,
+		}
+		switch {
+		case p.Reverse:
+			su.Directive = pb.SchemaUpdate_REVERSE
+		case p.Index:
+			su.Directive = pb.SchemaUpdate_INDEX
+			su.Tokenizer = p.Tokenizer
+		}
+		cs.schema[x.NamespaceAttr(ns, p.Predicate)] = su
+	}
+	for _, t := range sch.Types {
+		typ := &pb.TypeUpdate{TypeName: x.NamespaceAttr(ns, t.Name)}
+		for _, f := range t.Fields {
+			typ.Fields = append(typ.Fields, &pb.SchemaUpdate{Predicate: x.NamespaceAttr(ns, f.Name)})
+		}
+		cs.types[typ.TypeName] = typ
+	}
+	return nil
+}
+
+// exists returns whether the predicate already exists in the cluster.
+func (cs *clusterState) exists(pred string) bool {
+	_, ok := cs.tablets[pred]
+	return ok
+}
+
+// mergeSchema returns the schema of the predicates and the types loaded, merged with the schema of
+// the cluster. The predicates of the cluster keep their schema, and the types of the cluster gain
+// the fields of the types of the same name loaded.
+func (cs *clusterState) mergeSchema(schemaMap map[string]*pb.SchemaUpdate,
+	typs []*pb.TypeUpdate) []*pb.TypeUpdate {
+
+	for pred, su := range cs.schema {
+		if loaded, ok := schemaMap[pred]; ok && !x.IsReservedPredicate(pred) {
+			if !proto.Equal(loaded, su) {
+				fmt.Printf("Predicate %q already exists in the cluster, keeping its schema\n",
+					x.ParseAttr(pred))
+			}
+		}
+		schemaMap[pred] = su
+	}
+
+	var merged []*pb.TypeUpdate
+	for _, typ := range typs {
+		existing, ok := cs.types[typ.TypeName]
+		if !ok {
+			merged = append(merged, typ)
+			continue
+		}
+		typ = proto.Clone(typ).(*pb.TypeUpdate)
+		fields := make(map[string]struct{})
+		for _, f := range typ.Fields {
+			fields[f.Predicate] = struct{}{}
+		}
+		for _, f := range existing.Fields {
+			if _, ok := fields[f.Predicate]; !ok {
+				typ.Fields = append(typ.Fields, f)
+			}
+		}
+		merged = append(merged, typ)
+	}
+	return merged
+}
+
+// checkEdge fails the load if the edge can't be loaded incrementally: if its predicate exists in
+// the cluster but its schema is unknown, as it's in another namespace, or if the predicate has a
+// count index, which can't be updated without the existing data.
+func (cs *clusterState) checkEdge(attr string, sch *pb.SchemaUpdate, known bool) {
+	if !cs.exists(attr) {
+		return
+	}
+	if !known {
+		fmt.Fprintf(os.Stderr, "Predicate %q exists in the cluster, but not in the schema of the "+
+			"namespace loaded. Load one namespace at a time with --force-namespace.\n",
+			x.ParseAttr(attr))
+		os.Exit(1)
+	}
+	if sch.GetCount() {
+		fmt.Fprintf(os.Stderr, "Predicate %q of the cluster has a count index, which can't be "+
+			"updated by an incremental load.\n", x.ParseAttr(attr))
+		os.Exit(1)
+	}
+}
+
+// checkValue notes the node of the value edge if it may overwrite a value of the cluster that has
+// index entries: if the predicate is an indexed scalar of the cluster, and the node may exist in
+// the cluster. The index entries of the cluster can't be updated without its data, so the nodes
+// noted are checked by checkOverwrites once mapped.
+func (cs *clusterState) checkValue(de *pb.DirectedEdge, sch *pb.SchemaUpdate) {
+	if !cs.exists(de.Attr) || sch.GetList() || len(sch.GetTokenizer()) == 0 ||
+		de.Entity > cs.maxUid {
+		return
+	}
+	cs.Lock()
+	defer cs.Unlock()
+	uids, ok := cs.overwrites[de.Attr]
+	if !ok {
+		uids = make(map[uint64]struct{})
+		cs.overwrites[de.Attr] = uids
+	}
+	uids[de.Entity] = struct{}{}
+}
+
+// checkOverwrites fails the load if any of the nodes noted by checkValue already has a value of
+// the predicate in the cluster. A node having a value in another language is reported too.
+func (cs *clusterState) checkOverwrites(opt *BulkOptions) {
+	cs.Lock()
+	defer cs.Unlock()
+	if len(cs.overwrites) == 0 {
+		return
+	}
+
+	dg, err := dgo.Open(opt.ConnStr)
+	x.Checkf(err, "Unable to connect to alpha at %s", opt.ConnStr)
+	defer dg.Close()
+
+	for attr, set := range cs.overwrites {
+		uids := make([]uint64, 0, len(set))
+		for uid := range set {
+			uids = append(uids, uid)
+		}
+		slices.Sort(uids)
+
+		var found []string
+		for batch := range slices.Chunk(uids, overwriteBatch) {
+			valued, err := valuedNodes(dg, x.ParseAttr(attr), batch)
+			x.Checkf(err, "Unable to read the values of %q in the cluster", x.ParseAttr(attr))
+			found = append(found, valued...)
+		}
+		if len(found) > 0 {
+			fmt.Fprintf(os.Stderr, "Predicate %q of the cluster is indexed, and %d node(s) of the "+
+				"load already have a value of it, like %s. Their index entries can't be updated by "+
+				"an incremental load, load their values with mutations instead.\n",
+				x.ParseAttr(attr), len(found), found[0])
+			os.Exit(1)
+		}
+	}
+}
+
+// valuedNodes returns the nodes among the uids that have a value of the predicate in the cluster.
+func valuedNodes(dg *dgo.Dgraph, pred string, uids []uint64) ([]string, error) {
+	hex := make([]string, len(uids))
+	for i, uid := range uids {
+		hex[i] = fmt.Sprintf("%#x", uid)
+	}
+	q := fmt.Sprintf("{ q(func: uid(%s)) @filter(has(<%s>)) { uid } }",
+		strings.Join(hex, ","), pred)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := dg.NewReadOnlyTxn().Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Q []struct {
+			Uid string `json:"uid"`
+		} `json:"q"`
+	}
+	if err := json.Unmarshal(resp.GetJson(), &res); err != nil {
+		return nil, err
+	}
+	found := make([]string, 0, len(res.Q))
+	for _, n := range res.Q {
+		found = append(found, n.Uid)
+	}
+	return found, nil
+}
+
+// mergeIncrementalShards moves each map shard into the reduce shard of the same number, as they
+// are numbered after the groups serving their predicates.
+func mergeIncrementalShards(opt *BulkOptions) {
+	for i := range opt.ReduceShards {
+		dir := fmt.Sprintf("%03d", i)
+		// The reducers read the shards in the order of their names.
+		shardDir := filepath.Join(opt.shardsDir(), reduceShardDir, "shard_"+dir)
+		x.Check(os.MkdirAll(shardDir, 0750))
+		mapShard := filepath.Join(opt.shardsDir(), mapShardDir, dir)
+		if _, err := os.Stat(mapShard); os.IsNotExist(err) {
+			continue
+		}
+		fmt.Printf("Shard %s -> Reduce %s\n", mapShard, shardDir)
+		x.Check(os.Rename(mapShard, filepath.Join(shardDir, dir)))
+	}
+}
+
+// toDelta returns the postings of the posting list as a delta, which is added to the posting list
+// of the key in the cluster when read, instead of replacing it as a complete posting list would.
+func toDelta(pl *pb.PostingList, uids []uint64) []byte {
+	postings := make(map[uint64]*pb.Posting, len(pl.Postings))
+	for _, p := range pl.Postings {
+		postings[p.Uid] = p
+	}
+	delta := &pb.PostingList{Postings: make([]*pb.Posting, 0, len(uids))}
+	for _, uid := range uids {
+		p, ok := postings[uid]
+		if !ok {
+			p = &pb.Posting{Uid: uid, PostingType: pb.Posting_REF}
+		}
+		p.Op = posting.Set
+		delta.Postings = append(delta.Postings, p)
+	}
+	data, err := proto.Marshal(delta)
+	x.Check(err)
+	return data
+}
@@ -44,6 +44,8 @@ type BulkOptions struct {
 	DataFormat       string
 	SchemaFile       string
 	GqlSchemaFile    string
+	MappingFile      string
+	TransformFile    string
 	OutDir           string
 	ReplaceOutDir    bool
 	TmpDir           string
@@ -64,6 +66,11 @@ type BulkOptions struct {
 	ClientDir        string
 	Encrypted        bool
 	EncryptedOut     bool
+	DryRun           bool
+	Incremental      bool
+	SharedDir        string
+	MapWorkers       int
+	Leader           string
 
 	MapShards    int
 	ReduceShards int
@@ -71,6 +78,8 @@ type BulkOptions struct {
 	Namespace uint64
 
 	shardOutputDirs []string
+	// cluster is the state of the cluster loaded into by an incremental load.
+	cluster *clusterState
 
 	// ........... Badger options ..........
 	// EncryptionKey is the key used for encryption.
@@ -79,6 +88,11 @@ type BulkOptions struct {
 	Badger badger.Options
 }
 
+// dataFileSuffixes are the suffixes of the data files found in the --files directories.
+var dataFileSuffixes = []string{".rdf", ".rdf.gz", ".json", ".json.gz", ".csv", ".csv.gz", ".tsv",
+	".tsv.gz", ".parquet", ".nq", ".nq.gz", ".ttl", ".ttl.gz", ".trig", ".trig.gz", ".jsonld",
+	".jsonld.gz"}
+
 type state struct {
 	opt           *BulkOptions
 	prog          *progress
@@ -91,6 +105,9 @@ type state struct {
 	tmpDbs        []*badger.DB // Temporary DB to write the split lists to avoid ordering issues.
 	writeTs       uint64       // All badger writes use this timestamp
 	namespaces    *sync.Map    // To store the encountered namespaces.
+	mapping       *chunker.Mapping
+	transform     *chunker.Transform
+	worker        *workerClient // Set if the loader is a worker of a distributed load.
 }
 
 type loader struct {
@@ -107,18 +124,7 @@ func newLoader(opt *BulkOptions) *loader {
 
 	var zero *grpc.ClientConn
 	if opt.ZeroAddr != "" {
-		fmt.Printf("Connecting to zero at %s\n", opt.ZeroAddr)
-
-		tlsConf, err := x.LoadClientTLSConfigForInternalPort(Bulk.Conf)
-		x.Check(err)
-		dialOpts := []grpc.DialOption{}
-		if tlsConf != nil {
-			dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
-		} else {
-			dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-		}
-		zero, err = grpc.NewClient(opt.ZeroAddr, dialOpts...)
-		x.Checkf(err, "Unable to connect to zero, Is it running at %s?", opt.ZeroAddr)
+		zero = connectToZero(opt.ZeroAddr)
 	}
 
 	var dg *dgo.Dgraph
@@ -139,20 +145,56 @@ func newLoader(opt *BulkOptions) *loader {
 		writeTs:       getWriteTimestamp(zero, dg),
 		namespaces:    &sync.Map{},
 	}
+	st.shards.hashed = opt.Leader != ""
+	if opt.cluster != nil {
+		// The predicates of the cluster are reduced into the shard of the group serving them.
+		for pred, gid := range opt.cluster.tablets {
+			st.shards.predToShard[pred] = int(gid) - 1
+		}
+	}
 	st.schema = newSchemaStore(readSchema(opt), opt, st)
+	if opt.MappingFile != "" {
+		var err error
+		st.mapping, err = chunker.ReadMapping(opt.MappingFile)
+		x.Check(err)
+	}
+	if opt.TransformFile != "" {
+		var err error
+		st.transform, err = chunker.ReadTransform(opt.TransformFile)
+		x.Check(err)
+	}
 	ld := &loader{
 		state:   st,
 		mappers: make([]*mapper, opt.NumGoroutines),
 		zero:    zero,
 		dg:      dg,
 	}
-	for i := range opt.NumGoroutines {
-		ld.mappers[i] = newMapper(st)
+	if opt.MapWorkers == 0 {
+		// The leader of a distributed load leaves the map phase to its workers.
+		for i := range opt.NumGoroutines {
+			ld.mappers[i] = newMapper(st)
+		}
 	}
 	go ld.prog.report()
 	return ld
 }
 
+func connectToZero(addr string) *grpc.ClientConn {
+	fmt.Printf("Connecting to zero at %s\n", addr)
+
+	tlsConf, err := x.LoadClientTLSConfigForInternalPort(Bulk.Conf)
+	x.Check(err)
+	dialOpts := []grpc.DialOption{}
+	if tlsConf != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	zero, err := grpc.NewClient(addr, dialOpts...)
+	x.Checkf(err, "Unable to connect to zero, Is it running at %s?", addr)
+	return zero
+}
+
 func getWriteTimestamp(zero *grpc.ClientConn, dg *dgo.Dgraph) uint64 {
 	if zero != nil {
 		client := pb.NewZeroClient(zero)
@@ -284,8 +326,8 @@ func genDQLSchema(opt *BulkOptions) *schema.ParsedSchema {
 	return &finalSch
 }
 
-func (ld *loader) mapStage() {
-	ld.prog.setPhase(mapPhase)
+// openXidMap opens the xid map of the loader, and returns its DB if it's persisted with --xidmap.
+func (ld *loader) openXidMap() *badger.DB {
 	var db *badger.DB
 	if len(ld.opt.ClientDir) > 0 {
 		x.Check(os.MkdirAll(ld.opt.ClientDir, 0700))
@@ -300,24 +342,49 @@ func (ld *loader) mapStage() {
 		DgClient:    ld.dg,
 		Dir:         filepath.Join(ld.opt.TmpDir, bufferDir),
 	})
+	return db
+}
+
+// closeXidMap flushes the xid map of the loader and closes its DB.
+func (ld *loader) closeXidMap(db *badger.DB) {
+	x.Check(ld.xids.Flush())
+	if db != nil {
+		x.Check(db.Close())
+	}
+	ld.xids = nil
+}
+
+func (ld *loader) mapStage() {
+	ld.prog.setPhase(mapPhase)
+	db := ld.openXidMap()
 
 	fs := filestore.NewFileStore(ld.opt.DataFiles)
 
-	files := fs.FindDataFiles(ld.opt.DataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz"})
+	files := fs.FindDataFiles(ld.opt.DataFiles, dataFileSuffixes)
 	if len(files) == 0 {
 		fmt.Printf("No data files found in %s.\n", ld.opt.DataFiles)
 		os.Exit(1)
 	}
 
 	// Because mappers must handle chunks that may be from different input files, they must all
-	// assume the same data format, either RDF or JSON. Use the one specified by the user or by
-	// the first load file.
+	// assume the same data format, either RDF, JSON, CSV, Parquet, Turtle or JSON-LD. Use the one
+	// specified by the user or by the first load file. CSV and Parquet chunks carry the entry of the
+	// mapping of their file, so they can be parsed by any mapper, and Turtle and JSON-LD chunks are
+	// N-Quads.
 	loadType := chunker.DataFormat(files[0], ld.opt.DataFormat)
 	if loadType == chunker.UnknownFormat {
 		// Dont't try to detect JSON input in bulk loader.
-		fmt.Printf("Need --format=rdf or --format=json to load %s", files[0])
+		fmt.Printf("Need --format=rdf, --format=json, --format=csv, --format=parquet, "+
+			"--format=turtle or --format=jsonld to load %s", files[0])
+		os.Exit(1)
+	}
+	if (loadType == chunker.CsvFormat || loadType == chunker.ParquetFormat) && ld.mapping == nil {
+		fmt.Printf("Need --mapping to load %s", files[0])
 		os.Exit(1)
 	}
+	if ld.worker != nil {
+		files = ld.worker.share(files)
+	}
 
 	var mapperWg sync.WaitGroup
 	mapperWg.Add(len(ld.mappers))
@@ -344,7 +411,7 @@ func (ld *loader) mapStage() {
 			r, cleanup := fs.ChunkReader(file, key)
 			defer cleanup()
 
-			chunk := chunker.NewChunker(loadType, 1000)
+			chunk := ld.newChunker(loadType, file)
 			for {
 				chunkBuf, err := chunk.Chunk(r)
 				if chunkBuf != nil && chunkBuf.Len() > 0 {
@@ -360,21 +427,22 @@ func (ld *loader) mapStage() {
 	}
 	x.Check(thr.Finish())
 
-	// Send the graphql triples
-	ld.processGqlSchema(loadType)
+	// Send the graphql triples, once for a distributed load.
+	if ld.worker == nil || ld.worker.id == 0 {
+		ld.processGqlSchema(loadType)
+	}
 
 	close(ld.readerChunkCh)
 	mapperWg.Wait()
+	if ld.opt.cluster != nil {
+		ld.opt.cluster.checkOverwrites(ld.opt)
+	}
 
 	// Allow memory to GC before the reduce phase.
 	for i := range ld.mappers {
 		ld.mappers[i] = nil
 	}
-	x.Check(ld.xids.Flush())
-	if db != nil {
-		x.Check(db.Close())
-	}
-	ld.xids = nil
+	ld.closeXidMap(db)
 }
 
 func parseGqlSchema(s string) map[uint64]string {
@@ -420,6 +488,36 @@ func readGqlSchema(opt *BulkOptions) []byte {
 	return buf
 }
 
+// newChunker returns a chunker of the format for the file. For the mappers, the file is empty
+// as the CSV and Parquet chunks are self-describing.
+func (st *state) newChunker(loadType chunker.InputFormat, file string) chunker.Chunker {
+	var chunk chunker.Chunker
+	var err error
+	switch loadType {
+	case chunker.CsvFormat:
+		chunk, err = chunker.NewCSVChunker(st.mapping, file, 1000)
+	case chunker.ParquetFormat:
+		chunk, err = chunker.NewParquetChunker(st.mapping, file, 1000)
+	case chunker.RdfFormat, chunker.TurtleFormat, chunker.JsonldFormat:
+		var graphs *chunker.GraphMapping
+		if st.mapping != nil {
+			graphs = st.mapping.Graphs
+		}
+		switch loadType {
+		case chunker.RdfFormat:
+			chunk = chunker.NewRDFChunker(graphs, 1000)
+		case chunker.TurtleFormat:
+			chunk = chunker.NewTurtleChunker(graphs, 1000)
+		default:
+			chunk = chunker.NewJSONLDChunker(graphs, 1000)
+		}
+	default:
+		chunk = chunker.NewChunker(loadType, 1000)
+	}
+	x.Check(err)
+	return chunk
+}
+
 func (ld *loader) processGqlSchema(loadType chunker.InputFormat) {
 	if ld.opt.GqlSchemaFile == "" {
 		return
@@ -446,7 +544,10 @@ func (ld *loader) processGqlSchema(loadType chunker.InputFormat) {
 		gqlBuf := &bytes.Buffer{}
 		schema = strconv.Quote(schema)
 		switch loadType {
-		case chunker.RdfFormat:
+		case chunker.RdfFormat, chunker.CsvFormat, chunker.ParquetFormat, chunker.TurtleFormat,
+			chunker.JsonldFormat:
+			// The CSV, Parquet, Turtle and JSON-LD chunkers parse the chunks they didn't make as
+			// RDF.
 			_, err := fmt.Fprintf(gqlBuf, rdfSchema, ns, ns, schema, ns)
 			x.Check(err)
 		case chunker.JsonFormat:
@@ -133,11 +133,16 @@ func less(lhs, rhs MapEntry) bool {
 
 func (m *mapper) openOutputFile(shardIdx int) (*os.File, error) {
 	fileNum := atomic.AddUint32(&m.mapFileId, 1)
+	name := fmt.Sprintf("%06d.map.gz", fileNum)
+	if m.worker != nil {
+		// The workers of a distributed load write their map files to the same directories.
+		name = fmt.Sprintf("%03d-%06d.map.gz", m.worker.id, fileNum)
+	}
 	filename := filepath.Join(
-		m.opt.TmpDir,
+		m.opt.shardsDir(),
 		mapShardDir,
 		fmt.Sprintf("%03d", shardIdx),
-		fmt.Sprintf("%06d.map.gz", fileNum),
+		name,
 	)
 	x.Check(os.MkdirAll(filepath.Dir(filename), 0750))
 	return os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
@@ -218,8 +223,15 @@ func (m *mapper) writeMapEntriesToFile(cbuf *z.Buffer, shardIdx int) {
 }
 
 func (m *mapper) run(inputFormat chunker.InputFormat) {
-	chunk := chunker.NewChunker(inputFormat, 1000)
+	chunk := m.newChunker(inputFormat, "")
 	nquads := chunk.NQuads()
+	nquads.SetJSONPredicates(func(namespace uint64, pred string) bool {
+		if m.opt.Namespace != math.MaxUint64 {
+			namespace = m.opt.Namespace
+		}
+		sch := m.schema.getSchema(x.NamespaceAttr(namespace, pred))
+		return sch != nil && sch.ValueType == pb.Posting_JSON
+	})
 	go func() {
 		for chunkBuf := range m.readerChunkCh {
 			if err := chunk.Parse(chunkBuf); err != nil {
@@ -247,6 +259,18 @@ func (m *mapper) run(inputFormat chunker.InputFormat) {
 	}()
 
 	for nqs := range nquads.Ch() {
+		if m.transform != nil {
+			var err error
+			if nqs, err = m.transform.Apply(nqs); err != nil {
+				atomic.AddInt64(&m.prog.errCount, 1)
+				if !m.opt.IgnoreErrors {
+					x.Check(err)
+				}
+			}
+		}
+		if m.worker != nil {
+			m.resolveXids(nqs)
+		}
 		for _, nq := range nqs {
 			if err := facets.SortAndValidate(nq.Facets); err != nil {
 				atomic.AddInt64(&m.prog.errCount, 1)
@@ -331,6 +355,9 @@ func (m *mapper) processNQuad(nq dql.NQuad) {
 	// Appropriate schema must exist for the nquad's namespace by this time.
 	de.Attr = x.NamespaceAttr(de.Namespace, de.Attr)
 	fwd, rev := m.createPostings(nq, de)
+	if m.opt.cluster != nil && nq.GetObjectValue() != nil {
+		m.opt.cluster.checkValue(de, m.schema.getSchema(de.Attr))
+	}
 	shard := m.state.shards.shardFor(de.Attr)
 	key := x.DataKey(de.Attr, sid)
 	m.addMapEntry(key, fwd, shard)
@@ -370,12 +397,20 @@ func (m *mapper) lookupUid(xid string, ns uint64) uint64 {
 
 	// There might be a case where Nquad from different namespace have the same xid.
 	uid, isNew := m.xids.AssignUid(x.NamespaceAttr(ns, xid))
-	if !m.opt.StoreXids || !isNew {
-		return uid
+	if isNew {
+		m.storeXid(xid, ns)
+	}
+	return uid
+}
+
+// storeXid adds the xid edge of a new xid, if --store_xids is set.
+func (m *mapper) storeXid(xid string, ns uint64) {
+	if !m.opt.StoreXids {
+		return
 	}
 	if strings.HasPrefix(xid, "_:") {
 		// Don't store xids for blank nodes.
-		return uid
+		return
 	}
 	nq := dql.NQuad{NQuad: &api.NQuad{
 		Subject:   xid,
@@ -386,7 +421,6 @@ func (m *mapper) lookupUid(xid string, ns uint64) uint64 {
 		Namespace: ns,
 	}}
 	m.processNQuad(nq)
-	return uid
 }
 
 func (m *mapper) createPostings(nq dql.NQuad,
@@ -27,12 +27,16 @@ func mergeMapShardsIntoReduceShards(opt *BulkOptions) {
 		os.Exit(1)
 	}
 
-	shardDirs := readShardDirs(filepath.Join(opt.TmpDir, mapShardDir))
+	shardDirs := readShardDirs(filepath.Join(opt.shardsDir(), mapShardDir))
 	if len(shardDirs) == 0 {
 		fmt.Printf(
 			"No map shards found. Possibly caused by empty data files passed to the bulk loader.\n")
 		os.Exit(1)
 	}
+	if opt.Incremental {
+		mergeIncrementalShards(opt)
+		return
+	}
 
 	// First shard is handled differently because it contains reserved predicates.
 	firstShard := shardDirs[0]
@@ -42,7 +46,7 @@ func mergeMapShardsIntoReduceShards(opt *BulkOptions) {
 
 	var reduceShards []string
 	for i := range opt.ReduceShards {
-		shardDir := filepath.Join(opt.TmpDir, reduceShardDir, fmt.Sprintf("shard_%d", i))
+		shardDir := filepath.Join(opt.shardsDir(), reduceShardDir, fmt.Sprintf("shard_%d", i))
 		x.Check(os.MkdirAll(shardDir, 0750))
 		reduceShards = append(reduceShards, shardDir)
 	}
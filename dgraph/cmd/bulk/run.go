@@ -51,12 +51,21 @@ func init() {
 
 	flag := Bulk.Cmd.Flags()
 	flag.StringP("files", "f", "",
-		"Location of *.rdf(.gz) or *.json(.gz) file(s) to load.")
+		"Location of *.rdf(.gz), *.nq(.gz), *.json(.gz), *.csv(.gz), *.tsv(.gz), *.parquet, "+
+			"*.ttl(.gz), *.trig(.gz) or *.jsonld(.gz) file(s) to load.")
 	flag.StringP("schema", "s", "",
 		"Location of schema file.")
 	flag.StringP("graphql_schema", "g", "", "Location of the GraphQL schema file.")
 	flag.String("format", "",
-		"Specify file format (rdf or json) instead of getting it from filename.")
+		"Specify file format (rdf, json, csv, tsv, parquet, turtle, trig or jsonld) instead of "+
+			"getting it from filename.")
+	flag.String("mapping", "",
+		"Location of the JSON file mapping the columns of CSV/TSV and Parquet files to predicates, "+
+			"and the named graphs of N-Quads, TriG and JSON-LD files.")
+	flag.String("transform", "",
+		"Location of the YAML file of the transform renaming, dropping and rewriting predicates, "+
+			"converting values, setting types and prefixing xids of the N-Quads before they are "+
+			"loaded.")
 	flag.Bool("encrypted", false,
 		"Flag to indicate whether schema and data files are encrypted. "+
 			"Must be specified with --encryption or vault option(s).")
@@ -103,6 +112,32 @@ func init() {
 		"Comma separated list of tokenizer plugins")
 	flag.Bool("new_uids", false,
 		"Ignore UIDs in load files and assign new ones.")
+	flag.Bool("dry-run", false,
+		"Parse the data files and check them against the schema, reporting the N-Quads of each "+
+			"predicate, the type mismatches, the predicates not in the schema and the malformed "+
+			"input, without loading anything.")
+	flag.Bool("incremental", false,
+		"Load the data incrementally into the running cluster of --zero and --conn-str: its "+
+			"groups and schema are read from the cluster, and the data is written as additions "+
+			"to the existing data, with one output directory per group. The output is streamed "+
+			"into the cluster with dgraph import --incremental --snapshot-dir. Predicates of the "+
+			"cluster with a count index can't be loaded, nor values of indexed scalar predicates of "+
+			"the cluster for nodes already having one. Use --xidmap to keep the xids consistent "+
+			"across loads.")
+	flag.String("conn-str", "", "Dgraph connection string of an alpha of the cluster loaded "+
+		"into by --incremental.")
+	flag.Int("map-workers", 0,
+		"Lead a distributed load with this number of workers, started with --leader set to the "+
+			"--http address of the leader. The workers map their share of the data files, with "+
+			"the uids of the xids assigned by the leader, and then reduce the shards of the "+
+			"groups. All the processes need the same flags, and --shared-dir and --out on a "+
+			"storage shared by their hosts. A failed worker fails the load.")
+	flag.String("leader", "",
+		"Run as a worker of the distributed load led at this --http address. A worker on the "+
+			"host of the leader needs another --http address.")
+	flag.String("shared-dir", "",
+		"Directory shared by the processes of a distributed load, holding the map and reduce "+
+			"shards.")
 	flag.Uint64("force-namespace", math.MaxUint64,
 		"Namespace onto which to load the data. If not set, will preserve the namespace."+
 			" When using this flag to load data into specific namespace, make sure that the "+
@@ -139,6 +174,8 @@ func run() {
 		EncryptionKey:    keys.EncKey,
 		SchemaFile:       Bulk.Conf.GetString("schema"),
 		GqlSchemaFile:    Bulk.Conf.GetString("graphql_schema"),
+		MappingFile:      Bulk.Conf.GetString("mapping"),
+		TransformFile:    Bulk.Conf.GetString("transform"),
 		Encrypted:        Bulk.Conf.GetBool("encrypted"),
 		EncryptedOut:     Bulk.Conf.GetBool("encrypted_out"),
 		OutDir:           Bulk.Conf.GetString("out"),
@@ -161,6 +198,12 @@ func run() {
 		NewUids:          Bulk.Conf.GetBool("new_uids"),
 		ClientDir:        Bulk.Conf.GetString("xidmap"),
 		Namespace:        Bulk.Conf.GetUint64("force-namespace"),
+		DryRun:           Bulk.Conf.GetBool("dry-run"),
+		Incremental:      Bulk.Conf.GetBool("incremental"),
+		ConnStr:          Bulk.Conf.GetString("conn-str"),
+		MapWorkers:       Bulk.Conf.GetInt("map-workers"),
+		Leader:           Bulk.Conf.GetString("leader"),
+		SharedDir:        Bulk.Conf.GetString("shared-dir"),
 		Badger:           bopts,
 	}
 
@@ -241,6 +284,27 @@ func RunBulkLoader(opt BulkOptions) {
 			tok.LoadCustomTokenizer(soFile)
 		}
 	}
+	if opt.DryRun {
+		if !dryRun(&opt) {
+			os.Exit(1)
+		}
+		return
+	}
+	checkDistributed(&opt)
+	if opt.Incremental {
+		if opt.ZeroAddr == "" || opt.ConnStr == "" {
+			fmt.Fprint(os.Stderr, "--incremental needs --zero and --conn-str to read the cluster.\n")
+			os.Exit(1)
+		}
+		cluster, err := readClusterState(&opt)
+		x.Checkf(err, "Unable to read the state of the cluster")
+		// The output directories are the groups of the cluster.
+		opt.cluster = cluster
+		opt.MapShards, opt.ReduceShards = cluster.groups, cluster.groups
+		opt.NumReducers = min(opt.NumReducers, cluster.groups)
+		fmt.Printf("Loading incrementally into %d group(s) with %d predicate(s)\n",
+			cluster.groups, len(cluster.tablets))
+	}
 	if opt.MapBufSize <= 0 || opt.PartitionBufSize <= 0 {
 		fmt.Fprintf(os.Stderr, "mapoutput_mb: %d and partition_mb: %d must be greater than zero\n",
 			opt.MapBufSize, opt.PartitionBufSize)
@@ -261,27 +325,35 @@ func RunBulkLoader(opt BulkOptions) {
 	}()
 	http.HandleFunc("/jemalloc", x.JemallocHandler)
 
-	// Make sure it's OK to create or replace the directory specified with the --out option.
-	// It is always OK to create or replace the default output directory.
-	if opt.OutDir != defaultOutDir && !opt.ReplaceOutDir {
-		err := x.IsMissingOrEmptyDir(opt.OutDir)
-		if err == nil {
-			fmt.Fprintf(os.Stderr, "Output directory exists and is not empty."+
-				" Use --replace_out to overwrite it.\n")
-			os.Exit(1)
-		} else if err != x.ErrMissingDir {
-			x.CheckfNoTrace(err)
+	// The output directories of a distributed load are created by the leader.
+	if opt.Leader == "" {
+		// Make sure it's OK to create or replace the directory specified with the --out option.
+		// It is always OK to create or replace the default output directory.
+		if opt.OutDir != defaultOutDir && !opt.ReplaceOutDir {
+			err := x.IsMissingOrEmptyDir(opt.OutDir)
+			if err == nil {
+				fmt.Fprintf(os.Stderr, "Output directory exists and is not empty."+
+					" Use --replace_out to overwrite it.\n")
+				os.Exit(1)
+			} else if err != x.ErrMissingDir {
+				x.CheckfNoTrace(err)
+			}
 		}
-	}
 
-	// Delete and recreate the output dirs to ensure they are empty.
-	x.Check(os.RemoveAll(opt.OutDir))
-	for i := range opt.ReduceShards {
-		dir := filepath.Join(opt.OutDir, strconv.Itoa(i), "p")
-		x.Check(os.MkdirAll(dir, 0700))
-		opt.shardOutputDirs = append(opt.shardOutputDirs, dir)
+		// Delete and recreate the output dirs to ensure they are empty.
+		x.Check(os.RemoveAll(opt.OutDir))
+		for i := range opt.ReduceShards {
+			dir := filepath.Join(opt.OutDir, strconv.Itoa(i), "p")
+			x.Check(os.MkdirAll(dir, 0700))
+			opt.shardOutputDirs = append(opt.shardOutputDirs, dir)
 
-		x.Check(x.WriteGroupIdFile(dir, uint32(i+1)))
+			x.Check(x.WriteGroupIdFile(dir, uint32(i+1)))
+		}
+	}
+	if opt.MapWorkers > 0 && !opt.SkipMapPhase {
+		// Delete the shards of the previous distributed load.
+		x.Check(os.RemoveAll(filepath.Join(opt.SharedDir, mapShardDir)))
+		x.Check(os.RemoveAll(filepath.Join(opt.SharedDir, reduceShardDir)))
 	}
 
 	// Create a directory just for bulk loader's usage.
@@ -299,7 +371,15 @@ func RunBulkLoader(opt BulkOptions) {
 	x.Check(os.MkdirAll(bufDir, 0700))
 	defer os.RemoveAll(bufDir)
 
+	if opt.Leader != "" {
+		runWorker(&opt)
+		return
+	}
 	loader := newLoader(&opt)
+	var lead *leader
+	if opt.MapWorkers > 0 {
+		lead = newLeader(loader)
+	}
 
 	const bulkMetaFilename = "bulk.meta"
 	bulkMetaPath := filepath.Join(opt.TmpDir, bulkMetaFilename)
@@ -321,7 +401,11 @@ func RunBulkLoader(opt BulkOptions) {
 		loader.schema.schemaMap = bulkMeta.SchemaMap
 		loader.schema.types = bulkMeta.Types
 	} else {
-		loader.mapStage()
+		if lead != nil {
+			lead.mapStage()
+		} else {
+			loader.mapStage()
+		}
 		mergeMapShardsIntoReduceShards(&opt)
 		loader.leaseNamespaces()
 
@@ -340,7 +424,11 @@ func RunBulkLoader(opt BulkOptions) {
 			os.Exit(1)
 		}
 	}
-	loader.reduceStage()
+	if lead != nil {
+		lead.reduceStage()
+	} else {
+		loader.reduceStage()
+	}
 	loader.writeSchema()
 	loader.cleanup()
 }
@@ -54,6 +54,9 @@ func newSchemaStore(initial *schema.ParsedSchema, opt *BulkOptions, state *state
 		s.checkAndSetInitialSchema(x.ParseNamespace(p))
 		s.schemaMap[p] = sch
 	}
+	if opt.cluster != nil {
+		s.types = opt.cluster.mergeSchema(s.schemaMap, s.types)
+	}
 
 	return s
 }
@@ -111,6 +114,9 @@ func (s *schemaStore) validateType(de *pb.DirectedEdge, objectIsUID bool) {
 	s.RLock()
 	sch, ok := s.schemaMap[de.Attr]
 	s.RUnlock()
+	if s.opt.cluster != nil {
+		s.opt.cluster.checkEdge(de.Attr, sch, ok)
+	}
 	if !ok {
 		s.Lock()
 		sch, ok = s.schemaMap[de.Attr]
@@ -157,18 +163,27 @@ func (s *schemaStore) getPredicates(db *badger.DB) []string {
 }
 
 func (s *schemaStore) write(db *badger.DB, preds []string) {
+	// Write schema and types always at timestamp 1, s.state.writeTs may not be equal to 1
+	// if bulk loader was restarted or other similar scenarios. An incremental load writes them
+	// at s.state.writeTs instead, so that they replace the types of the cluster.
+	ts := uint64(1)
+	if s.opt.cluster != nil {
+		ts = s.writeTs
+	}
 	w := posting.NewTxnWriter(db)
 	for _, pred := range preds {
 		sch, ok := s.schemaMap[pred]
 		if !ok {
 			continue
 		}
+		if s.opt.cluster != nil && s.opt.cluster.exists(pred) {
+			// An incremental load keeps the schema of the predicates of the cluster.
+			continue
+		}
 		k := x.SchemaKey(pred)
 		v, err := proto.Marshal(sch)
 		x.Check(err)
-		// Write schema and types always at timestamp 1, s.state.writeTs may not be equal to 1
-		// if bulk loader was restarted or other similar scenarios.
-		x.Check(w.SetAt(k, v, posting.BitSchemaPosting, 1))
+		x.Check(w.SetAt(k, v, posting.BitSchemaPosting, ts))
 	}
 
 	// Write all the types as all groups should have access to all the types.
@@ -176,7 +191,7 @@ func (s *schemaStore) write(db *badger.DB, preds []string) {
 		k := x.TypeKey(typ.TypeName)
 		v, err := proto.Marshal(typ)
 		x.Check(err)
-		x.Check(w.SetAt(k, v, posting.BitSchemaPosting, 1))
+		x.Check(w.SetAt(k, v, posting.BitSchemaPosting, ts))
 	}
 
 	x.Check(w.Flush())
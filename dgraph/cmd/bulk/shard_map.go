@@ -8,6 +8,8 @@ package bulk
 import (
 	"sync"
 
+	farm "github.com/dgryski/go-farm"
+
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -16,6 +18,9 @@ type shardMap struct {
 	numShards   int
 	predToShard map[string]int
 	nextShard   int
+	// hashed assigns the predicates to the shards by their hash instead of in turn, so that the
+	// workers of a distributed load assign them to the same shards.
+	hashed bool
 }
 
 func newShardMap(numShards int) *shardMap {
@@ -45,8 +50,12 @@ func (m *shardMap) shardFor(pred string) int {
 		return shard
 	}
 
-	shard = m.nextShard
+	if m.hashed {
+		shard = int(farm.Fingerprint64([]byte(pred)) % uint64(m.numShards))
+	} else {
+		shard = m.nextShard
+		m.nextShard = (m.nextShard + 1) % m.numShards
+	}
 	m.predToShard[pred] = shard
-	m.nextShard = (m.nextShard + 1) % m.numShards
 	return shard
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/dgraph-io/dgo/v250"
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/x"
 
 	"github.com/golang/glog"
 	"golang.org/x/sync/errgroup"
@@ -35,6 +36,18 @@ func newClient(connectionString string) (api.DgraphClient, error) {
 }
 
 func Import(ctx context.Context, connectionString string, bulkOutDir string) error {
+	return runImport(ctx, connectionString, bulkOutDir, false)
+}
+
+// ImportIncremental streams the output of an incremental bulk load into the cluster, adding its
+// data to the existing data instead of replacing it.
+func ImportIncremental(ctx context.Context, connectionString string, bulkOutDir string) error {
+	return runImport(ctx, connectionString, bulkOutDir, true)
+}
+
+func runImport(ctx context.Context, connectionString string, bulkOutDir string,
+	incremental bool) error {
+
 	dg, err := newClient(connectionString)
 	if err != nil {
 		return err
@@ -44,7 +57,7 @@ func Import(ctx context.Context, connectionString string, bulkOutDir string) err
 		return err
 	}
 
-	return streamSnapshot(ctx, dg, bulkOutDir, resp.Groups)
+	return streamSnapshot(ctx, dg, bulkOutDir, resp.Groups, incremental)
 }
 
 // initiateSnapshotStream initiates a snapshot stream session with the Dgraph server.
@@ -64,11 +77,18 @@ func initiateSnapshotStream(ctx context.Context, dc api.DgraphClient) (*api.Upda
 
 // streamSnapshot takes a p directory and a set of group IDs and streams the data from the
 // p directory to the corresponding group IDs. It first scans the provided directory for
-// subdirectories named with numeric group IDs.
-func streamSnapshot(ctx context.Context, dc api.DgraphClient, baseDir string, groups []uint32) error {
+// subdirectories named with numeric group IDs. An incremental snapshot is added to the existing
+// data of the groups instead of replacing it.
+func streamSnapshot(ctx context.Context, dc api.DgraphClient, baseDir string, groups []uint32,
+	incremental bool) error {
+
 	glog.Infof("[import] Starting to stream snapshot from directory: %s", baseDir)
 
-	errG, errGrpCtx := errgroup.WithContext(ctx)
+	streamCtx := ctx
+	if incremental {
+		streamCtx = x.AttachIncrementalSnapshot(ctx)
+	}
+	errG, errGrpCtx := errgroup.WithContext(streamCtx)
 	for _, group := range groups {
 		errG.Go(func() error {
 			pDir := filepath.Join(baseDir, fmt.Sprintf("%d", group-1), "p")
@@ -87,6 +107,16 @@ func streamSnapshot(ctx context.Context, dc api.DgraphClient, baseDir string, gr
 
 	if err := errG.Wait(); err != nil {
 		glog.Errorf("[import] failed to stream external snapshot: %v", err)
+		if incremental {
+			// The existing data can't be dropped, so the data of the groups streamed before the
+			// error is kept.
+			req := &api.UpdateExtSnapshotStreamingStateRequest{Finish: true}
+			if _, err := dc.UpdateExtSnapshotStreamingState(ctx, req); err != nil {
+				return fmt.Errorf("failed to turn off drain mode: %v", err)
+			}
+			glog.Info("[import] successfully disabled drain mode")
+			return fmt.Errorf("the data may be partially loaded: %w", err)
+		}
 		// If errors occurs during streaming of the external snapshot, we drop all the data and
 		// go back to ensure a clean slate and the cluster remains in working state.
 		glog.Info("[import] dropping all the data and going back to clean slate")
@@ -33,15 +33,26 @@ func init() {
 	ImportCmd.EnvPrefix = "DGRAPH_IMPORT"
 
 	flag := ImportCmd.Cmd.Flags()
-	flag.StringP("files", "f", "", "Location of *.rdf(.gz) or *.json(.gz) file(s) to load.")
+	flag.StringP("files", "f", "", "Location of *.rdf(.gz), *.json(.gz), *.csv(.gz), *.tsv(.gz) "+
+		"or *.parquet file(s) to load.")
 	flag.StringP("snapshot-dir", "p", "", "Location of p directory")
 	flag.StringP("schema", "s", "", "Location of DQL schema file.")
 	flag.StringP("graphql_schema", "g", "", "Location of the GraphQL schema file.")
 	flag.StringP("graphql-schema", "", "", "Location of the GraphQL schema file.")
-	flag.String("format", "", "Specify file format (rdf or json)")
+	flag.String("format", "", "Specify file format (rdf, json, csv, tsv, parquet, turtle, trig "+
+		"or jsonld)")
+	flag.String("mapping", "", "Location of the JSON file mapping the columns of CSV/TSV and "+
+		"Parquet files to predicates, and the named graphs of N-Quads, TriG and JSON-LD files.")
 	flag.Bool("drop-all", false, "Drops all the existing data in the cluster before importing data into Dgraph.")
 	flag.Bool("drop-all-confirm", false, "Confirm drop-all operation.")
 	flag.StringP("conn-str", "c", "", "Dgraph connection string.")
+	flag.Bool("incremental", false, "Add the data to the existing data of the cluster instead of "+
+		"dropping it. The data is bulk loaded with the schema and the groups of the cluster, "+
+		"and streamed into the groups. Predicates of the cluster with a count index can't be "+
+		"loaded, nor values of indexed scalar predicates of the cluster for nodes already "+
+		"having one.")
+	flag.StringP("zero", "z", "localhost:5080", "gRPC address of Dgraph zero, used by "+
+		"--incremental to read the groups of the cluster and lease the UIDs.")
 }
 
 func run() {
@@ -67,17 +78,26 @@ func run() {
 		dropAllConfirm = true
 	}
 	bulkLoad := dropAll && dropAllConfirm
+	incremental := ImportCmd.Conf.GetBool("incremental")
 
-	if !bulkLoad {
+	if bulkLoad && incremental {
+		fmt.Println("--incremental keeps the existing data, it can't be used with --drop-all")
+		os.Exit(1)
+	}
+	if !bulkLoad && !incremental {
 		fmt.Println("Live Loader is not supported right now!")
 		os.Exit(1)
 	}
+	importFn := Import
+	if incremental {
+		importFn = ImportIncremental
+	}
 
 	// if snapshot p directory is already provided, there is no need to run bulk loader
 	if ImportCmd.Conf.GetString("snapshot-dir") != "" {
 		connStr := ImportCmd.Conf.GetString("conn-str")
 		snapshotDir := ImportCmd.Conf.GetString("snapshot-dir")
-		if err := Import(context.Background(), connStr, snapshotDir); err != nil {
+		if err := importFn(context.Background(), connStr, snapshotDir); err != nil {
 			fmt.Println("Failed to import data:", err)
 			os.Exit(1)
 		}
@@ -101,6 +121,7 @@ func run() {
 		DataFormat:       ImportCmd.Conf.GetString("format"),
 		SchemaFile:       ImportCmd.Conf.GetString("schema"),
 		GqlSchemaFile:    graphqlSchema,
+		MappingFile:      ImportCmd.Conf.GetString("mapping"),
 		Encrypted:        false,
 		EncryptedOut:     false,
 		OutDir:           "out",
@@ -123,10 +144,14 @@ func run() {
 		Badger:           bopts,
 		EncryptionKey:    nil,
 		ConnStr:          ImportCmd.Conf.GetString("conn-str"),
+		Incremental:      incremental,
+	}
+	if incremental {
+		opt.ZeroAddr = ImportCmd.Conf.GetString("zero")
 	}
 	bulk.RunBulkLoader(opt)
 
-	if err := Import(context.Background(), ImportCmd.Conf.GetString("conn-str"), "out"); err != nil {
+	if err := importFn(context.Background(), ImportCmd.Conf.GetString("conn-str"), "out"); err != nil {
 		fmt.Println("Failed to import data:", err)
 		os.Exit(1)
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/dgraph-io/badger/v4"
 	"github.com/dgraph-io/dgo/v250"
 	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/chunker"
 	"github.com/hypermodeinc/dgraph/v25/dql"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/tok"
@@ -79,8 +80,19 @@ type loader struct {
 	reqs       chan *request
 	schema     *Schema
 	namespaces map[uint64]struct{}
+	mapping    *chunker.Mapping
+	transform  *chunker.Transform
 
 	upsertLock sync.RWMutex
+
+	// progress tracks the commits of the N-Quads of each data file, for the checkpoints.
+	progress          map[string]*progress
+	checkpointLock    sync.Mutex
+	checkpointStopped bool
+	// deadLetter, if set, gets the N-Quads of the requests which can't succeed.
+	deadLetter *deadLetter
+	// Num of N-Quads written to the dead letter file
+	rejected uint64
 }
 
 // Counter keeps a track of various parameters about a batch mutation. Running totals are printed
@@ -92,6 +104,8 @@ type Counter struct {
 	TxnsDone uint64
 	// Number of Aborts
 	Aborts uint64
+	// Number of N-Quads written to the dead letter file.
+	Rejected uint64
 	// Time elapsed since the batch started.
 	Elapsed time.Duration
 }
@@ -102,10 +116,12 @@ type Counter struct {
 // server expects TLS and our certificate does not match or the host name is not verified. When
 // the node certificate is created the name much match the request host name. e.g., localhost not
 // 127.0.0.1.
-func handleError(err error, isRetry bool) {
+// It returns false if retrying the request would fail again with the same error.
+func handleError(err error, isRetry bool) bool {
 	s := status.Convert(err)
 	switch {
-	case s.Code() == codes.Internal, s.Code() == codes.Unavailable:
+	case s.Code() == codes.Internal, s.Code() == codes.Unavailable,
+		s.Code() == codes.DeadlineExceeded:
 		// Let us not crash live loader due to this. Instead, we should infinitely retry to
 		// reconnect and retry the request.
 		//nolint:gosec // random generator in closed set does not require cryptographic precision
@@ -126,7 +142,9 @@ func handleError(err error, isRetry bool) {
 		time.Sleep(dur)
 	case err != x.ErrConflict && err != dgo.ErrAborted:
 		fmt.Printf("Error while mutating: %v s.Code %v\n", s.Message(), s.Code())
+		return false
 	}
+	return true
 }
 
 func (l *loader) infinitelyRetry(req *request) {
@@ -142,10 +160,14 @@ func (l *loader) infinitelyRetry(req *request) {
 			}
 			atomic.AddUint64(&l.nquads, uint64(len(req.Set)))
 			atomic.AddUint64(&l.txns, 1)
+			req.drain.done()
 			return
 		}
 		nretries++
-		handleError(err, true)
+		if !handleError(err, true) && l.deadLetter != nil {
+			l.reject(req, err)
+			return
+		}
 		atomic.AddUint64(&l.aborts, 1)
 		if i >= 10*time.Second {
 			i = 10 * time.Second
@@ -175,14 +197,28 @@ func (l *loader) request(req *request) {
 		atomic.AddUint64(&l.nquads, uint64(len(req.Set)))
 		atomic.AddUint64(&l.txns, 1)
 		l.deregister(req)
+		req.drain.done()
+		return
+	}
+	if !handleError(err, false) && l.deadLetter != nil {
+		l.reject(req, err)
+		l.deregister(req)
 		return
 	}
-	handleError(err, false)
 	atomic.AddUint64(&l.aborts, 1)
 	l.retryRequestsWg.Add(1)
 	go l.infinitelyRetry(req)
 }
 
+// reject writes the N-Quads of the request to the dead letter file instead of retrying it.
+func (l *loader) reject(req *request, err error) {
+	if werr := l.deadLetter.write(req.Set, err); werr != nil {
+		x.Fatalf("Error while writing to the dead letter file: %v", werr)
+	}
+	atomic.AddUint64(&l.rejected, uint64(len(req.Set)))
+	req.drain.done()
+}
+
 func getTypeVal(val *api.Value) (types.Val, error) {
 	p := dql.TypeValFrom(val)
 	// Convert value to bytes
@@ -487,5 +523,6 @@ func (l *loader) Counter() Counter {
 		TxnsDone: atomic.LoadUint64(&l.txns),
 		Elapsed:  time.Since(l.start),
 		Aborts:   atomic.LoadUint64(&l.aborts),
+		Rejected: atomic.LoadUint64(&l.rejected),
 	}
 }
@@ -0,0 +1,213 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package live
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// checkpoint is the progress of a load, saved to the --checkpoint file. A rerun of the load with
+// the same file resumes where it stopped: the files loaded before are skipped, and the others are
+// read again up to the end of their last committed chunk without loading it again.
+type checkpoint struct {
+	// XidMap is the directory of the xid to uid mappings. They are written along the checkpoint,
+	// so that the rerun gives the same uids to the xids of the rest of the files.
+	XidMap string                   `json:"xidmap"`
+	Files  map[string]*fileProgress `json:"files"`
+}
+
+// fileProgress is how far the N-Quads of a data file have been committed.
+type fileProgress struct {
+	Done bool `json:"done,omitempty"`
+	// Chunks is the number of chunks of the file whose N-Quads have all been committed, and
+	// Offset the byte offset in the decompressed file at the end of these chunks.
+	Chunks int64 `json:"chunks"`
+	Offset int64 `json:"offset"`
+}
+
+// readCheckpoint reads the checkpoint saved to the file, or returns an empty checkpoint if the
+// file doesn't exist yet.
+func readCheckpoint(file, xidmapDir string) (*checkpoint, error) {
+	cp := &checkpoint{XidMap: xidmapDir, Files: make(map[string]*fileProgress)}
+	data, err := os.ReadFile(file)
+	switch {
+	case os.IsNotExist(err):
+		return cp, nil
+	case err != nil:
+		return nil, errors.Wrapf(err, "while reading checkpoint %s", file)
+	}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, errors.Wrapf(err, "while reading checkpoint %s", file)
+	}
+	if cp.XidMap != xidmapDir {
+		return nil, errors.Errorf("checkpoint %s was saved with --xidmap %q, not %q", file,
+			cp.XidMap, xidmapDir)
+	}
+	if cp.Files == nil {
+		cp.Files = make(map[string]*fileProgress)
+	}
+	return cp, nil
+}
+
+// progress tracks the chunks of a data file, from their reading until their N-Quads are
+// committed. The N-Quads of several chunks are buffered and sorted together before they are sent,
+// so a chunk is committed once all the drains of the buffer covering its N-Quads are done.
+type progress struct {
+	sync.Mutex
+	fileProgress
+
+	// chunks are the chunks read but not committed, and drains the drains not done yet, in order.
+	chunks []chunkEnd
+	drains []*drain
+	// read is set once the whole file has been read and sent.
+	read bool
+}
+
+// chunkEnd is the end of a chunk: the number of N-Quads of the file parsed up to it, and where
+// the next chunk starts.
+type chunkEnd struct {
+	nquads int
+	chunks int64
+	offset int64
+}
+
+// drain is the requests made for the first nquads N-Quads of a file, not made before.
+type drain struct {
+	p       *progress
+	nquads  int
+	pending int
+}
+
+// chunkRead records the end of a chunk, once its N-Quads have been parsed.
+func (p *progress) chunkRead(nquads int, chunks, offset int64) {
+	p.Lock()
+	defer p.Unlock()
+	p.chunks = append(p.chunks, chunkEnd{nquads: nquads, chunks: chunks, offset: offset})
+}
+
+// drained records the requests made for the first nquads N-Quads of the file. The returned drain
+// must be marked done once for each of its requests.
+func (p *progress) drained(nquads, reqs int) *drain {
+	p.Lock()
+	defer p.Unlock()
+	d := &drain{p: p, nquads: nquads, pending: reqs}
+	p.drains = append(p.drains, d)
+	p.advance()
+	return d
+}
+
+// finish records that the whole file has been read and sent.
+func (p *progress) finish() {
+	p.Lock()
+	defer p.Unlock()
+	p.read = true
+	p.advance()
+}
+
+// done records that one of the requests of the drain has been committed or rejected.
+func (d *drain) done() {
+	d.p.Lock()
+	defer d.p.Unlock()
+	d.pending--
+	d.p.advance()
+}
+
+// advance commits the chunks covered by the drains done. It must be called with the lock held.
+func (p *progress) advance() {
+	for len(p.drains) > 0 && p.drains[0].pending == 0 {
+		nquads := p.drains[0].nquads
+		p.drains = p.drains[1:]
+		for len(p.chunks) > 0 && p.chunks[0].nquads <= nquads {
+			p.Chunks, p.Offset = p.chunks[0].chunks, p.chunks[0].offset
+			p.chunks = p.chunks[1:]
+		}
+	}
+	p.Done = p.read && len(p.drains) == 0 && len(p.chunks) == 0
+}
+
+// offsetReader counts the bytes read from a data file.
+type offsetReader struct {
+	r io.Reader
+	n int64
+}
+
+func (o *offsetReader) Read(b []byte) (int, error) {
+	n, err := o.r.Read(b)
+	o.n += int64(n)
+	return n, err
+}
+
+// saveCheckpoint saves the progress of the files to the checkpoint file. The xid to uid mappings
+// and the rejected N-Quads are written out first, as the committed chunks depend on them.
+func (l *loader) saveCheckpoint() error {
+	l.checkpointLock.Lock()
+	defer l.checkpointLock.Unlock()
+	if l.checkpointStopped {
+		return nil
+	}
+
+	cp := checkpoint{XidMap: opt.clientDir, Files: make(map[string]*fileProgress)}
+	for file, p := range l.progress {
+		p.Lock()
+		fp := p.fileProgress
+		p.Unlock()
+		cp.Files[file] = &fp
+	}
+	if err := l.alloc.Sync(); err != nil {
+		return errors.Wrap(err, "while writing the xid to uid mappings")
+	}
+	if l.deadLetter != nil {
+		if err := l.deadLetter.sync(); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := opt.checkpoint + ".tmp"
+	if err := x.WriteFileSync(tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "while writing checkpoint %s", tmp)
+	}
+	return errors.Wrapf(os.Rename(tmp, opt.checkpoint), "while writing checkpoint %s",
+		opt.checkpoint)
+}
+
+// saveCheckpoints saves the checkpoint every interval, until stopCheckpoints is called.
+func (l *loader) saveCheckpoints(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := l.saveCheckpoint(); err != nil {
+			glog.Errorf("Error while saving checkpoint: %v", err)
+		}
+		l.checkpointLock.Lock()
+		stopped := l.checkpointStopped
+		l.checkpointLock.Unlock()
+		if stopped {
+			return
+		}
+	}
+}
+
+// stopCheckpoints saves the last checkpoint, and stops saving them.
+func (l *loader) stopCheckpoints() error {
+	err := l.saveCheckpoint()
+	l.checkpointLock.Lock()
+	defer l.checkpointLock.Unlock()
+	l.checkpointStopped = true
+	return err
+}
@@ -0,0 +1,102 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package live
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
+)
+
+func TestProgress(t *testing.T) {
+	p := &progress{}
+	p.chunkRead(10, 1, 100)
+	p.chunkRead(25, 2, 250)
+	d1 := p.drained(20, 2)
+	p.chunkRead(30, 3, 300)
+	d2 := p.drained(30, 1)
+
+	// The second drain being done commits nothing until the first one is done.
+	d2.done()
+	require.Equal(t, fileProgress{}, p.fileProgress)
+	d1.done()
+	require.Equal(t, fileProgress{}, p.fileProgress)
+	d1.done()
+	require.Equal(t, fileProgress{Chunks: 3, Offset: 300}, p.fileProgress)
+
+	// The file is done once it has been read and the last drain is done.
+	p.chunkRead(30, 4, 310)
+	p.drained(30, 0)
+	require.Equal(t, fileProgress{Chunks: 4, Offset: 310}, p.fileProgress)
+	p.finish()
+	require.Equal(t, fileProgress{Done: true, Chunks: 4, Offset: 310}, p.fileProgress)
+}
+
+func TestProgressPartialDrain(t *testing.T) {
+	// A chunk is committed only if a drain done covers all its N-Quads.
+	p := &progress{}
+	p.chunkRead(10, 1, 100)
+	p.chunkRead(20, 2, 200)
+	p.drained(15, 1).done()
+	require.Equal(t, fileProgress{Chunks: 1, Offset: 100}, p.fileProgress)
+}
+
+func TestReadCheckpoint(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "checkpoint")
+	cp, err := readCheckpoint(file, "xids")
+	require.NoError(t, err)
+	require.Empty(t, cp.Files)
+
+	data := `{"xidmap": "xids", "files": {"a.rdf": {"done": true}, "b.rdf": {"chunks": 2,
+		"offset": 200}}}`
+	require.NoError(t, os.WriteFile(file, []byte(data), 0600))
+	cp, err = readCheckpoint(file, "xids")
+	require.NoError(t, err)
+	require.Equal(t, map[string]*fileProgress{
+		"a.rdf": {Done: true},
+		"b.rdf": {Chunks: 2, Offset: 200},
+	}, cp.Files)
+
+	_, err = readCheckpoint(file, "other")
+	require.ErrorContains(t, err, `was saved with --xidmap "xids", not "other"`)
+}
+
+func TestDeadLetter(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "dead.rdf")
+	dl, err := openDeadLetter(file)
+	require.NoError(t, err)
+	since, err := facets.FacetFor("since", "2020")
+	require.NoError(t, err)
+
+	nqs := []*api.NQuad{
+		{Subject: "0x1", Predicate: "name", Lang: "en",
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: "A \"quoted\"\nname"}}},
+		{Subject: "0x1", Predicate: "age", Namespace: 2,
+			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 30}}},
+		{Subject: "0x1", Predicate: "friend", ObjectId: "0x2",
+			Facets: []*api.Facet{since}},
+	}
+	require.NoError(t, dl.write(nqs, errors.New("schema mismatch:\nage")))
+	require.NoError(t, dl.close())
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "# Error: schema mismatch: age\n")
+
+	// The file can be loaded again.
+	ck := chunker.NewChunker(chunker.RdfFormat, 10)
+	require.NoError(t, ck.Parse(bytes.NewBuffer(data)))
+	ck.NQuads().Flush()
+	require.Equal(t, nqs, <-ck.NQuads().Ch())
+}
@@ -0,0 +1,149 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package live
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// rdfTypes are the RDF types of the values written to the dead letter file, as in the exports.
+var rdfTypes = map[types.TypeID]string{
+	types.StringID:   "xs:string",
+	types.DateTimeID: "xs:dateTime",
+	types.IntID:      "xs:int",
+	types.FloatID:    "xs:float",
+	types.BoolID:     "xs:boolean",
+	types.GeoID:      "geo:geojson",
+	types.BinaryID:   "xs:base64Binary",
+	types.PasswordID: "xs:password",
+	types.BigFloatID: "xs:decimal",
+	types.VFloatID:   "xs:[]float32",
+}
+
+// deadLetter is the file where the N-Quads of the requests failing with an error that retrying
+// won't fix are written, so that they can be fixed and loaded again. Each request is written in
+// RDF, after a comment with its error. The subjects and objects are the uids given to them by the
+// load, so the file must be loaded again without --new_uids.
+type deadLetter struct {
+	sync.Mutex
+	f *os.File
+	w *bufio.Writer
+}
+
+func openDeadLetter(file string) (*deadLetter, error) {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while opening dead letter file %s", file)
+	}
+	return &deadLetter{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// write writes the N-Quads after a comment with the error.
+func (dl *deadLetter) write(nqs []*api.NQuad, reqErr error) error {
+	var b strings.Builder
+	msg := strings.ReplaceAll(reqErr.Error(), "\n", " ")
+	fmt.Fprintf(&b, "# Error: %s\n", msg)
+	for _, nq := range nqs {
+		if err := writeNQuad(&b, nq); err != nil {
+			return err
+		}
+	}
+
+	dl.Lock()
+	defer dl.Unlock()
+	_, err := dl.w.WriteString(b.String())
+	return err
+}
+
+// sync writes the N-Quads to the disk.
+func (dl *deadLetter) sync() error {
+	dl.Lock()
+	defer dl.Unlock()
+	if err := dl.w.Flush(); err != nil {
+		return errors.Wrap(err, "while writing dead letter file")
+	}
+	return errors.Wrap(dl.f.Sync(), "while writing dead letter file")
+}
+
+func (dl *deadLetter) close() error {
+	if err := dl.sync(); err != nil {
+		return err
+	}
+	return dl.f.Close()
+}
+
+// writeNQuad writes the N-Quad as an RDF line, with its namespace as the label.
+func writeNQuad(w io.Writer, nq *api.NQuad) error {
+	obj := fmt.Sprintf("<%s>", nq.ObjectId)
+	if nq.ObjectValue != nil {
+		val, err := getTypeVal(nq.ObjectValue)
+		if err != nil {
+			return err
+		}
+		str, err := types.Convert(val, types.StringID)
+		if err != nil {
+			return err
+		}
+		obj = quote(str.Value.(string))
+		switch {
+		case nq.Lang != "":
+			obj += "@" + nq.Lang
+		case val.Tid != types.DefaultID:
+			typ, ok := rdfTypes[val.Tid]
+			if !ok {
+				return errors.Errorf("no RDF type for the value %v of type %s", str.Value,
+					val.Tid.Name())
+			}
+			obj += "^^<" + typ + ">"
+		}
+	}
+
+	var fcs []string
+	for _, f := range nq.Facets {
+		v, err := facets.ValFor(f)
+		if err != nil {
+			return err
+		}
+		str := types.Val{Tid: types.StringID}
+		if err := types.Marshal(v, &str); err != nil {
+			return err
+		}
+		if v.Tid == types.StringID {
+			fcs = append(fcs, f.Key+"="+quote(str.Value.(string)))
+		} else {
+			fcs = append(fcs, f.Key+"="+str.Value.(string))
+		}
+	}
+	var fc string
+	if len(fcs) > 0 {
+		fc = " (" + strings.Join(fcs, ", ") + ")"
+	}
+
+	_, err := fmt.Fprintf(w, "<%s> <%s> %s <%#x>%s .\n", nq.Subject, nq.Predicate, obj,
+		nq.Namespace, fc)
+	return err
+}
+
+// quote quotes the string as an RDF literal.
+func quote(s string) string {
+	b, err := json.Marshal(s)
+	// Any string can be marshalled.
+	x.Panic(err)
+	return string(b)
+}
@@ -0,0 +1,81 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package live
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	"github.com/hypermodeinc/dgraph/v25/filestore"
+	schemapkg "github.com/hypermodeinc/dgraph/v25/schema"
+)
+
+// dryRun parses the data files and checks them against the schema file, without connecting to
+// the cluster. It prints the report of the checks, and fails if the data doesn't match the schema.
+func dryRun() error {
+	if opt.schemaFile == "" {
+		return errors.New("--dry-run needs --schema to check the data against")
+	}
+	if opt.dataFiles == "" {
+		return errors.New("RDF or JSON file(s) location must be specified")
+	}
+	sch, err := schemapkg.ParseWithNamespace(readSchemaFile(opt.schemaFile, opt.key),
+		opt.namespaceToLoad)
+	if err != nil {
+		return errors.Wrapf(err, "while parsing schema file %q", opt.schemaFile)
+	}
+
+	l := &loader{}
+	if len(opt.mappingFile) > 0 {
+		if l.mapping, err = chunker.ReadMapping(opt.mappingFile); err != nil {
+			return err
+		}
+	}
+	fs := filestore.NewFileStore(opt.dataFiles)
+	files := fs.FindDataFiles(opt.dataFiles, dataFileSuffixes)
+	if len(files) == 0 {
+		return errors.Errorf("No data files found in %s", opt.dataFiles)
+	}
+
+	c := chunker.NewChecker(sch, opt.namespaceToLoad)
+	if len(opt.transformFile) > 0 {
+		t, err := chunker.ReadTransform(opt.transformFile)
+		if err != nil {
+			return err
+		}
+		c.SetTransform(t)
+	}
+	for _, file := range files {
+		file = strings.Trim(file, " \t")
+		fmt.Printf("Checking data file %q\n", file)
+		err := func() error {
+			rd, cleanup := fs.ChunkReader(file, opt.key)
+			defer cleanup()
+			ck, _, err := l.newChunker(rd, file)
+			if err != nil {
+				return err
+			}
+			c.CheckFile(file, rd, ck)
+			return nil
+		}()
+		if err != nil {
+			return errors.Wrap(err, file)
+		}
+	}
+
+	fmt.Println()
+	if err := c.Report(os.Stdout); err != nil {
+		return err
+	}
+	if c.Failed() {
+		return errors.New("the data files don't match the schema")
+	}
+	return nil
+}
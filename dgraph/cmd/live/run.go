@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/dgryski/go-farm"
+	"github.com/dustin/go-humanize"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -47,6 +48,8 @@ type options struct {
 	dataFiles       string
 	dataFormat      string
 	schemaFile      string
+	mappingFile     string
+	transformFile   string
 	concurrent      int
 	batchSize       int
 	clientDir       string
@@ -61,6 +64,10 @@ type options struct {
 	key             x.Sensitive
 	namespaceToLoad uint64
 	preserveNs      bool
+	checkpoint      string
+	checkpointEvery time.Duration
+	deadLetter      string
+	dryRun          bool
 }
 
 type Predicate struct {
@@ -86,6 +93,8 @@ type Schema struct {
 type request struct {
 	*api.Mutation
 	conflicts []uint64
+	// drain is marked done once the request is committed or rejected.
+	drain *drain
 }
 
 func (l *Schema) init(ns uint64, galaxyOperation bool) {
@@ -99,6 +108,11 @@ func (l *Schema) init(ns uint64, galaxyOperation bool) {
 	}
 }
 
+// dataFileSuffixes are the suffixes of the data files found in the --files directories.
+var dataFileSuffixes = []string{".rdf", ".rdf.gz", ".json", ".json.gz", ".csv", ".csv.gz", ".tsv",
+	".tsv.gz", ".parquet", ".nq", ".nq.gz", ".ttl", ".ttl.gz", ".trig", ".trig.gz", ".jsonld",
+	".jsonld.gz"}
+
 var (
 	opt options
 	sch Schema
@@ -129,10 +143,17 @@ func init() {
 	// --tls SuperFlag
 	x.RegisterClientTLSFlags(flag)
 
-	flag.StringP("files", "f", "", "Location of *.rdf(.gz) or *.json(.gz) file(s) to load")
+	flag.StringP("files", "f", "", "Location of *.rdf(.gz), *.nq(.gz), *.json(.gz), "+
+		"*.csv(.gz), *.tsv(.gz), *.parquet, *.ttl(.gz), *.trig(.gz) or *.jsonld(.gz) file(s) to load")
 	flag.StringP("schema", "s", "", "Location of schema file")
-	flag.String("format", "", "Specify file format (rdf or json) instead of getting it "+
-		"from filename")
+	flag.String("format", "", "Specify file format (rdf, json, csv, tsv, parquet, turtle, trig or "+
+		"jsonld) "+
+		"instead of getting it from filename")
+	flag.String("mapping", "", "Location of the JSON file mapping the columns of CSV/TSV and "+
+		"Parquet files to predicates, and the named graphs of N-Quads, TriG and JSON-LD files")
+	flag.String("transform", "", "Location of the YAML file of the transform renaming, dropping "+
+		"and rewriting predicates, converting values, setting types and prefixing xids of the "+
+		"N-Quads before they are loaded")
 	flag.StringP("alpha", "a", "127.0.0.1:9080",
 		"Comma-separated list of Dgraph alpha gRPC server addresses")
 	flag.StringP("zero", "z", "", "(deprecated) Dgraph zero gRPC server address")
@@ -169,6 +190,18 @@ func init() {
 	flag.Int64("force-namespace", 0, "Namespace onto which to load the data."+
 		"Only superadmin should use this for loading data into multiple namespaces or some"+
 		"specific namespace. Setting it to negative value will preserve the namespace.")
+	flag.String("checkpoint", "", "File to save the progress of the load to, so that a rerun "+
+		"with the same file resumes where the load stopped. Needs --xidmap, to keep the uids "+
+		"given to the xids. The blank nodes of JSON, Turtle and JSON-LD files are named anew "+
+		"by each run.")
+	flag.Duration("checkpoint_interval", time.Minute, "How often to save the --checkpoint file.")
+	flag.Bool("dry-run", false, "Parse the data files and check them against the --schema file, "+
+		"reporting the N-Quads of each predicate, the type mismatches, the predicates not in the "+
+		"schema and the malformed input, without connecting to the cluster or loading anything.")
+	flag.String("dead_letter", "", "File to append the N-Quads to when their mutation fails "+
+		"with an error that retrying won't fix, instead of retrying them forever. They are "+
+		"written in RDF with their uids, after a comment with the error, to be fixed and loaded "+
+		"again.")
 }
 
 func getSchema(ctx context.Context, dgraphClient *dgo.Dgraph, galaxyOperation bool) (*Schema, error) {
@@ -215,16 +248,8 @@ func validateSchema(sch string, namespaces map[uint64]struct{}) error {
 	return nil
 }
 
-// processSchemaFile process schema for a given gz file.
-func (l *loader) processSchemaFile(ctx context.Context, file string, key x.Sensitive,
-	dgraphClient *dgo.Dgraph) error {
-	fmt.Printf("\nProcessing schema file %q\n", file)
-	if len(opt.authToken) > 0 {
-		md := metadata.New(nil)
-		md.Append("auth-token", opt.authToken)
-		ctx = metadata.NewOutgoingContext(ctx, md)
-	}
-
+// readSchemaFile returns the schema in the file, which may be encrypted and gzipped.
+func readSchemaFile(file string, key x.Sensitive) string {
 	f, err := filestore.Open(file)
 	x.CheckfNoTrace(err)
 	defer func() {
@@ -244,9 +269,21 @@ func (l *loader) processSchemaFile(ctx context.Context, file string, key x.Sensi
 	if err != nil {
 		x.Checkf(err, "Error while reading file")
 	}
+	return string(b)
+}
+
+// processSchemaFile process schema for a given gz file.
+func (l *loader) processSchemaFile(ctx context.Context, file string, key x.Sensitive,
+	dgraphClient *dgo.Dgraph) error {
+	fmt.Printf("\nProcessing schema file %q\n", file)
+	if len(opt.authToken) > 0 {
+		md := metadata.New(nil)
+		md.Append("auth-token", opt.authToken)
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
 
 	op := &api.Operation{}
-	op.Schema = string(b)
+	op.Schema = readSchemaFile(file, key)
 	if opt.preserveNs {
 		// Verify schema if we are loading into multiple namespaces.
 		if err := validateSchema(op.Schema, l.namespaces); err != nil {
@@ -444,14 +481,10 @@ func (l *loader) allocateUids(nqs []*api.NQuad) {
 	l.alloc.BumpTo(maxUid)
 }
 
-// processFile forwards a file to the RDF or JSON processor as appropriate
-func (l *loader) processFile(ctx context.Context, fs filestore.FileStore, filename string,
-	key x.Sensitive) error {
-
-	fmt.Printf("Processing data file %q\n", filename)
-
-	rd, cleanup := fs.ChunkReader(filename, key)
-	defer cleanup()
+// newChunker returns the chunker of the file, and its format. Files in an unknown format are
+// loaded as JSON if they look like it.
+func (l *loader) newChunker(rd *bufio.Reader, filename string) (chunker.Chunker,
+	chunker.InputFormat, error) {
 
 	loadType := chunker.DataFormat(filename, opt.dataFormat)
 	if loadType == chunker.UnknownFormat {
@@ -459,15 +492,104 @@ func (l *loader) processFile(ctx context.Context, fs filestore.FileStore, filena
 			if isJson {
 				loadType = chunker.JsonFormat
 			} else {
-				return errors.Errorf("need --format=rdf or --format=json to load %s", filename)
+				return nil, loadType, errors.Errorf("need --format=rdf or --format=json to load %s",
+					filename)
 			}
 		}
 	}
 
-	return l.processLoadFile(ctx, rd, chunker.NewChunker(loadType, opt.batchSize))
+	var ck chunker.Chunker
+	var err error
+	switch loadType {
+	case chunker.RdfFormat, chunker.TurtleFormat, chunker.JsonldFormat:
+		var graphs *chunker.GraphMapping
+		if l.mapping != nil {
+			graphs = l.mapping.Graphs
+		}
+		switch loadType {
+		case chunker.RdfFormat:
+			ck = chunker.NewRDFChunker(graphs, opt.batchSize)
+		case chunker.TurtleFormat:
+			ck = chunker.NewTurtleChunker(graphs, opt.batchSize)
+		default:
+			ck = chunker.NewJSONLDChunker(graphs, opt.batchSize)
+		}
+	case chunker.CsvFormat, chunker.ParquetFormat:
+		if l.mapping == nil {
+			return nil, loadType, errors.Errorf("need --mapping to load %s", filename)
+		}
+		if loadType == chunker.CsvFormat {
+			ck, err = chunker.NewCSVChunker(l.mapping, filename, opt.batchSize)
+		} else {
+			ck, err = chunker.NewParquetChunker(l.mapping, filename, opt.batchSize)
+		}
+		if err != nil {
+			return nil, loadType, err
+		}
+	default:
+		ck = chunker.NewChunker(loadType, opt.batchSize)
+	}
+	return ck, loadType, nil
+}
+
+// processFile forwards a file to the RDF, JSON, CSV or Parquet processor as appropriate
+func (l *loader) processFile(ctx context.Context, fs filestore.FileStore, filename string,
+	key x.Sensitive) error {
+
+	p := l.progress[filename]
+	if p.Done {
+		fmt.Printf("Skipping data file %q, loaded before\n", filename)
+		return nil
+	}
+	if p.Chunks > 0 {
+		fmt.Printf("Resuming data file %q after %s\n", filename,
+			humanize.IBytes(uint64(p.Offset)))
+	} else {
+		fmt.Printf("Processing data file %q\n", filename)
+	}
+
+	rd, cleanup := fs.ChunkReader(filename, key)
+	defer cleanup()
+
+	ck, loadType, err := l.newChunker(rd, filename)
+	if err != nil {
+		return err
+	}
+	ck.NQuads().SetJSONPredicates(l.isJSONPredicate)
+	// The RDF chunks can start on any line, so they can be skipped by seeking past them.
+	return l.processLoadFile(ctx, rd, ck, p, loadType == chunker.RdfFormat)
+}
+
+// isJSONPredicate returns whether the predicate is of type json in the schema of the cluster.
+func (l *loader) isJSONPredicate(namespace uint64, pred string) bool {
+	if l.schema == nil {
+		return false
+	}
+	if !opt.preserveNs {
+		namespace = opt.namespaceToLoad
+	}
+	p, ok := l.schema.preds[x.NamespaceAttr(namespace, pred)]
+	return ok && p.ValueType == types.JSONID
 }
 
-func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunker.Chunker) error {
+// processLoadFile loads the chunks of the file after those committed according to p. If seek is
+// set, the committed chunks are skipped by seeking to their end; otherwise they are read again
+// without being loaded.
+func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunker.Chunker,
+	p *progress, seek bool) error {
+
+	start := p.fileProgress
+	or := &offsetReader{r: rd}
+	rd = bufio.NewReaderSize(or, rd.Size())
+	offset := func() int64 { return or.n - int64(rd.Buffered()) }
+	chunks := int64(0)
+	if seek && start.Chunks > 0 {
+		if _, err := io.CopyN(io.Discard, rd, start.Offset); err != nil {
+			return errors.Wrap(err, "while seeking to the checkpoint")
+		}
+		chunks = start.Chunks
+	}
+
 	nqbuf := ck.NQuads()
 	errCh := make(chan error, 1)
 	// Spin a goroutine to push NQuads to mutation channel.
@@ -477,6 +599,7 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 			errCh <- err
 		}()
 		buffer := make([]*api.NQuad, 0, opt.bufferSize*opt.batchSize)
+		var received int
 
 		drain := func() {
 			// We collect opt.bufferSize requests and preprocess them. For the requests
@@ -500,12 +623,13 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 				}
 				return buffer[i].Predicate < buffer[j].Predicate
 			})
+			d := p.drained(received, (len(buffer)+opt.batchSize-1)/opt.batchSize)
 			for len(buffer) > 0 {
 				sz := opt.batchSize
 				if len(buffer) < opt.batchSize {
 					sz = len(buffer)
 				}
-				mu := &request{Mutation: &api.Mutation{Set: buffer[:sz]}}
+				mu := &request{Mutation: &api.Mutation{Set: buffer[:sz]}, drain: d}
 				l.reqs <- mu
 				buffer = buffer[sz:]
 			}
@@ -515,6 +639,7 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 			if len(nqs) == 0 {
 				continue
 			}
+			received += len(nqs)
 
 			for _, nq := range nqs {
 				if !opt.preserveNs {
@@ -527,6 +652,12 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 					return
 				}
 			}
+			if l.transform != nil {
+				if nqs, err = l.transform.Apply(nqs); err != nil {
+					err = errors.Wrap(err, "while transforming N-Quads")
+					return
+				}
+			}
 
 			if opt.upsertPredicate == "" {
 				l.allocateUids(nqs)
@@ -568,11 +699,15 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 		}
 
 		chunkBuf, err := ck.Chunk(rd)
+		chunks++
 		// Parses the rdf entries from the chunk, groups them into batches (each one
 		// containing opt.batchSize entries) and sends the batches to the loader.reqs channel (see
-		// above).
-		if oerr := ck.Parse(chunkBuf); oerr != nil {
-			return errors.Wrap(oerr, "During parsing chunk in processLoadFile")
+		// above). The chunks committed before the checkpoint are skipped.
+		if chunks > start.Chunks {
+			if oerr := ck.Parse(chunkBuf); oerr != nil {
+				return errors.Wrap(oerr, "During parsing chunk in processLoadFile")
+			}
+			p.chunkRead(nqbuf.Pushed(), chunks, offset())
 		}
 		if err == io.EOF {
 			break
@@ -581,7 +716,11 @@ func (l *loader) processLoadFile(ctx context.Context, rd *bufio.Reader, ck chunk
 		}
 	}
 	nqbuf.Flush()
-	return <-errCh
+	if err := <-errCh; err != nil {
+		return err
+	}
+	p.finish()
+	return nil
 }
 
 func setup(opts batchMutationOptions, dc *dgo.Dgraph, conf *viper.Viper) *loader {
@@ -672,6 +811,8 @@ func run() error {
 		dataFiles:       Live.Conf.GetString("files"),
 		dataFormat:      Live.Conf.GetString("format"),
 		schemaFile:      Live.Conf.GetString("schema"),
+		mappingFile:     Live.Conf.GetString("mapping"),
+		transformFile:   Live.Conf.GetString("transform"),
 		concurrent:      Live.Conf.GetInt("conc"),
 		batchSize:       Live.Conf.GetInt("batch"),
 		clientDir:       Live.Conf.GetString("xidmap"),
@@ -684,6 +825,13 @@ func run() error {
 		upsertPredicate: Live.Conf.GetString("upsertPredicate"),
 		tmpDir:          Live.Conf.GetString("tmp"),
 		key:             keys.EncKey,
+		checkpoint:      Live.Conf.GetString("checkpoint"),
+		checkpointEvery: Live.Conf.GetDuration("checkpoint_interval"),
+		deadLetter:      Live.Conf.GetString("dead_letter"),
+		dryRun:          Live.Conf.GetBool("dry-run"),
+	}
+	if len(opt.checkpoint) > 0 && len(opt.clientDir) == 0 {
+		return errors.New("--checkpoint needs --xidmap to keep the uids given to the xids")
 	}
 
 	forceNs := Live.Conf.GetInt64("force-namespace")
@@ -704,6 +852,10 @@ func run() error {
 
 	z.SetTmpDir(opt.tmpDir)
 
+	if opt.dryRun {
+		return dryRun()
+	}
+
 	go func() {
 		if err := http.ListenAndServe(opt.httpAddr, nil); err != nil {
 			glog.Errorf("Error while starting HTTP server: %+v", err)
@@ -780,18 +932,51 @@ func run() error {
 		return errors.New("RDF or JSON file(s) location must be specified")
 	}
 
+	if len(opt.mappingFile) > 0 {
+		if l.mapping, err = chunker.ReadMapping(opt.mappingFile); err != nil {
+			fmt.Printf("Error while reading mapping file %q: %s\n", opt.mappingFile, err)
+			return err
+		}
+	}
+	if len(opt.transformFile) > 0 {
+		if l.transform, err = chunker.ReadTransform(opt.transformFile); err != nil {
+			fmt.Printf("Error while reading transform file %q: %s\n", opt.transformFile, err)
+			return err
+		}
+	}
+
 	fs := filestore.NewFileStore(opt.dataFiles)
 
-	filesList := fs.FindDataFiles(opt.dataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz"})
+	filesList := fs.FindDataFiles(opt.dataFiles, dataFileSuffixes)
 	totalFiles := len(filesList)
 	if totalFiles == 0 {
 		return errors.Errorf("No data files found in %s", opt.dataFiles)
 	}
 	fmt.Printf("Found %d data file(s) to process\n", totalFiles)
 
+	cp := &checkpoint{}
+	if len(opt.checkpoint) > 0 {
+		if cp, err = readCheckpoint(opt.checkpoint, opt.clientDir); err != nil {
+			return err
+		}
+	}
+	l.progress = make(map[string]*progress, totalFiles)
+	for i, file := range filesList {
+		filesList[i] = strings.Trim(file, " \t")
+		p := &progress{}
+		if fp, ok := cp.Files[filesList[i]]; ok {
+			p.fileProgress = *fp
+		}
+		l.progress[filesList[i]] = p
+	}
+	if len(opt.deadLetter) > 0 {
+		if l.deadLetter, err = openDeadLetter(opt.deadLetter); err != nil {
+			return err
+		}
+	}
+
 	errCh := make(chan error, totalFiles)
 	for _, file := range filesList {
-		file = strings.Trim(file, " \t")
 		go func(file string) {
 			errCh <- errors.Wrap(l.processFile(ctx, fs, file, opt.key), file)
 		}(file)
@@ -801,10 +986,24 @@ func run() error {
 	if bmOpts.PrintCounters {
 		go l.printCounters()
 	}
+	if len(opt.checkpoint) > 0 {
+		go l.saveCheckpoints(opt.checkpointEvery)
+	}
 
 	for range totalFiles {
 		if err := <-errCh; err != nil {
 			fmt.Printf("Error while processing data file %s\n", err)
+			// Save what has been committed so far, for the rerun once the file is fixed.
+			if len(opt.checkpoint) > 0 {
+				if err := l.stopCheckpoints(); err != nil {
+					fmt.Printf("Error while saving checkpoint: %s\n", err)
+				}
+			}
+			if l.deadLetter != nil {
+				if err := l.deadLetter.close(); err != nil {
+					fmt.Printf("%s\n", err)
+				}
+			}
 			return err
 		}
 	}
@@ -829,7 +1028,20 @@ func run() error {
 	fmt.Printf("Number of N-Quads processed  : %d\n", c.Nquads)
 	fmt.Printf("Time spent                   : %v\n", c.Elapsed)
 	fmt.Printf("N-Quads processed per second : %d\n", rate)
+	if l.deadLetter != nil {
+		fmt.Printf("Number of N-Quads rejected   : %d\n", c.Rejected)
+	}
 
+	if len(opt.checkpoint) > 0 {
+		if err := l.stopCheckpoints(); err != nil {
+			return err
+		}
+	}
+	if l.deadLetter != nil {
+		if err := l.deadLetter.close(); err != nil {
+			return err
+		}
+	}
 	if err := l.alloc.Flush(); err != nil {
 		return err
 	}
@@ -0,0 +1,606 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package schema
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// maxDistinct is the number of distinct string values kept for each predicate, to tell the
+// predicates with a few repeated values from the others when suggesting an index.
+const maxDistinct = 10000
+
+// inferrer gathers statistics about the NQuads of the data files, from which it infers a schema.
+type inferrer struct {
+	preds    map[string]*predicate
+	subjects map[string]*subject
+	nquads   uint64
+}
+
+// predicate holds what has been seen of the objects of a predicate.
+type predicate struct {
+	name   string
+	uids   uint64
+	values map[types.TypeID]uint64
+	langs  map[string]struct{}
+	// list is set once a subject has been seen with two objects, in the same language.
+	list bool
+	seen map[string]struct{}
+	// objects are the uid objects, to find the type of the nodes they point to.
+	objects map[string]struct{}
+
+	strings  uint64
+	strLen   uint64
+	spaces   uint64
+	distinct map[string]struct{}
+}
+
+// subject holds the predicates and the dgraph.type values of a node.
+type subject struct {
+	types []string
+	preds map[string]struct{}
+}
+
+// schemaType is a dgraph.type, either found in the data or guessed from the predicates of the
+// nodes without one.
+type schemaType struct {
+	name      string
+	preds     map[string]struct{}
+	nodes     uint64
+	candidate bool
+}
+
+func newInferrer() *inferrer {
+	return &inferrer{
+		preds:    make(map[string]*predicate),
+		subjects: make(map[string]*subject),
+	}
+}
+
+// scanFile parses the file with the chunker and gathers statistics about its NQuads.
+func (inf *inferrer) scanFile(r *bufio.Reader, ck chunker.Chunker) error {
+	nqs := ck.NQuads()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for batch := range nqs.Ch() {
+			for _, nq := range batch {
+				inf.add(nq)
+			}
+		}
+	}()
+
+	var err error
+	for {
+		chunk, cerr := ck.Chunk(r)
+		if cerr != nil && cerr != io.EOF {
+			err = cerr
+			break
+		}
+		if chunk != nil && chunk.Len() > 0 {
+			if err = ck.Parse(chunk); err != nil {
+				break
+			}
+		}
+		if cerr == io.EOF {
+			break
+		}
+	}
+	nqs.Flush()
+	<-done
+	return err
+}
+
+func (inf *inferrer) add(nq *api.NQuad) {
+	inf.nquads++
+	subj, ok := inf.subjects[nq.Subject]
+	if !ok {
+		subj = &subject{preds: make(map[string]struct{})}
+		inf.subjects[nq.Subject] = subj
+	}
+	if nq.Predicate == "dgraph.type" {
+		if v, ok := nq.ObjectValue.GetVal().(*api.Value_DefaultVal); ok {
+			subj.types = append(subj.types, v.DefaultVal)
+		} else if v, ok := nq.ObjectValue.GetVal().(*api.Value_StrVal); ok {
+			subj.types = append(subj.types, v.StrVal)
+		}
+		return
+	}
+	if x.IsReservedPredicate(x.NamespaceAttr(nq.Namespace, nq.Predicate)) {
+		return
+	}
+	subj.preds[nq.Predicate] = struct{}{}
+
+	p, ok := inf.preds[nq.Predicate]
+	if !ok {
+		p = &predicate{
+			name:     nq.Predicate,
+			values:   make(map[types.TypeID]uint64),
+			langs:    make(map[string]struct{}),
+			seen:     make(map[string]struct{}),
+			objects:  make(map[string]struct{}),
+			distinct: make(map[string]struct{}),
+		}
+		inf.preds[nq.Predicate] = p
+	}
+	key := nq.Subject + "\x00" + nq.Lang
+	if _, ok := p.seen[key]; ok {
+		p.list = true
+	} else {
+		p.seen[key] = struct{}{}
+	}
+	if nq.ObjectValue == nil {
+		p.uids++
+		p.objects[nq.ObjectId] = struct{}{}
+		return
+	}
+	if nq.Lang != "" {
+		p.langs[nq.Lang] = struct{}{}
+	}
+	tid, str := valueType(nq.ObjectValue)
+	p.values[tid]++
+	if tid == types.StringID {
+		p.strings++
+		p.strLen += uint64(len(str))
+		if strings.ContainsAny(str, " \t\n") {
+			p.spaces++
+		}
+		if len(p.distinct) < maxDistinct {
+			p.distinct[str] = struct{}{}
+		}
+	}
+}
+
+// valueType returns the type of the value, guessed from its text if it is untyped, and the text
+// of the strings.
+func valueType(v *api.Value) (types.TypeID, string) {
+	switch val := v.Val.(type) {
+	case *api.Value_IntVal:
+		return types.IntID, ""
+	case *api.Value_DoubleVal:
+		return types.FloatID, ""
+	case *api.Value_BoolVal:
+		return types.BoolID, ""
+	case *api.Value_DatetimeVal, *api.Value_DateVal:
+		return types.DateTimeID, ""
+	case *api.Value_GeoVal:
+		return types.GeoID, ""
+	case *api.Value_PasswordVal:
+		return types.PasswordID, ""
+	case *api.Value_BigfloatVal:
+		return types.BigFloatID, ""
+	case *api.Value_Vfloat32Val:
+		return types.VFloatID, ""
+	case *api.Value_BytesVal:
+		return types.BinaryID, ""
+	case *api.Value_StrVal:
+		// JSON has no datetime type, so the datetimes are strings.
+		if _, err := types.ParseTime(val.StrVal); err == nil {
+			return types.DateTimeID, ""
+		}
+		return types.StringID, val.StrVal
+	case *api.Value_DefaultVal:
+		s := strings.TrimSpace(val.DefaultVal)
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return types.IntID, ""
+		}
+		if isFloat(s) {
+			return types.FloatID, ""
+		}
+		if s == "true" || s == "false" {
+			return types.BoolID, ""
+		}
+		if _, err := types.ParseTime(s); err == nil {
+			return types.DateTimeID, ""
+		}
+		return types.StringID, val.DefaultVal
+	default:
+		return types.StringID, ""
+	}
+}
+
+var floatRegexp = regexp.MustCompile(`^[-+]?(\d+\.\d*|\.\d+|\d+)([eE][-+]?\d+)?$`)
+
+// isFloat returns whether the text is a decimal number. Unlike strconv.ParseFloat, it doesn't take
+// words such as "Inf" or "NaN" for numbers.
+func isFloat(s string) bool {
+	return floatRegexp.MatchString(s)
+}
+
+// valueTypeOf returns the type of the values of the predicate, the most specific type all of them
+// can be converted to.
+func (p *predicate) valueTypeOf() types.TypeID {
+	switch len(p.values) {
+	case 0:
+		return types.DefaultID
+	case 1:
+		for tid := range p.values {
+			return tid
+		}
+	}
+	numbers := true
+	for tid := range p.values {
+		if tid != types.IntID && tid != types.FloatID {
+			numbers = false
+		}
+	}
+	if numbers {
+		return types.FloatID
+	}
+	return types.StringID
+}
+
+// typeOf returns the type of the predicate, and a note if its objects don't all have that type.
+func (p *predicate) typeOf() (types.TypeID, string) {
+	var nvalues uint64
+	for _, n := range p.values {
+		nvalues += n
+	}
+	switch {
+	case nvalues == 0:
+		return types.UidID, ""
+	case p.uids > nvalues:
+		return types.UidID, fmt.Sprintf("%d of the objects are values, not nodes", nvalues)
+	case p.uids > 0:
+		tid := p.valueTypeOf()
+		return tid, fmt.Sprintf("%d of the objects are nodes, not values", p.uids)
+	}
+	tid := p.valueTypeOf()
+	if len(p.values) > 1 {
+		var names []string
+		for t := range p.values {
+			names = append(names, t.Name())
+		}
+		sort.Strings(names)
+		return tid, "the values are of types " + strings.Join(names, ", ")
+	}
+	return tid, ""
+}
+
+// index returns the index suggested for the predicate, or "" for none.
+func (p *predicate) index(tid types.TypeID) string {
+	switch tid {
+	case types.IntID, types.FloatID, types.BoolID, types.GeoID:
+		return tid.Name()
+	case types.DateTimeID:
+		return "day"
+	case types.StringID:
+		if p.strings == 0 {
+			return ""
+		}
+		switch {
+		case len(p.distinct) < maxDistinct && uint64(len(p.distinct))*2 <= p.strings:
+			// A few values, repeated: an enumeration.
+			return "exact"
+		case p.strLen/p.strings > 50:
+			return "fulltext"
+		case p.spaces*2 > p.strings:
+			return "term"
+		default:
+			return "hash"
+		}
+	}
+	return ""
+}
+
+// findTypes returns the types of the nodes, the ones found in the data and then the candidate types
+// grouping the nodes without a dgraph.type by their predicates, and the type of each node.
+func (inf *inferrer) findTypes() ([]*schemaType, map[string]string) {
+	named := make(map[string]*schemaType)
+	nodeTypes := make(map[string]string)
+	signatures := make(map[string]*schemaType)
+	for id, subj := range inf.subjects {
+		if len(subj.preds) == 0 {
+			continue
+		}
+		if len(subj.types) > 0 {
+			for _, name := range subj.types {
+				t, ok := named[name]
+				if !ok {
+					t = &schemaType{name: name, preds: make(map[string]struct{})}
+					named[name] = t
+				}
+				t.nodes++
+				for pred := range subj.preds {
+					t.preds[pred] = struct{}{}
+				}
+			}
+			nodeTypes[id] = subj.types[0]
+			continue
+		}
+		preds := make([]string, 0, len(subj.preds))
+		for pred := range subj.preds {
+			preds = append(preds, pred)
+		}
+		sort.Strings(preds)
+		sig := strings.Join(preds, "\x00")
+		t, ok := signatures[sig]
+		if !ok {
+			t = &schemaType{preds: subj.preds, candidate: true}
+			signatures[sig] = t
+		}
+		t.nodes++
+	}
+
+	// The nodes whose predicates share a prefix, as in Person.name and Person.age, are grouped by
+	// it. The other nodes with a subset of the predicates of other nodes are grouped with them,
+	// the ones with the most predicates first.
+	sigs := make([]string, 0, len(signatures))
+	for sig := range signatures {
+		sigs = append(sigs, sig)
+	}
+	sort.Slice(sigs, func(i, j int) bool {
+		ti, tj := signatures[sigs[i]], signatures[sigs[j]]
+		if len(ti.preds) != len(tj.preds) {
+			return len(ti.preds) > len(tj.preds)
+		}
+		return sigs[i] < sigs[j]
+	})
+	var candidates []*schemaType
+	byPrefix := make(map[string]*schemaType)
+	groupOf := make(map[string]*schemaType)
+	for _, sig := range sigs {
+		t := signatures[sig]
+		var group *schemaType
+		prefix := commonPrefix(t.preds)
+		if prefix != "" {
+			group = byPrefix[prefix]
+		} else {
+			for _, c := range candidates {
+				if c.name == "" && containsAll(c.preds, t.preds) &&
+					(group == nil || c.nodes > group.nodes) {
+					group = c
+				}
+			}
+		}
+		if group == nil {
+			group = &schemaType{name: prefix, preds: make(map[string]struct{}), candidate: true}
+			candidates = append(candidates, group)
+			if prefix != "" {
+				byPrefix[prefix] = group
+			}
+		}
+		for pred := range t.preds {
+			group.preds[pred] = struct{}{}
+		}
+		group.nodes += t.nodes
+		groupOf[sig] = group
+	}
+
+	var all []*schemaType
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		all = append(all, named[name])
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].nodes > candidates[j].nodes
+	})
+	for i, c := range candidates {
+		c.name = candidateName(c.name, i+1, named)
+		named[c.name] = c
+		all = append(all, c)
+	}
+
+	for id, subj := range inf.subjects {
+		if _, ok := nodeTypes[id]; ok || len(subj.preds) == 0 {
+			continue
+		}
+		preds := make([]string, 0, len(subj.preds))
+		for pred := range subj.preds {
+			preds = append(preds, pred)
+		}
+		sort.Strings(preds)
+		nodeTypes[id] = groupOf[strings.Join(preds, "\x00")].name
+	}
+	return all, nodeTypes
+}
+
+func containsAll(set, subset map[string]struct{}) bool {
+	for k := range subset {
+		if _, ok := set[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// commonPrefix returns the prefix shared by all the predicates, as Person for Person.name and
+// Person.age, or "" if they don't share one.
+func commonPrefix(preds map[string]struct{}) string {
+	prefix := ""
+	for pred := range preds {
+		i := strings.LastIndexByte(pred, '.')
+		if i <= 0 || (prefix != "" && pred[:i] != prefix) {
+			return ""
+		}
+		prefix = pred[:i]
+	}
+	return prefix
+}
+
+// candidateName names a candidate type after the prefix of its predicates, or else after its
+// rank, with a number added if a type already has that name.
+func candidateName(prefix string, rank int, taken map[string]*schemaType) string {
+	name := prefix
+	if name == "" {
+		name = fmt.Sprintf("Type%d", rank)
+	}
+	base := name
+	for i := 2; ; i++ {
+		if _, ok := taken[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+}
+
+func (inf *inferrer) sortedPreds() []*predicate {
+	preds := make([]*predicate, 0, len(inf.preds))
+	for _, p := range inf.preds {
+		preds = append(preds, p)
+	}
+	sort.Slice(preds, func(i, j int) bool { return preds[i].name < preds[j].name })
+	return preds
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeDQL writes the DQL schema inferred, with the types found.
+func (inf *inferrer) writeDQL(w io.Writer, schemaTypes []*schemaType) error {
+	bw := bufio.NewWriter(w)
+	for _, p := range inf.sortedPreds() {
+		tid, note := p.typeOf()
+		if note != "" {
+			fmt.Fprintf(bw, "# %s: %s.\n", p.name, note)
+		}
+		typ := tid.Name()
+		if p.list && tid != types.PasswordID {
+			typ = "[" + typ + "]"
+		}
+		fmt.Fprintf(bw, "<%s>: %s", p.name, typ)
+		if index := p.index(tid); index != "" {
+			fmt.Fprintf(bw, " @index(%s)", index)
+		}
+		if len(p.langs) > 0 && tid == types.StringID {
+			fmt.Fprint(bw, " @lang")
+		}
+		fmt.Fprintln(bw, " .")
+	}
+
+	for _, t := range schemaTypes {
+		fmt.Fprintln(bw)
+		if t.candidate {
+			fmt.Fprintf(bw, "# Candidate type of %d nodes without a dgraph.type.\n", t.nodes)
+		}
+		fmt.Fprintf(bw, "type <%s> {\n", t.name)
+		for _, pred := range sortedKeys(t.preds) {
+			fmt.Fprintf(bw, "  %s\n", pred)
+		}
+		fmt.Fprintln(bw, "}")
+	}
+	return bw.Flush()
+}
+
+var graphqlScalars = map[types.TypeID]string{
+	types.StringID:   "String",
+	types.DefaultID:  "String",
+	types.IntID:      "Int64",
+	types.FloatID:    "Float",
+	types.BoolID:     "Boolean",
+	types.DateTimeID: "DateTime",
+	types.GeoID:      "Point",
+}
+
+var graphqlSearch = map[string]string{
+	"int":      "",
+	"float":    "",
+	"bool":     "",
+	"geo":      "",
+	"day":      "(by: [day])",
+	"exact":    "(by: [exact])",
+	"hash":     "(by: [hash])",
+	"term":     "(by: [term])",
+	"fulltext": "(by: [fulltext])",
+}
+
+var graphqlName = regexp.MustCompile(`[^_a-zA-Z0-9]`)
+
+// gqlName returns the name turned into a GraphQL name.
+func gqlName(name string) string {
+	name = graphqlName.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// writeGraphQL writes a GraphQL schema for the types found, mapped to the inferred predicates. The
+// edges are typed with the most common type of the nodes they point to, and are left out if these
+// nodes have no type.
+func (inf *inferrer) writeGraphQL(w io.Writer, schemaTypes []*schemaType,
+	nodeTypes map[string]string) error {
+
+	bw := bufio.NewWriter(w)
+	for i, t := range schemaTypes {
+		if i > 0 {
+			fmt.Fprintln(bw)
+		}
+		name := gqlName(t.name)
+		fmt.Fprintf(bw, "type %s", name)
+		if name != t.name {
+			fmt.Fprintf(bw, " @dgraph(type: %q)", t.name)
+		}
+		fmt.Fprintln(bw, " {")
+		for _, pred := range sortedKeys(t.preds) {
+			p := inf.preds[pred]
+			field := gqlName(pred[strings.LastIndexByte(pred, '.')+1:])
+			tid, _ := p.typeOf()
+			var typ, search string
+			if tid == types.UidID {
+				if typ = p.objectType(nodeTypes); typ == "" {
+					fmt.Fprintf(bw, "  # %s: the nodes it points to have no type.\n", field)
+					continue
+				}
+				typ = gqlName(typ)
+			} else {
+				var ok bool
+				if typ, ok = graphqlScalars[tid]; !ok {
+					fmt.Fprintf(bw, "  # %s: %s has no GraphQL type.\n", field, tid.Name())
+					continue
+				}
+				if index := p.index(tid); index != "" {
+					search = " @search" + graphqlSearch[index]
+				}
+			}
+			if p.list {
+				typ = "[" + typ + "]"
+			}
+			fmt.Fprintf(bw, "  %s: %s%s @dgraph(pred: %q)\n", field, typ, search, pred)
+		}
+		fmt.Fprintln(bw, "}")
+	}
+	return bw.Flush()
+}
+
+// objectType returns the most common type of the nodes the predicate points to.
+func (p *predicate) objectType(nodeTypes map[string]string) string {
+	counts := make(map[string]int)
+	for obj := range p.objects {
+		if t, ok := nodeTypes[obj]; ok {
+			counts[t]++
+		}
+	}
+	best := ""
+	for t, n := range counts {
+		if best == "" || n > counts[best] || (n == counts[best] && t < best) {
+			best = t
+		}
+	}
+	return best
+}
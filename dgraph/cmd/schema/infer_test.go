@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package schema
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	dqlschema "github.com/hypermodeinc/dgraph/v25/schema"
+)
+
+func infer(t *testing.T, format chunker.InputFormat, data string) *inferrer {
+	inf := newInferrer()
+	r := bufio.NewReader(strings.NewReader(data))
+	require.NoError(t, inf.scanFile(r, chunker.NewChunker(format, 10)))
+	return inf
+}
+
+func TestInferRDF(t *testing.T) {
+	inf := infer(t, chunker.RdfFormat, `
+_:a <Person.name> "Alice Smith" .
+_:a <Person.age> "30" .
+_:a <Person.friend> _:b .
+_:a <Person.friend> _:c .
+_:b <Person.name> "Bob"@en .
+_:b <Person.name> "Robert"@fr .
+_:b <Person.age> "31" .
+_:c <Person.name> "Carol" .
+_:c <Person.active> "true" .
+_:d <title> "A book" .
+_:d <published> "2020-01-02" .
+_:d <rating> "4.5" .
+_:d <dgraph.type> "Book" .
+_:d <author> _:a .
+`)
+	schemaTypes, nodeTypes := inf.findTypes()
+	var out strings.Builder
+	require.NoError(t, inf.writeDQL(&out, schemaTypes))
+	dql := out.String()
+	for _, s := range []string{
+		"<Person.active>: bool @index(bool) .\n",
+		"<Person.age>: int @index(int) .\n",
+		"<Person.friend>: [uid] .\n",
+		"<Person.name>: string @index(hash) @lang .\n",
+		"<author>: uid .\n",
+		"<published>: datetime @index(day) .\n",
+		"<rating>: float @index(float) .\n",
+		"type <Book> {\n  author\n  published\n  rating\n  title\n}\n",
+		"type <Person> {\n  Person.active\n  Person.age\n  Person.friend\n  Person.name\n}\n",
+	} {
+		require.Contains(t, dql, s)
+	}
+	_, err := dqlschema.Parse(dql)
+	require.NoError(t, err)
+
+	out.Reset()
+	require.NoError(t, inf.writeGraphQL(&out, schemaTypes, nodeTypes))
+	require.Contains(t, out.String(), `author: Person @dgraph(pred: "author")`)
+	require.Contains(t, out.String(), `friend: [Person] @dgraph(pred: "Person.friend")`)
+}
+
+func TestInferJSON(t *testing.T) {
+	inf := infer(t, chunker.JsonFormat, `[
+		{"name": "a", "tags": ["x", "y"], "score": 1, "born": "1990-05-01T10:00:00Z"},
+		{"name": "b", "tags": ["x"], "score": 2.5, "bio": "`+strings.Repeat("word ", 20)+`"},
+		{"name": "a", "tags": ["y"], "score": "n/a"},
+		{"name": "a"}
+	]`)
+	var out strings.Builder
+	require.NoError(t, inf.writeDQL(&out, nil))
+	dql := out.String()
+	for _, s := range []string{
+		"<bio>: string @index(fulltext) .\n",
+		"<born>: datetime @index(day) .\n",
+		"<name>: string @index(exact) .\n",
+		"# score: the values are of types float, int, string.\n<score>: string",
+		"<tags>: [string] @index(exact) .\n",
+	} {
+		require.Contains(t, dql, s)
+	}
+}
+
+func TestInferCandidateTypes(t *testing.T) {
+	inf := infer(t, chunker.RdfFormat, `
+_:a <name> "a" .
+_:a <email> "a@x" .
+_:b <name> "b" .
+_:c <title> "c" .
+_:c <pages> "10" .
+`)
+	schemaTypes, nodeTypes := inf.findTypes()
+	require.Len(t, schemaTypes, 2)
+	require.Equal(t, "Type1", schemaTypes[0].name)
+	require.Equal(t, uint64(2), schemaTypes[0].nodes)
+	require.Equal(t, nodeTypes["_:a"], nodeTypes["_:b"])
+	require.NotEqual(t, nodeTypes["_:a"], nodeTypes["_:c"])
+}
@@ -0,0 +1,171 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package schema builds the tools working on DQL schemas, such as "dgraph schema infer", which
+// infers a schema from data files.
+package schema
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	"github.com/hypermodeinc/dgraph/v25/filestore"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// Schema is the sub-command invoked when calling "dgraph schema".
+var Schema x.SubCommand
+
+var inferCmd x.SubCommand
+
+var dataFileSuffixes = []string{".rdf", ".rdf.gz", ".json", ".json.gz", ".csv", ".csv.gz", ".tsv",
+	".tsv.gz", ".parquet", ".nq", ".nq.gz", ".ttl", ".ttl.gz", ".trig", ".trig.gz", ".jsonld",
+	".jsonld.gz"}
+
+func init() {
+	Schema.Cmd = &cobra.Command{
+		Use:         "schema",
+		Short:       "Tools for DQL schemas",
+		Annotations: map[string]string{"group": "tool"},
+	}
+	Schema.Cmd.SetHelpTemplate(x.NonRootTemplate)
+
+	inferCmd.Cmd = &cobra.Command{
+		Use:   "infer",
+		Short: "Infer a DQL schema from data files",
+		Long: `
+Infer a DQL schema from RDF, JSON, CSV, Parquet, Turtle or JSON-LD data files. The type of
+the predicates, whether they are lists, point to nodes or have languages, and the indexes
+to add are guessed from their values. The types group the nodes by their dgraph.type, or
+are candidate types grouping the nodes without one by their predicates.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runInfer(inferCmd.Conf); err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				os.Exit(1)
+			}
+		},
+		Annotations: map[string]string{"group": "tool"},
+	}
+	inferCmd.EnvPrefix = "DGRAPH_SCHEMA_INFER"
+
+	flag := inferCmd.Cmd.Flags()
+	flag.StringP("files", "f", "", "Location of the data files, or of the directories holding them.")
+	flag.String("format", "", "Specify file format (rdf, json, csv, tsv, parquet, turtle, trig or "+
+		"jsonld) instead of getting it from filename.")
+	flag.StringP("mapping", "m", "", "Location of the mapping file, needed by CSV and Parquet "+
+		"files.")
+	flag.StringP("out", "o", "", "File to write the DQL schema to, instead of the standard output.")
+	flag.String("graphql", "", "File to write a GraphQL schema for the types to, if set.")
+
+	Schema.Cmd.AddCommand(inferCmd.Cmd)
+	inferCmd.Conf = viper.New()
+	if err := inferCmd.Conf.BindPFlags(inferCmd.Cmd.Flags()); err != nil {
+		glog.Fatalf("Unable to bind flags for command %v: %v", inferCmd, err)
+	}
+	inferCmd.Conf.AutomaticEnv()
+	inferCmd.Conf.SetEnvPrefix(inferCmd.EnvPrefix)
+}
+
+func runInfer(conf *viper.Viper) error {
+	files := conf.GetString("files")
+	if files == "" {
+		return errors.New("data file(s) location must be specified with --files")
+	}
+	var mapping *chunker.Mapping
+	if file := conf.GetString("mapping"); file != "" {
+		var err error
+		if mapping, err = chunker.ReadMapping(file); err != nil {
+			return err
+		}
+	}
+
+	fs := filestore.NewFileStore(files)
+	dataFiles := fs.FindDataFiles(files, dataFileSuffixes)
+	if len(dataFiles) == 0 {
+		return errors.Errorf("No data files found in %s", files)
+	}
+	inf := newInferrer()
+	for _, file := range dataFiles {
+		file = strings.Trim(file, " \t")
+		fmt.Fprintf(os.Stderr, "Scanning data file %q\n", file)
+		err := func() error {
+			rd, cleanup := fs.ChunkReader(file, nil)
+			defer cleanup()
+			ck, err := newChunker(file, conf.GetString("format"), mapping)
+			if err != nil {
+				return err
+			}
+			return inf.scanFile(rd, ck)
+		}()
+		if err != nil {
+			return errors.Wrap(err, file)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Scanned %d N-Quads of %d predicates\n", inf.nquads, len(inf.preds))
+
+	schemaTypes, nodeTypes := inf.findTypes()
+	if err := writeTo(conf.GetString("out"), func(w io.Writer) error {
+		return inf.writeDQL(w, schemaTypes)
+	}); err != nil {
+		return err
+	}
+	if file := conf.GetString("graphql"); file != "" {
+		return writeTo(file, func(w io.Writer) error {
+			return inf.writeGraphQL(w, schemaTypes, nodeTypes)
+		})
+	}
+	return nil
+}
+
+func newChunker(file, format string, mapping *chunker.Mapping) (chunker.Chunker, error) {
+	var graphs *chunker.GraphMapping
+	if mapping != nil {
+		graphs = mapping.Graphs
+	}
+	switch loadType := chunker.DataFormat(file, format); loadType {
+	case chunker.UnknownFormat:
+		return nil, errors.Errorf("need --format to read %s", file)
+	case chunker.CsvFormat, chunker.ParquetFormat:
+		if mapping == nil {
+			return nil, errors.Errorf("need --mapping to read %s", file)
+		}
+		if loadType == chunker.CsvFormat {
+			return chunker.NewCSVChunker(mapping, file, 1000)
+		}
+		return chunker.NewParquetChunker(mapping, file, 1000)
+	case chunker.RdfFormat:
+		return chunker.NewRDFChunker(graphs, 1000), nil
+	case chunker.TurtleFormat:
+		return chunker.NewTurtleChunker(graphs, 1000), nil
+	case chunker.JsonldFormat:
+		return chunker.NewJSONLDChunker(graphs, 1000), nil
+	default:
+		return chunker.NewChunker(loadType, 1000), nil
+	}
+}
+
+// writeTo calls write with the file, or with the standard output if file is empty.
+func writeTo(file string, write func(w io.Writer) error) error {
+	if file == "" {
+		return write(os.Stdout)
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
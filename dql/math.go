@@ -55,7 +55,7 @@ type MathTree struct {
 
 func isUnary(f string) bool {
 	return f == "exp" || f == "ln" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" || f == "length"
 }
 
 func isBinaryMath(f string) bool {
@@ -153,7 +153,7 @@ func isMathFunc(f string) bool {
 		f == "==" || f == "!=" ||
 		f == "min" || f == "max" || f == "sqrt" ||
 		f == "pow" || f == "logbase" || f == "floor" || f == "ceil" ||
-		f == "since" || f == "dot"
+		f == "since" || f == "dot" || f == "length" || f == "geodistance"
 }
 
 func parseMathFunc(gq *GraphQuery, it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
@@ -260,6 +260,16 @@ loop:
 				}
 			}
 			valueStack.push(child)
+		case item.Typ == itemLeftSquare: // A point, e.g. in geodistance(loc, [lon, lat]).
+			geo := &Function{}
+			if err := parseGeoArgs(it, geo); err != nil {
+				return nil, false, err
+			}
+			g, err := types.ParseGeoArg(geo.Args[0].Value)
+			if err != nil {
+				return nil, false, err
+			}
+			valueStack.push(&MathTree{Const: types.Val{Tid: types.GeoID, Value: g}})
 		case item.Typ == itemLeftRound: // Just push to op stack.
 			opStack.push(&MathTree{Fn: "("})
 
@@ -401,7 +411,7 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 	switch t.Fn {
 	case "+", "-", "/", "*", "%", "exp", "ln", "cond", "min",
 		"sqrt", "max", "<", ">", "<=", ">=", "==", "!=", "u-",
-		"logbase", "pow", "dot":
+		"logbase", "pow", "dot", "geodistance":
 		x.Check2(buf.WriteString(t.Fn))
 	default:
 		x.Fatalf("Unknown operator: %q", t.Fn)
@@ -49,53 +49,17 @@ type NQuad struct {
 	*api.NQuad
 }
 
+// TypeValFrom converts the api.Value into a types.Val of the matching type.
 func TypeValFrom(val *api.Value) types.Val {
-	switch val.Val.(type) {
-	case *api.Value_BytesVal:
-		return types.Val{Tid: types.BinaryID, Value: val.GetBytesVal()}
-	case *api.Value_IntVal:
-		return types.Val{Tid: types.IntID, Value: val.GetIntVal()}
-	case *api.Value_StrVal:
-		return types.Val{Tid: types.StringID, Value: val.GetStrVal()}
-	case *api.Value_BoolVal:
-		return types.Val{Tid: types.BoolID, Value: val.GetBoolVal()}
-	case *api.Value_DoubleVal:
-		return types.Val{Tid: types.FloatID, Value: val.GetDoubleVal()}
-	case *api.Value_GeoVal:
-		return types.Val{Tid: types.GeoID, Value: val.GetGeoVal()}
-	case *api.Value_BigfloatVal:
-		return types.Val{Tid: types.BigFloatID, Value: val.GetBigfloatVal()}
-	case *api.Value_DatetimeVal:
-		return types.Val{Tid: types.DateTimeID, Value: val.GetDatetimeVal()}
-	case *api.Value_PasswordVal:
-		return types.Val{Tid: types.PasswordID, Value: val.GetPasswordVal()}
-	case *api.Value_Vfloat32Val:
-		return types.Val{
-			Tid:   types.VFloatID,
-			Value: types.BytesAsFloatArray(val.GetVfloat32Val()),
-		}
-	case *api.Value_DefaultVal:
-		return types.Val{Tid: types.DefaultID, Value: val.GetDefaultVal()}
-	}
-
-	return types.Val{Tid: types.StringID, Value: ""}
+	return types.ValFrom(val)
 }
 
 func byteVal(nq NQuad) ([]byte, types.TypeID, error) {
-	// We infer object type from type of value. We set appropriate type in parse
-	// function or the Go client has already set.
-	p := TypeValFrom(nq.ObjectValue)
-	// These three would have already been marshalled to bytes by the client or
-	// in parse function.
-	if p.Tid == types.GeoID || p.Tid == types.DateTimeID || p.Tid == types.BigFloatID {
-		return p.Value.([]byte), p.Tid, nil
-	}
-
-	p1 := types.ValueForType(types.BinaryID)
-	if err := types.Marshal(p, &p1); err != nil {
+	p, err := types.StorageValue(nq.ObjectValue)
+	if err != nil {
 		return []byte{}, p.Tid, err
 	}
-	return p1.Value.([]byte), p.Tid, nil
+	return p.Value.([]byte), p.Tid, nil
 }
 
 func toUid(subject string, newToUid map[string]uint64) (uid uint64, err error) {
@@ -190,6 +190,7 @@ var mathOpPrecedence = map[string]int{
 	"floor":   105,
 	"ceil":    104,
 	"since":   103,
+	"length":  102,
 	"exp":     100,
 	"ln":      99,
 	"sqrt":    98,
@@ -199,6 +200,8 @@ var mathOpPrecedence = map[string]int{
 	"max":     85,
 	"min":     84,
 
+	"geodistance": 83,
+
 	// NOTE: Previously, we had "/" at precedence 50 and "*" at precedence 49.
 	//       This is problematic because it would evaluate:
 	//              5 * 10 / 50 as: 5 * (10/50). This is fine for floating point, but breaks
@@ -1701,7 +1704,8 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext", "ngram",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "facet",
+		"ip_within", "ip_contains", "json_path":
 		return true
 	}
 	return false
@@ -3415,7 +3419,8 @@ func isMathBlock(name string) bool {
 }
 
 func isGeoFunc(name string) bool {
-	return name == "near" || name == "contains" || name == "within" || name == "intersects"
+	return name == "near" || name == "nearest" || name == "contains" || name == "within" ||
+		name == "intersects"
 }
 
 func IsInequalityFn(name string) bool {
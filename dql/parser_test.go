@@ -13,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	geom "github.com/twpayne/go-geom"
 
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/chunker"
@@ -4200,6 +4201,43 @@ func TestMathWithoutVarAlias(t *testing.T) {
 	require.Contains(t, err.Error(), "Function math should be used with a variable or have an alias")
 }
 
+func TestMathGeoDistance(t *testing.T) {
+	query := `{
+		var(func: near(loc, [-122.08, 37.42], 1000)) {
+			g as loc
+			d as math(geodistance(g, [-122.08, 37.42]) / 1000)
+		}
+		me(func: uid(d), orderasc: val(d)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "near", res.Query[0].Func.Name)
+	mt := res.Query[0].Children[1].MathExp
+	require.Equal(t, "/", mt.Fn)
+	gd := mt.Child[0]
+	require.Equal(t, "geodistance", gd.Fn)
+	require.Equal(t, "g", gd.Child[0].Var)
+	require.Equal(t, types.GeoID, gd.Child[1].Const.Tid)
+	require.Equal(t, geom.Coord{-122.08, 37.42}, gd.Child[1].Const.Value.(*geom.Point).Coords())
+
+	_, err = Parse(Request{Str: `{f(func: uid(1)){g as loc x:math(geodistance(g, [1, 2)}}`})
+	require.Error(t, err)
+}
+
+func TestParseNearest(t *testing.T) {
+	query := `{
+		me(func: nearest(loc, [-122.08, 37.42], 5)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "nearest", res.Query[0].Func.Name)
+	require.Equal(t, []Arg{{Value: "[-122.08,37.42]"}, {Value: "5"}}, res.Query[0].Func.Args)
+}
+
 func TestMathDiv0(t *testing.T) {
 	tests := []struct {
 		in       string
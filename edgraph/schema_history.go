@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/query"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// attachSchemaChange adds a change of the schema to the mutations making it, so that it is added
+// to the history of the schema by the same proposals that apply it.
+func attachSchemaChange(ctx context.Context, m *pb.Mutations, change *pb.SchemaChange) {
+	if change == nil {
+		return
+	}
+	change.Version = m.StartTs
+	change.Time = time.Now().Unix()
+	if x.WorkerConfig.AclEnabled {
+		if userData, err := extractUserAndGroups(ctx); err == nil {
+			change.User = userData.userId
+		}
+	}
+	m.SchemaChange = change
+}
+
+// RollbackSchema reverts the predicates and types of the namespace changed after the given
+// version of the schema to their definitions at that version. The types created after it are
+// dropped, and the predicates created after it are kept along with their data. The indexes are
+// rebuilt in the background. The rollback is itself added to the history of the schema.
+func (s *Server) RollbackSchema(ctx context.Context, version uint64) error {
+	if schema.State().IndexingInProgress() {
+		return errIndexingInProgress
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While rolling back the schema")
+	}
+	history, err := worker.GetSchemaHistory(ns)
+	if err != nil {
+		return err
+	}
+	preds, typs, dropTypes, err := worker.SchemaRollback(history, version)
+	if err != nil {
+		return err
+	}
+
+	markBuildingIndexes(typs)
+	uniqueKeys := newUniqueKeys(typs)
+	if err := verifyUniqueKeys(ctx, uniqueKeys); err != nil {
+		return err
+	}
+	change, err := worker.NewSchemaChange(ctx, preds, typs, nil, dropTypes)
+	if err != nil || change == nil {
+		return err
+	}
+	change.RollbackTo = version
+	glog.Infof("Rolling back the schema of namespace %#x to version %d", ns, version)
+
+	// The rollback is recorded by the first of the proposals making it.
+	pending := change
+	apply := func(m *pb.Mutations) error {
+		attachSchemaChange(ctx, m, pending)
+		pending = nil
+		_, err := query.ApplyMutations(ctx, m)
+		return err
+	}
+	if len(change.NewPreds) > 0 || len(change.NewTypes) > 0 {
+		m := &pb.Mutations{
+			StartTs: worker.State.GetTimestamp(false),
+			Schema:  change.NewPreds,
+			Types:   change.NewTypes,
+		}
+		if err := apply(m); err != nil {
+			return err
+		}
+		if err := backfillUniqueKeys(ctx, uniqueKeys); err != nil {
+			return err
+		}
+	}
+	for _, typ := range dropTypes {
+		if _, ok := schema.State().GetType(typ); !ok {
+			continue
+		}
+		m := &pb.Mutations{
+			StartTs:   worker.State.GetTimestamp(false),
+			DropOp:    pb.Mutations_TYPE,
+			DropValue: typ,
+		}
+		if err := apply(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
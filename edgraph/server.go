@@ -306,7 +306,25 @@ func parseSchemaFromAlterOperation(ctx context.Context, sch string) (
 				"which is reserved as the namespace for dgraph's internal types/predicates.",
 				x.ParseAttr(typ.TypeName))
 		}
+
+		// Composite unique keys and indexes are maintained through internal predicates,
+		// which are created along with the type.
+		for _, key := range typ.UniqueKeys {
+			su := schema.UniqueKeySchema(typ.TypeName, key)
+			if _, ok := preds[su.Predicate]; !ok {
+				preds[su.Predicate] = struct{}{}
+				result.Preds = append(result.Preds, su)
+			}
+		}
+		for _, index := range typ.CompositeIndexes {
+			su := schema.CompositeIndexSchema(typ.TypeName, index)
+			if _, ok := preds[su.Predicate]; !ok {
+				preds[su.Predicate] = struct{}{}
+				result.Preds = append(result.Preds, su)
+			}
+		}
 	}
+	markBuildingIndexes(result.Types)
 
 	return result, nil
 }
@@ -456,14 +474,21 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		}
 		edges := []*pb.DirectedEdge{edge}
 		m.Edges = edges
+		change, err := worker.NewSchemaChange(ctx, nil, nil, []string{attr}, nil)
+		if err != nil {
+			return empty, err
+		}
+		attachSchemaChange(ctx, m, change)
 		_, err = query.ApplyMutations(ctx, m)
 		if err != nil {
 			return empty, err
 		}
 
 		// insert a helper record for backup & restore, indicating that drop_attr was done
-		err = InsertDropRecord(ctx, "DROP_ATTR;"+attr)
-		return empty, err
+		if err = InsertDropRecord(ctx, "DROP_ATTR;"+attr); err != nil {
+			return empty, err
+		}
+		return empty, nil
 	}
 
 	if op.DropOp == api.Operation_TYPE {
@@ -480,7 +505,12 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 
 		m.DropOp = pb.Mutations_TYPE
 		m.DropValue = dropPred
-		_, err := query.ApplyMutations(ctx, m)
+		change, err := worker.NewSchemaChange(ctx, nil, nil, nil, []string{dropPred})
+		if err != nil {
+			return empty, err
+		}
+		attachSchemaChange(ctx, m, change)
+		_, err = query.ApplyMutations(ctx, m)
 		return empty, err
 	}
 	result, err := parseSchemaFromAlterOperation(ctx, op.Schema)
@@ -496,6 +526,15 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	// TODO: Maybe add some checks about the schema.
 	m.Schema = result.Preds
 	m.Types = result.Types
+	uniqueKeys := newUniqueKeys(result.Types)
+	if err := verifyUniqueKeys(ctx, uniqueKeys); err != nil {
+		return empty, err
+	}
+	change, err := worker.NewSchemaChange(ctx, result.Preds, result.Types, nil, nil)
+	if err != nil {
+		return empty, err
+	}
+	attachSchemaChange(ctx, m, change)
 	_, err = query.ApplyMutations(ctx, m)
 	if err != nil {
 		return empty, err
@@ -505,10 +544,80 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	if err = worker.WaitForIndexing(ctx, !op.RunInBackground); err != nil {
 		return empty, err
 	}
-
+	if err := backfillUniqueKeys(ctx, uniqueKeys); err != nil {
+		return empty, err
+	}
 	return empty, nil
 }
 
+// typeUniqueKey is a composite unique key of a type.
+type typeUniqueKey struct {
+	typeName string
+	key      *pb.UniqueKey
+}
+
+// newUniqueKeys returns the composite unique keys of the types not present in the current
+// schema.
+func newUniqueKeys(typs []*pb.TypeUpdate) []typeUniqueKey {
+	var out []typeUniqueKey
+	for _, typ := range typs {
+		current := make(map[string]struct{})
+		if prev, ok := schema.State().GetType(typ.TypeName); ok {
+			for _, key := range prev.UniqueKeys {
+				current[schema.UniqueKeyPredicate(prev.TypeName, key)] = struct{}{}
+			}
+		}
+		for _, key := range typ.UniqueKeys {
+			if _, ok := current[schema.UniqueKeyPredicate(typ.TypeName, key)]; !ok {
+				out = append(out, typeUniqueKey{typeName: typ.TypeName, key: key})
+			}
+		}
+	}
+	return out
+}
+
+// verifyUniqueKeys rejects the unique keys whose combined values are already shared by nodes
+// of their type, like the existing duplicates of a @unique predicate are rejected by
+// verifyUnique.
+func verifyUniqueKeys(ctx context.Context, keys []typeUniqueKey) error {
+	for _, k := range keys {
+		if err := worker.VerifyUniqueKey(ctx, k.typeName, k.key); err != nil {
+			return errors.Wrapf(err, "Please fix the data before adding the unique key")
+		}
+	}
+	return nil
+}
+
+// backfillUniqueKeys indexes the combined values of the new unique keys for the nodes written
+// before they were declared. Nodes written since verifyUniqueKeys may still duplicate them.
+func backfillUniqueKeys(ctx context.Context, keys []typeUniqueKey) error {
+	for _, k := range keys {
+		if err := worker.BackfillUniqueKey(ctx, k.typeName, k.key); err != nil {
+			return errors.Wrapf(err, "while building the unique key of type %s. Please drop the "+
+				"unique key and add it again after fixing the data", x.ParseAttr(k.typeName))
+		}
+	}
+	return nil
+}
+
+// markBuildingIndexes marks the composite indexes of the types not present in the current schema
+// as building, so that queries don't use them until they are built for the existing nodes. The
+// other ones keep their state.
+func markBuildingIndexes(typs []*pb.TypeUpdate) {
+	for _, typ := range typs {
+		current := make(map[string]bool)
+		if prev, ok := schema.State().GetType(typ.TypeName); ok {
+			for _, index := range prev.CompositeIndexes {
+				current[schema.CompositeIndexPredicate(prev.TypeName, index)] = index.Building
+			}
+		}
+		for _, index := range typ.CompositeIndexes {
+			building, ok := current[schema.CompositeIndexPredicate(typ.TypeName, index)]
+			index.Building = building || !ok
+		}
+	}
+}
+
 func annotateNamespace(span trace.Span, ns uint64) {
 	span.SetAttributes(attribute.String("ns", fmt.Sprintf("%d", ns)))
 }
@@ -1552,7 +1661,7 @@ func parseRequest(ctx context.Context, qc *queryContext) error {
 		// parsing mutations
 		qc.gmuList = make([]*dql.Mutation, 0, len(qc.req.Mutations))
 		for _, mu := range qc.req.Mutations {
-			gmu, err := ParseMutationObject(mu, qc.graphql)
+			gmu, err := ParseMutationObject(ctx, mu, qc.graphql)
 			if err != nil {
 				return err
 			}
@@ -1948,11 +2057,18 @@ func hasPoormansAuth(ctx context.Context) error {
 // api.Mutation#SetJson, api.Mutation#SetNquads and api.Mutation#Set are consolidated into the
 // dql.Mutation.Set field. Similarly the 3 fields api.Mutation#DeleteJson, api.Mutation#DelNquads
 // and api.Mutation#Del are merged into the dql.Mutation#Del field.
-func ParseMutationObject(mu *api.Mutation, isGraphql bool) (*dql.Mutation, error) {
+func ParseMutationObject(ctx context.Context, mu *api.Mutation,
+	isGraphql bool) (*dql.Mutation, error) {
+
 	res := &dql.Mutation{Cond: mu.Cond}
 
+	jsonTypes := newJSONTypes(ctx)
 	if len(mu.SetJson) > 0 {
-		nqs, md, err := chunker.ParseJSON(mu.SetJson, chunker.SetNquads)
+		nqs, md, err := chunker.ParseJSONWithPredicates(mu.SetJson, chunker.SetNquads,
+			jsonTypes.predicate)
+		if err == nil {
+			err = jsonTypes.err
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1961,7 +2077,11 @@ func ParseMutationObject(mu *api.Mutation, isGraphql bool) (*dql.Mutation, error
 	}
 	if len(mu.DeleteJson) > 0 {
 		// The metadata is not currently needed for delete operations so it can be safely ignored.
-		nqs, _, err := chunker.ParseJSON(mu.DeleteJson, chunker.DeleteNquads)
+		nqs, _, err := chunker.ParseJSONWithPredicates(mu.DeleteJson, chunker.DeleteNquads,
+			jsonTypes.predicate)
+		if err == nil {
+			err = jsonTypes.err
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1998,6 +2118,50 @@ func ParseMutationObject(mu *api.Mutation, isGraphql bool) (*dql.Mutation, error
 	return res, nil
 }
 
+// jsonTypes tells whether the predicates of the mutations of a request are of type json. The
+// types are read from the groups serving the predicates, and the first error met doing so is
+// kept in err.
+type jsonTypes struct {
+	ctx    context.Context
+	galaxy bool
+	ns     uint64
+	isJSON map[string]bool
+	err    error
+}
+
+func newJSONTypes(ctx context.Context) *jsonTypes {
+	ns, _ := x.ExtractNamespace(ctx)
+	return &jsonTypes{
+		ctx:    ctx,
+		galaxy: x.IsRootNsOperation(ctx),
+		ns:     ns,
+		isJSON: make(map[string]bool),
+	}
+}
+
+// predicate returns whether the predicate is of type json. Only the guardians of the galaxy
+// mutate the predicates of other namespaces.
+func (j *jsonTypes) predicate(namespace uint64, pred string) bool {
+	if !j.galaxy {
+		namespace = j.ns
+	}
+	attr := x.NamespaceAttr(namespace, pred)
+	if isJSON, ok := j.isJSON[attr]; ok {
+		return isJSON
+	}
+
+	nodes, err := worker.GetSchemaOverNetwork(j.ctx, &pb.SchemaRequest{
+		Predicates: []string{attr},
+		Fields:     []string{"type"},
+	})
+	isJSON := err == nil && len(nodes) > 0 && nodes[0].Type == types.JSONID.Name()
+	if err != nil && j.err == nil {
+		j.err = errors.Wrapf(err, "cannot retrieve the type of predicate %s", pred)
+	}
+	j.isJSON[attr] = isJSON
+	return isJSON
+}
+
 func validateAndConvertFacets(nquads []*api.NQuad) error {
 	for _, m := range nquads {
 		encodedFacets := make([]*api.Facet, 0, len(m.Facets))
@@ -2126,14 +2290,31 @@ func formatTypes(typeList []*pb.TypeUpdate) []map[string]interface{} {
 	for _, typ := range typeList {
 		typeMap := make(map[string]interface{})
 		typeMap["name"] = typ.TypeName
-		fields := make([]map[string]string, len(typ.Fields))
+		fields := make([]map[string]interface{}, len(typ.Fields))
 
 		for i, field := range typ.Fields {
-			m := make(map[string]string, 1)
+			m := make(map[string]interface{}, 1)
 			m["name"] = field.Predicate
+			if field.NonNullable {
+				m["non_nullable"] = true
+			}
 			fields[i] = m
 		}
 		typeMap["fields"] = fields
+		if len(typ.UniqueKeys) > 0 {
+			unique := make([][]string, len(typ.UniqueKeys))
+			for i, key := range typ.UniqueKeys {
+				unique[i] = key.Predicates
+			}
+			typeMap["unique"] = unique
+		}
+		if len(typ.CompositeIndexes) > 0 {
+			indexes := make([][]string, len(typ.CompositeIndexes))
+			for i, index := range typ.CompositeIndexes {
+				indexes[i] = index.Predicates
+			}
+			typeMap["index"] = indexes
+		}
 
 		res = append(res, typeMap)
 	}
@@ -195,7 +195,7 @@ const (
 
 	input ExportInput {
 		"""
-		Data format for the export, e.g. "rdf" or "json" (default: "rdf")
+		Data format for the export, e.g. "rdf", "json", "jsonld" or "parquet" (default: "rdf")
 		"""
 		format: String
 
@@ -372,6 +372,75 @@ const (
 		response: AssignedIds
 	}
 
+	type VectorIndexLevelStats {
+		level: Int
+		nodes: UInt64
+		edges: UInt64
+		avgDegree: Float
+	}
+
+	type VectorIndexStats {
+		predicate: String
+		index: String
+
+		"""
+		True while the vector index is being (re)built.
+		"""
+		building: Boolean
+		vectorsProcessed: UInt64
+		vectorsTotal: UInt64
+		buildStartedAt: DateTime
+
+		numVectors: UInt64
+
+		"""
+		Number of vectors that have no neighbors on any level of the graph.
+		"""
+		orphanedNodes: UInt64
+		levels: [VectorIndexLevelStats]
+	}
+
+	type SchemaDefinitionChange {
+		name: String
+
+		"""
+		Definition before the change, null if it was created by the change.
+		"""
+		before: String
+
+		"""
+		Definition after the change, null if it was dropped by the change.
+		"""
+		after: String
+	}
+
+	type SchemaChange {
+		"""
+		Version of the schema resulting from the change.
+		"""
+		version: UInt64
+		user: String
+		time: DateTime
+
+		"""
+		Version the schema was rolled back to, if the change is a rollback.
+		"""
+		rollbackTo: UInt64
+		predicates: [SchemaDefinitionChange]
+		types: [SchemaDefinitionChange]
+	}
+
+	input RollbackSchemaInput {
+		"""
+		Version of the schema to roll back to, as reported by schemaHistory.
+		"""
+		version: UInt64!
+	}
+
+	type RollbackSchemaPayload {
+		response: Response
+	}
+
 	` + adminTypes + `
 
 	type Query {
@@ -380,6 +449,17 @@ const (
 		state: MembershipState
 		config: Config
 		task(input: TaskInput!): TaskPayload
+
+		"""
+		Build progress and graph statistics of the vector indexes. If no predicates
+		are given, all the vector predicates of the namespace are reported.
+		"""
+		vectorIndexStats(predicates: [String!]): [VectorIndexStats]
+
+		"""
+		The changes made to the Dgraph schema of the namespace, latest first.
+		"""
+		schemaHistory: [SchemaChange]
 		` + adminQueries + `
 	}
 
@@ -428,6 +508,14 @@ const (
 		"""
 		assign(input: AssignInput!): AssignPayload
 
+		"""
+		Roll back the predicates and types changed after a version of the Dgraph schema to
+		their definitions at that version. Types created after it are dropped, while the
+		predicates created after it are kept along with their data. The indexes are rebuilt
+		in the background.
+		"""
+		rollbackSchema(input: RollbackSchemaInput!): RollbackSchemaPayload
+
 		` + adminMutations + `
 	}
  `
@@ -483,11 +571,13 @@ var (
 		resolve.LoggingMWMutation,
 	}
 	adminQueryMWConfig = map[string]resolve.QueryMiddlewares{
-		"health":       minimalAdminQryMWs, // dgraph checks Guardian auth for health
-		"state":        minimalAdminQryMWs, // dgraph checks Guardian auth for state
-		"config":       gogQryMWs,
-		"listBackups":  gogQryMWs,
-		"getGQLSchema": stdAdminQryMWs,
+		"health":           minimalAdminQryMWs, // dgraph checks Guardian auth for health
+		"state":            minimalAdminQryMWs, // dgraph checks Guardian auth for state
+		"config":           gogQryMWs,
+		"listBackups":      gogQryMWs,
+		"getGQLSchema":     stdAdminQryMWs,
+		"vectorIndexStats": stdAdminQryMWs,
+		"schemaHistory":    stdAdminQryMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"queryUser":      minimalAdminQryMWs,
@@ -508,6 +598,7 @@ var (
 		"moveTablet":      gogMutMWs,
 		"assign":          gogMutMWs,
 		"updateGQLSchema": stdAdminMutMWs,
+		"rollbackSchema":  stdAdminMutMWs,
 		"addNamespace":    gogAclMutMWs,
 		"deleteNamespace": gogAclMutMWs,
 		"resetPassword":   gogAclMutMWs,
@@ -742,6 +833,7 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		"moveTablet":      resolveMoveTablet,
 		"assign":          resolveAssign,
 		"restoreTenant":   resolveTenantRestore,
+		"rollbackSchema":  resolveRollbackSchema,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
@@ -762,6 +854,12 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("task", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveTask)
 		}).
+		WithQueryResolver("vectorIndexStats", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveVectorIndexStats)
+		}).
+		WithQueryResolver("schemaHistory", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveSchemaHistory)
+		}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/edgraph"
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func resolveSchemaHistory(ctx context.Context, q schema.Query) *resolve.Resolved {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	history, err := worker.GetSchemaHistory(ns)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	// The latest changes come first.
+	res := make([]interface{}, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		change := history[i]
+		entry := map[string]interface{}{
+			"version":    json.Number(strconv.FormatUint(change.Version, 10)),
+			"user":       change.User,
+			"time":       time.Unix(change.Time, 0).UTC().Format(time.RFC3339),
+			"predicates": predicateDiffs(change),
+			"types":      typeDiffs(change),
+		}
+		if change.RollbackTo > 0 {
+			entry["rollbackTo"] = json.Number(strconv.FormatUint(change.RollbackTo, 10))
+		}
+		res = append(res, entry)
+	}
+
+	return resolve.DataResult(
+		q,
+		map[string]interface{}{q.Name(): res},
+		nil,
+	)
+}
+
+func predicateDiffs(change *pb.SchemaChange) []interface{} {
+	diffs := make(map[string]map[string]interface{})
+	diff := func(name string) map[string]interface{} {
+		if _, ok := diffs[name]; !ok {
+			diffs[name] = map[string]interface{}{"name": x.ParseAttr(name)}
+		}
+		return diffs[name]
+	}
+	for _, su := range change.OldPreds {
+		diff(su.Predicate)["before"] = worker.PredicateDefinition(su)
+	}
+	for _, su := range change.NewPreds {
+		diff(su.Predicate)["after"] = worker.PredicateDefinition(su)
+	}
+	return sortedDiffs(diffs)
+}
+
+func typeDiffs(change *pb.SchemaChange) []interface{} {
+	diffs := make(map[string]map[string]interface{})
+	diff := func(name string) map[string]interface{} {
+		if _, ok := diffs[name]; !ok {
+			diffs[name] = map[string]interface{}{"name": x.ParseAttr(name)}
+		}
+		return diffs[name]
+	}
+	for _, tu := range change.OldTypes {
+		diff(tu.TypeName)["before"] = worker.TypeDefinition(tu)
+	}
+	for _, tu := range change.NewTypes {
+		diff(tu.TypeName)["after"] = worker.TypeDefinition(tu)
+	}
+	return sortedDiffs(diffs)
+}
+
+func sortedDiffs(diffs map[string]map[string]interface{}) []interface{} {
+	names := make([]string, 0, len(diffs))
+	for name := range diffs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		out = append(out, diffs[name])
+	}
+	return out
+}
+
+func resolveRollbackSchema(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(m, inputArgError(errors.Errorf("can't convert input to map"))),
+			false
+	}
+	version, err := parseAsUint64(inputArg["version"])
+	if err != nil {
+		return resolve.EmptyResult(m, inputArgError(schema.GQLWrapf(err,
+			"can't convert input.version to uint64"))), false
+	}
+
+	if err := (&edgraph.Server{}).RollbackSchema(ctx, version); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	msg := fmt.Sprintf("Rolled back the schema to version %d. The indexes are being rebuilt in"+
+		" the background.", version)
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success", msg)},
+		nil,
+	), true
+}
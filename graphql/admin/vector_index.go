@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func resolveVectorIndexStats(ctx context.Context, q schema.Query) *resolve.Resolved {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	req := &pb.VectorIndexStatsRequest{Namespace: ns}
+	preds, _ := q.ArgValue("predicates").([]interface{})
+	for _, p := range preds {
+		if pred, ok := p.(string); ok {
+			req.Predicates = append(req.Predicates, x.NamespaceAttr(ns, pred))
+		}
+	}
+
+	stats, err := worker.GetVectorIndexStatsOverNetwork(ctx, req)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(stats))
+	for _, s := range stats {
+		levels := make([]interface{}, 0, len(s.Levels))
+		for _, l := range s.Levels {
+			var avgDegree float64
+			if l.Nodes > 0 {
+				avgDegree = float64(l.Edges) / float64(l.Nodes)
+			}
+			levels = append(levels, map[string]interface{}{
+				"level":     int64(l.Level),
+				"nodes":     l.Nodes,
+				"edges":     l.Edges,
+				"avgDegree": avgDegree,
+			})
+		}
+		stat := map[string]interface{}{
+			"predicate":        x.ParseAttr(s.Predicate),
+			"index":            s.Index,
+			"building":         s.Building,
+			"vectorsProcessed": s.VectorsProcessed,
+			"vectorsTotal":     s.VectorsTotal,
+			"numVectors":       s.NumVectors,
+			"orphanedNodes":    s.OrphanedNodes,
+			"levels":           levels,
+		}
+		if s.Building {
+			stat["buildStartedAt"] = time.Unix(s.BuildStartedAt, 0).UTC().Format(time.RFC3339)
+		}
+		res = append(res, stat)
+	}
+
+	return resolve.DataResult(
+		q,
+		map[string]interface{}{q.Name(): res},
+		nil,
+	)
+}
@@ -0,0 +1,189 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// The values of the indexed facets of a predicate are kept in the index of the predicate,
+// under tokens made of tok.IdentFacet, the name of the facet, a zero byte, the type of the
+// value and an encoding of the value that sorts in the same order as the values. The index
+// maps the tokens to the subjects having an edge with the facet value.
+
+// FacetIndexPrefix returns the common prefix of the tokens of the values of the given type
+// of the facet.
+func FacetIndexPrefix(facet string, vt api.Facet_ValType) string {
+	return string([]byte{tok.IdentFacet}) + facet + "\x00" + string([]byte{byte(vt)})
+}
+
+// FacetIndexToken returns the token of the value of the facet in the facet index.
+func FacetIndexToken(f *api.Facet) (string, error) {
+	val, err := facets.ValFor(f)
+	if err != nil {
+		return "", err
+	}
+	var b []byte
+	switch v := val.Value.(type) {
+	case int64:
+		b = binary.BigEndian.AppendUint64(nil, uint64(v)^(1<<63))
+	case float64:
+		bits := math.Float64bits(v)
+		if bits&(1<<63) != 0 {
+			bits = ^bits
+		} else {
+			bits |= 1 << 63
+		}
+		b = binary.BigEndian.AppendUint64(nil, bits)
+	case time.Time:
+		b = binary.BigEndian.AppendUint64(nil, uint64(v.Unix())^(1<<63))
+		b = binary.BigEndian.AppendUint32(b, uint32(v.Nanosecond()))
+	case bool:
+		b = []byte{0}
+		if v {
+			b[0] = 1
+		}
+	case string:
+		b = []byte(v)
+	default:
+		return "", errors.Errorf("Unsupported value of facet %s", f.Key)
+	}
+	return FacetIndexPrefix(f.Key, f.ValType) + string(b), nil
+}
+
+// facetIndexTokens returns the tokens of the values of the indexed facets of the edges of the
+// posting list, as seen at readTs.
+func (l *List) facetIndexTokens(readTs uint64, names []string) (map[string]struct{}, error) {
+	tokens := make(map[string]struct{})
+	err := l.Iterate(readTs, 0, func(p *pb.Posting) error {
+		for _, f := range p.Facets {
+			if !slices.Contains(names, f.Key) {
+				continue
+			}
+			token, err := FacetIndexToken(f)
+			if err != nil {
+				return err
+			}
+			tokens[token] = struct{}{}
+		}
+		return nil
+	})
+	return tokens, err
+}
+
+// updateFacetIndex updates the facet index of the subject with the difference between the
+// tokens of its edges before and after a mutation.
+func (txn *Txn) updateFacetIndex(ctx context.Context, attr string, uid uint64,
+	before, after map[string]struct{}) error {
+
+	edge := &pb.DirectedEdge{ValueId: uid, Attr: attr}
+	for token := range before {
+		if _, ok := after[token]; ok {
+			continue
+		}
+		edge.Op = pb.DirectedEdge_DEL
+		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
+			return err
+		}
+	}
+	for token := range after {
+		if _, ok := before[token]; ok {
+			continue
+		}
+		edge.Op = pb.DirectedEdge_SET
+		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// needsFacetIndexRebuild returns the indexed facets that were added and removed.
+func (rb *IndexRebuild) needsFacetIndexRebuild() ([]string, []string) {
+	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
+	var prev []string
+	if rb.OldSchema != nil {
+		prev = rb.OldSchema.FacetIndex
+	}
+
+	var added, removed []string
+	for _, name := range rb.CurrentSchema.FacetIndex {
+		if !slices.Contains(prev, name) {
+			added = append(added, name)
+		}
+	}
+	for _, name := range prev {
+		if !slices.Contains(rb.CurrentSchema.FacetIndex, name) {
+			removed = append(removed, name)
+		}
+	}
+	return added, removed
+}
+
+func prefixesToDropFacetIndexes(rb *IndexRebuild) [][]byte {
+	added, removed := rb.needsFacetIndexRebuild()
+	var prefixes [][]byte
+	for _, name := range append(added, removed...) {
+		prefix := x.IndexKey(rb.Attr, string([]byte{tok.IdentFacet})+name+"\x00")
+		prefixes = append(prefixes, prefix)
+
+		// All the parts of any list that has been split into multiple parts.
+		split := slices.Clone(prefix)
+		split[0] = x.ByteSplit
+		prefixes = append(prefixes, split)
+	}
+	return prefixes
+}
+
+// rebuildFacetIndexes builds the indexes of the facets added to the predicate.
+func rebuildFacetIndexes(ctx context.Context, rb *IndexRebuild) error {
+	added, _ := rb.needsFacetIndexRebuild()
+	if len(added) == 0 {
+		return nil
+	}
+
+	glog.Infof("Rebuilding facet indexes %v for %s", added, rb.Attr)
+	pk := x.ParsedKey{Attr: rb.Attr}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder.fn = func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error) {
+		tokens, err := pl.facetIndexTokens(txn.StartTs, added)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			err := txn.updateFacetIndex(ctx, rb.Attr, uid, nil, tokens)
+			switch err {
+			case ErrRetry:
+				time.Sleep(10 * time.Millisecond)
+			default:
+				return []*pb.DirectedEdge{}, err
+			}
+		}
+	}
+	return builder.Run(ctx)
+}
+
+// indexedFacets returns the indexed facets of the predicate, if any.
+func indexedFacets(ctx context.Context, attr string) []string {
+	if pstore == nil {
+		return nil
+	}
+	return schema.State().FacetIndexes(ctx, attr)
+}
@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -65,6 +66,11 @@ func indexTokens(ctx context.Context, info *indexMutationInfo) ([]string, error)
 	if err != nil {
 		return nil, err
 	}
+	// Values not satisfying the conditions of a partial index are left out of it.
+	conds := schema.State().IndexConditions(ctx, attr)
+	if ok, err := MatchesIndexConditions(sv, conds); err != nil || !ok {
+		return nil, err
+	}
 
 	var tokens []string
 	for _, it := range info.tokenizers {
@@ -77,6 +83,27 @@ func indexTokens(ctx context.Context, info *indexMutationInfo) ([]string, error)
 	return tokens, nil
 }
 
+// MatchesIndexConditions returns whether the value satisfies all the conditions of a partial
+// index.
+func MatchesIndexConditions(val types.Val, conds []*pb.IndexCondition) (bool, error) {
+	for _, cond := range conds {
+		cv, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(cond.Value)}, val.Tid)
+		if err != nil {
+			return false, err
+		}
+		var ok bool
+		if cond.Op == "ne" {
+			ok = !types.CompareVals("eq", val, cv)
+		} else {
+			ok = types.CompareVals(cond.Op, val, cv)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // addIndexMutations adds mutation(s) for a single term, to maintain the index,
 // but only for the given tokenizers.
 // TODO - See if we need to pass op as argument as t should already have Op.
@@ -100,6 +127,10 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 		return []*pb.DirectedEdge{}, errors.New("invalid UID with value 0")
 	}
 
+	if len(info.factorySpecs) > 0 && schema.State().IsList(attr) {
+		return txn.addVectorChunkIndexMutations(ctx, info)
+	}
+
 	if len(info.factorySpecs) > 0 {
 		inKey := x.DataKey(info.edge.Attr, uid)
 		pl, err := txn.Get(inKey)
@@ -185,11 +216,12 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 		return []*pb.DirectedEdge{}, err
 	}
 
-	// Create a value token -> uid edge.
+	// Create a value token -> uid edge, which expires with the value.
 	edge := &pb.DirectedEdge{
-		ValueId: uid,
-		Attr:    attr,
-		Op:      info.op,
+		ValueId:   uid,
+		Attr:      attr,
+		Op:        info.op,
+		ExpiresAt: info.edge.ExpiresAt,
 	}
 
 	for _, token := range tokens {
@@ -283,11 +315,12 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *pb.DirectedEdge) erro
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 	if err := plist.addMutation(ctx, txn, edge); err != nil {
 		return err
@@ -347,11 +380,12 @@ func (txn *Txn) addReverseAndCountMutation(ctx context.Context, t *pb.DirectedEd
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 
 	cp, err := txn.addReverseMutationHelper(ctx, plist, hasCountIndex, edge)
@@ -582,12 +616,35 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 
 // AddMutationWithIndex is addMutation with support for indexing. It also
 // supports reverse edges.
-func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge, txn *Txn) error {
+func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
+	txn *Txn) (rerr error) {
 	if edge.Attr == "" {
 		return errors.Errorf("Predicate cannot be empty for edge with subject: [%v], object: [%v]"+
 			" and value: [%v]", edge.Entity, edge.ValueId, edge.Value)
 	}
 
+	// The facet index of the subject is updated with the difference between the tokens of
+	// its edges before and after the mutation, as other edges may share the facet values.
+	var facetTokens map[string]struct{}
+	names := indexedFacets(ctx, edge.Attr)
+	if len(names) > 0 {
+		var err error
+		if facetTokens, err = l.facetIndexTokens(txn.StartTs, names); err != nil {
+			return err
+		}
+		defer func() {
+			if rerr != nil {
+				return
+			}
+			after, err := l.facetIndexTokens(txn.StartTs, names)
+			if err != nil {
+				rerr = err
+				return
+			}
+			rerr = txn.updateFacetIndex(ctx, edge.Attr, edge.Entity, facetTokens, after)
+		}()
+	}
+
 	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
 		return l.handleDeleteAll(ctx, edge, txn)
 	}
@@ -687,6 +744,10 @@ func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
 	stream.Prefix = r.prefix
 	stream.NumGo = 16
 	txn := NewTxn(r.startTs)
+
+	progress := startVectorIndexBuild(ctx, r.attr, r.prefix, r.startTs)
+	defer finishVectorIndexBuild(r.attr)
+
 	stream.KeyToList = func(key []byte, it *badger.Iterator) (*bpb.KVList, error) {
 		// We should return quickly if the context is no longer valid.
 		select {
@@ -757,6 +818,7 @@ func (r *rebuilder) RunWithoutTemp(ctx context.Context) error {
 		if _, err := r.fn(pk.Uid, l, txn); err != nil {
 			return nil, err
 		}
+		progress.processed.Add(1)
 		return nil, nil
 	}
 	stream.Send = func(buf *z.Buffer) error {
@@ -1150,6 +1212,11 @@ func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 	}
 	querySchema.Tokenizer = interimTokenizers
 
+	// Facets are only served from their index once it is built.
+	added, _ := rb.needsFacetIndexRebuild()
+	querySchema.FacetIndex = slices.DeleteFunc(slices.Clone(rb.CurrentSchema.FacetIndex),
+		func(name string) bool { return slices.Contains(added, name) })
+
 	if rb.needsCountIndexRebuild() == indexRebuild {
 		querySchema.Count = false
 	}
@@ -1174,6 +1241,7 @@ func (rb *IndexRebuild) DropIndexes(ctx context.Context) error {
 	prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
 	prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
 	prefixes = append(prefixes, prefixesToDropVectorIndexEdges(ctx, rb)...)
+	prefixes = append(prefixes, prefixesToDropFacetIndexes(rb)...)
 	if len(prefixes) > 0 {
 		// This trace message now gets logged only if there are any prefixes to
 		// to be deleted
@@ -1188,12 +1256,14 @@ func (rb *IndexRebuild) BuildData(ctx context.Context) error {
 	return rebuildListType(ctx, rb)
 }
 
-// NeedIndexRebuild returns true if any of the tokenizer, reverse
-// or count indexes need to be rebuilt.
+// NeedIndexRebuild returns true if any of the tokenizer, reverse,
+// count or facet indexes need to be rebuilt.
 func (rb *IndexRebuild) NeedIndexRebuild() bool {
+	added, _ := rb.needsFacetIndexRebuild()
 	return rb.needsTokIndexRebuild().op == indexRebuild ||
 		rb.needsReverseEdgesRebuild() == indexRebuild ||
-		rb.needsCountIndexRebuild() == indexRebuild
+		rb.needsCountIndexRebuild() == indexRebuild ||
+		len(added) > 0
 }
 
 // BuildIndexes builds indexes.
@@ -1204,6 +1274,9 @@ func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
 	if err := rebuildReverseEdges(ctx, rb); err != nil {
 		return err
 	}
+	if err := rebuildFacetIndexes(ctx, rb); err != nil {
+		return err
+	}
 	return rebuildCountIndex(ctx, rb)
 }
 
@@ -1252,8 +1325,9 @@ func (rb *IndexRebuild) needsTokIndexRebuild() *indexRebuildInfo {
 	}
 
 	// All tokenizers in the index need to be deleted and rebuilt if the value
-	// types have changed.
-	if currIndex && rb.CurrentSchema.ValueType != old.ValueType {
+	// types or the conditions of a partial index have changed.
+	if currIndex && (rb.CurrentSchema.ValueType != old.ValueType ||
+		!slices.EqualFunc(rb.CurrentSchema.Where, old.Where, equalIndexConditions)) {
 		return &indexRebuildInfo{
 			op:                     indexRebuild,
 			attr:                   rb.Attr,
@@ -1472,7 +1546,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 		// 1. This can be a schema mutation where the user adds a index on existing vectors.
 		// 2. This can be a vector mutation where the user adds vectors to the DB on a
 		// predicate that is already indexed.
-		if runForVectors {
+		// Every vector of a list is indexed on its own, see addVectorChunkIndexMutations.
+		if runForVectors && !schema.State().IsList(rb.Attr) {
 			val, err := pl.Value(txn.StartTs)
 			if err != nil {
 				return []*pb.DirectedEdge{}, err
@@ -1699,14 +1774,23 @@ func prefixesToDropVectorIndexEdges(ctx context.Context, rb *IndexRebuild) [][]b
 		return nil
 	}
 
-	prefixes := append([][]byte{}, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecEntry)))
-	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecDead)))
-	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecKeyword)))
+	prefixes := vectorIndexPrefixes(rb.Attr)
+	// The chunks of a list of vectors predicate, and their index.
+	chunkAttr := hnsw.ConcatStrings(rb.Attr, hnsw.VecChunk)
+	prefixes = append(prefixes, x.PredicatePrefix(chunkAttr))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecChunkOwner)))
+	prefixes = append(prefixes, vectorIndexPrefixes(chunkAttr)...)
+	return prefixes
+}
+
+func vectorIndexPrefixes(attr string) [][]byte {
+	prefixes := append([][]byte{}, x.PredicatePrefix(hnsw.ConcatStrings(attr, hnsw.VecEntry)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(attr, hnsw.VecDead)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(attr, hnsw.VecKeyword)))
 
 	for i := range hnsw.VectorIndexMaxLevels {
-		prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecKeyword, fmt.Sprint(i))))
+		prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(attr, hnsw.VecKeyword, fmt.Sprint(i))))
 	}
-
 	return prefixes
 }
 
@@ -1869,6 +1953,9 @@ func DeleteAll() error {
 func DeleteAllForNs(ns uint64) error {
 	ResetCache()
 	schema.State().DeletePredsForNs(ns)
+	if err := pstore.DropPrefix(x.SchemaHistoryPrefix(ns)); err != nil {
+		return err
+	}
 	return DeleteData(ns)
 }
 
@@ -1906,3 +1993,119 @@ func DeleteNamespace(ns uint64) error {
 	schema.State().DeletePredsForNs(ns)
 	return pstore.BanNamespace(ns)
 }
+
+// IsCompositeIndexType returns whether values of the type can be components of composite
+// indexes.
+func IsCompositeIndexType(tid types.TypeID) bool {
+	switch tid {
+	case types.DefaultID, types.StringID, types.IntID, types.FloatID, types.DateTimeID,
+		types.BoolID, types.UidID:
+		return true
+	}
+	return false
+}
+
+// CompositeIndexToken returns the token of a value as a component of a composite index. The
+// tokens sort in the same order as the values. The tokens of floats and datetimes are lossy,
+// hence the uids found through a range of tokens must be filtered by their values.
+func CompositeIndexToken(val types.Val) ([]byte, error) {
+	var tokenizer tok.Tokenizer
+	switch val.Tid {
+	case types.DefaultID, types.StringID:
+		return []byte(val.Value.(string)), nil
+	case types.UidID:
+		return binary.BigEndian.AppendUint64(nil, val.Value.(uint64)), nil
+	case types.IntID:
+		tokenizer = tok.IntTokenizer{}
+	case types.FloatID:
+		tokenizer = tok.FloatTokenizer{}
+	case types.DateTimeID:
+		tokenizer = tok.HourTokenizer{}
+	case types.BoolID:
+		tokenizer = tok.BoolTokenizer{}
+	default:
+		return nil, errors.Errorf("Values of type %s cannot be part of a composite index",
+			val.Tid.Name())
+	}
+	tokens, err := tokenizer.Tokens(val.Value)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(tokens[0]), nil
+}
+
+// CompositeIndexValue returns the value stored in the internal predicate of a composite index
+// for the tokens of the leading components of the index. The tokens are escaped and terminated
+// so that the values sort in the order of the tuples of tokens, and hex encoded so that they
+// can be indexed with the exact tokenizer.
+func CompositeIndexValue(tokens [][]byte) string {
+	var buf []byte
+	for _, token := range tokens {
+		buf = appendCompositeComponent(buf, token)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// CompositeIndexEntry returns the value stored in the internal predicate of a composite index
+// for a node, given the binary values of the fields of the index in order. The components are
+// the fields up to the first one without a value, and nothing is stored if the first one has
+// no value.
+func CompositeIndexEntry(values []types.Val) (string, error) {
+	var tokens [][]byte
+	for _, val := range values {
+		if val.Value == nil {
+			break
+		}
+		native, err := types.Convert(val, val.Tid)
+		if err != nil {
+			return "", err
+		}
+		token, err := CompositeIndexToken(native)
+		if err != nil {
+			return "", err
+		}
+		tokens = append(tokens, token)
+	}
+	if len(tokens) == 0 {
+		return "", nil
+	}
+	return CompositeIndexValue(tokens), nil
+}
+
+// CompositeIndexRange returns the inclusive bounds of the values of a composite index whose
+// leading components have the given tokens. If lo or hi is not nil, the next component must
+// also be at least lo or at most hi, respectively.
+func CompositeIndexRange(eq [][]byte, lo, hi []byte) (string, string) {
+	var prefix []byte
+	for _, token := range eq {
+		prefix = appendCompositeComponent(prefix, token)
+	}
+	lower, upper := prefix, prefix
+	if lo != nil {
+		lower = appendCompositeEscaped(bytes.Clone(prefix), lo)
+	}
+	if hi != nil {
+		upper = appendCompositeComponent(bytes.Clone(prefix), hi)
+	}
+	// No hex digit sorts after 'g', so the upper bound covers all the values that extend it.
+	return hex.EncodeToString(lower), hex.EncodeToString(upper) + "g"
+}
+
+func appendCompositeComponent(buf, token []byte) []byte {
+	return append(appendCompositeEscaped(buf, token), 0x00, 0x01)
+}
+
+func appendCompositeEscaped(buf, token []byte) []byte {
+	for _, b := range token {
+		if b == 0x00 {
+			buf = append(buf, 0x00, 0xff)
+			continue
+		}
+		buf = append(buf, b)
+	}
+	return buf
+}
+
+func equalIndexConditions(a, b *pb.IndexCondition) bool {
+	return a.Op == b.Op && a.Value == b.Value
+}
@@ -9,15 +9,20 @@ import (
 	"bytes"
 	"context"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
 	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -76,6 +81,19 @@ func TestIndexing(t *testing.T) {
 	require.EqualValues(t, "\x01abc", a[0])
 }
 
+func TestIndexingPartial(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(
+		`status:string @index(exact) @where(ne: "archived") .`), 1))
+	a, err := indexTokensForTest("status", "", types.Val{Tid: types.StringID, Value: []byte("open")})
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"\x02open"}, a)
+
+	a, err = indexTokensForTest("status", "",
+		types.Val{Tid: types.StringID, Value: []byte("archived")})
+	require.NoError(t, err)
+	require.Empty(t, a)
+}
+
 func TestIndexingMultiLang(t *testing.T) {
 	require.NoError(t, schema.ParseBytes([]byte("name:string @index(fulltext) ."), 1))
 
@@ -615,3 +633,176 @@ func TestNeedsListTypeRebuild(t *testing.T) {
 	require.False(t, rebuild)
 	require.Error(t, err)
 }
+
+func TestListOfVectorsIndex(t *testing.T) {
+	require.NoError(t, pstore.DropAll())
+	MemLayerInstance.clear()
+	require.NoError(t, schema.ParseBytes(
+		[]byte(`chunks: [float32vector] @index(hnsw(metric: "euclidean")) .`), 1))
+
+	ctx := context.Background()
+	attr := x.AttrInRootNamespace("chunks")
+	vecs := [][]float32{{1, 1, 1}, {5, 5, 5}}
+	chunkUid := func(vec []float32) uint64 {
+		return VectorChunkUid(1, types.FloatArrayAsBytes(vec))
+	}
+	owner := func(vec []float32, readTs uint64) uint64 {
+		o, err := VectorChunkOwner(NewLocalCache(readTs), attr, chunkUid(vec))
+		require.NoError(t, err)
+		return o
+	}
+	search := func(query []float32, readTs uint64) []uint64 {
+		specs, err := schema.State().FactoryCreateSpec(ctx, attr)
+		require.NoError(t, err)
+		indexer, err := specs[0].CreateIndex(hnsw.ConcatStrings(attr, hnsw.VecChunk))
+		require.NoError(t, err)
+		qc := hnsw.NewQueryCache(NewViLocalCache(NewLocalCache(readTs)), readTs)
+		res, err := indexer.Search(ctx, qc, query, 1, index.AcceptAll[float32])
+		require.NoError(t, err)
+		return res
+	}
+	mutate := func(vec []float32, op uint32, ts uint64) {
+		l, err := GetNoStore(x.DataKey(attr, 1), ts)
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{
+			Attr:      attr,
+			Entity:    1,
+			Value:     types.FloatArrayAsBytes(vec),
+			ValueType: pb.Posting_VFLOAT,
+		}
+		addMutation(t, l, edge, op, ts, ts+1, true)
+	}
+
+	mutate(vecs[0], Set, 1)
+	mutate(vecs[1], Set, 3)
+	require.Equal(t, uint64(1), owner(vecs[0], 5))
+	require.Equal(t, uint64(1), owner(vecs[1], 5))
+	require.Equal(t, []uint64{chunkUid(vecs[1])}, search([]float32{4, 4, 4}, 5))
+
+	mutate(vecs[1], Del, 5)
+	require.Equal(t, uint64(1), owner(vecs[0], 7))
+	require.Zero(t, owner(vecs[1], 7))
+	require.Equal(t, []uint64{chunkUid(vecs[0])}, search([]float32{4, 4, 4}, 7))
+}
+
+func TestCompositeIndexOrder(t *testing.T) {
+	value := func(vals ...types.Val) string {
+		var tokens [][]byte
+		for _, val := range vals {
+			token, err := CompositeIndexToken(val)
+			require.NoError(t, err)
+			tokens = append(tokens, token)
+		}
+		return CompositeIndexValue(tokens)
+	}
+	str := func(s string) types.Val { return types.Val{Tid: types.StringID, Value: s} }
+	num := func(i int64) types.Val { return types.Val{Tid: types.IntID, Value: i} }
+
+	// The values sort in the order of the tuples, including when a string is a prefix of
+	// another or contains zero bytes.
+	ordered := []string{
+		value(str("a")),
+		value(str("a"), num(-5)),
+		value(str("a"), num(3)),
+		value(str("a\x00")),
+		value(str("a\x00"), num(1)),
+		value(str("a\x01")),
+		value(str("ab"), num(-1)),
+		value(str("b")),
+	}
+	for i := 1; i < len(ordered); i++ {
+		require.Less(t, ordered[i-1], ordered[i])
+	}
+
+	token := func(val types.Val) []byte {
+		b, err := CompositeIndexToken(val)
+		require.NoError(t, err)
+		return b
+	}
+	lo, hi := CompositeIndexRange([][]byte{token(str("a"))}, nil, nil)
+	for _, v := range ordered[:3] {
+		require.True(t, lo <= v && v <= hi)
+	}
+	for _, v := range ordered[3:] {
+		require.False(t, lo <= v && v <= hi)
+	}
+
+	lo, hi = CompositeIndexRange([][]byte{token(str("a"))}, token(num(0)), token(num(3)))
+	require.False(t, lo <= ordered[1] && ordered[1] <= hi)
+	require.True(t, lo <= ordered[2] && ordered[2] <= hi)
+	require.False(t, lo <= ordered[3] && ordered[3] <= hi)
+}
+
+func TestCompositeIndexEntry(t *testing.T) {
+	binary := func(val types.Val) types.Val {
+		b := types.ValueForType(types.BinaryID)
+		require.NoError(t, types.Marshal(val, &b))
+		return types.Val{Tid: val.Tid, Value: b.Value}
+	}
+	token := func(val types.Val) []byte {
+		b, err := CompositeIndexToken(val)
+		require.NoError(t, err)
+		return b
+	}
+	status := types.Val{Tid: types.StringID, Value: "open"}
+	created := types.Val{Tid: types.IntID, Value: int64(7)}
+
+	entry, err := CompositeIndexEntry([]types.Val{binary(status), binary(created)})
+	require.NoError(t, err)
+	require.Equal(t, CompositeIndexValue([][]byte{token(status), token(created)}), entry)
+
+	// The components stop at the first field without a value.
+	entry, err = CompositeIndexEntry([]types.Val{binary(status), {}, binary(created)})
+	require.NoError(t, err)
+	require.Equal(t, CompositeIndexValue([][]byte{token(status)}), entry)
+
+	entry, err = CompositeIndexEntry([]types.Val{{}, binary(created)})
+	require.NoError(t, err)
+	require.Empty(t, entry)
+}
+
+func TestFacetIndexTokenOrder(t *testing.T) {
+	token := func(val interface{}, vt api.Facet_ValType) string {
+		f, err := facets.ToBinary("since", val, vt)
+		require.NoError(t, err)
+		token, err := FacetIndexToken(f)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(token, FacetIndexPrefix("since", vt)))
+		return token
+	}
+
+	// The tokens of the values of a type sort in the same order as the values.
+	ordered := [][]string{
+		{
+			token(int64(math.MinInt64), api.Facet_INT),
+			token(int64(-2), api.Facet_INT),
+			token(int64(0), api.Facet_INT),
+			token(int64(7), api.Facet_INT),
+		},
+		{
+			token(-1.5, api.Facet_FLOAT),
+			token(-0.25, api.Facet_FLOAT),
+			token(0.0, api.Facet_FLOAT),
+			token(3.75, api.Facet_FLOAT),
+		},
+		{
+			token(time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), api.Facet_DATETIME),
+			token(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), api.Facet_DATETIME),
+			token(time.Date(2020, 1, 1, 0, 0, 0, 1, time.UTC), api.Facet_DATETIME),
+		},
+		{
+			token(false, api.Facet_BOOL),
+			token(true, api.Facet_BOOL),
+		},
+		{
+			token("a", api.Facet_STRING),
+			token("ab", api.Facet_STRING),
+			token("b", api.Facet_STRING),
+		},
+	}
+	for _, tokens := range ordered {
+		for i := 1; i < len(tokens); i++ {
+			require.Less(t, tokens[i-1], tokens[i])
+		}
+	}
+}
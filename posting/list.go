@@ -13,6 +13,7 @@ import (
 	"log"
 	"math"
 	"sort"
+	"time"
 
 	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
@@ -744,10 +745,25 @@ func NewPosting(t *pb.DirectedEdge) *pb.Posting {
 		LangTag:     []byte(t.Lang),
 		Op:          op,
 		Facets:      t.Facets,
+		ExpiresAt:   t.ExpiresAt,
 	}
 	return p
 }
 
+// isExpired returns true if the posting has a time-to-live that ran out at the given unix time.
+func isExpired(p *pb.Posting, now uint64) bool {
+	return p.ExpiresAt != 0 && p.ExpiresAt <= now
+}
+
+func hasExpiringPostings(plist *pb.PostingList) bool {
+	for _, p := range plist.Postings {
+		if p.ExpiresAt != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func createDeleteAllPosting() *pb.Posting {
 	return &pb.Posting{
 		Op:    Del,
@@ -930,6 +946,9 @@ func (l *List) addMutationInternal(ctx context.Context, txn *Txn, t *pb.Directed
 	}
 
 	x.PrintMutationEdge(t, pk, txn.StartTs)
+	if mpost.ExpiresAt != 0 && (pk.IsData() || pk.IsReverse()) {
+		txn.addExpiringKey(l.key, mpost.ExpiresAt)
+	}
 
 	// We ensure that commit marks are applied to posting lists in the right
 	// order. We can do so by proposing them in the same order as received by the Oracle delta
@@ -1113,7 +1132,19 @@ func (l *List) pickPostings(readTs uint64) (uint64, []*pb.Posting) {
 	return deleteAllMarker, posts
 }
 
+// iterate is iterateAll, skipping the postings that have expired. The expired postings stay
+// in the list until they are deleted by the expiry sweeper, which also updates the indexes.
 func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *pb.Posting) error) error {
+	now := uint64(time.Now().Unix())
+	return l.iterateAll(readTs, afterUid, func(p *pb.Posting) error {
+		if isExpired(p, now) {
+			return nil
+		}
+		return f(p)
+	})
+}
+
+func (l *List) iterateAll(readTs uint64, afterUid uint64, f func(obj *pb.Posting) error) error {
 	l.AssertRLock()
 
 	// mposts is the list of mutable postings
@@ -1302,13 +1333,17 @@ func (l *List) immutableLayerLen() (int, error) {
 	return codec.ExactLen(l.plist.Pack), nil
 }
 
+// getPostingAndLength counts the expired postings too. The count index is kept in step with
+// the stored postings, so that the deletion of an expired posting by the expiry sweeper moves
+// its list to the right count. The queries on the count index recount the lists with expired
+// postings instead, see evaluate in the worker.
 func (l *List) getPostingAndLength(readTs, afterUid, uid uint64) (int, bool, *pb.Posting) {
 	l.AssertRLock()
 	var count int
 	var found bool
 	var post *pb.Posting
 
-	err := l.iterate(readTs, afterUid, func(p *pb.Posting) error {
+	err := l.iterateAll(readTs, afterUid, func(p *pb.Posting) error {
 		if p.Uid == uid {
 			post = p
 			found = true
@@ -1617,7 +1652,19 @@ func (l *List) encode(out *rollupOutput, readTs uint64, split bool) error {
 		initializeSplit()
 	}
 
-	err := l.iterate(readTs, 0, func(p *pb.Posting) error {
+	// The expired postings of the data lists are kept until the expiry sweeper deletes them, so
+	// that their index entries are deleted too. The entries of the other lists can be dropped.
+	pk, err := x.Parse(l.key)
+	if err != nil {
+		return errors.Wrapf(err, "cannot parse key when encoding list with key %s",
+			hex.EncodeToString(l.key))
+	}
+	now := uint64(time.Now().Unix())
+
+	err = l.iterateAll(readTs, 0, func(p *pb.Posting) error {
+		if !pk.IsData() && isExpired(p, now) {
+			return nil
+		}
 		if p.Uid > endUid && split {
 			plist.Pack = enc.Done()
 			out.parts[startUid] = plist
@@ -1627,7 +1674,7 @@ func (l *List) encode(out *rollupOutput, readTs uint64, split bool) error {
 		}
 
 		enc.Add(p.Uid)
-		if p.Facets != nil || p.PostingType != pb.Posting_REF {
+		if p.Facets != nil || p.PostingType != pb.Posting_REF || p.ExpiresAt != 0 {
 			plist.Postings = append(plist.Postings, p)
 		}
 		return nil
@@ -1723,7 +1770,14 @@ func (l *List) calculateUids() error {
 	}
 	res := make([]uint64, 0, l.ApproxLen())
 
-	err := l.iterate(l.mutationMap.committedUidsTime, 0, func(p *pb.Posting) error {
+	// The uids of lists with expiring postings aren't cached, as they change when the postings
+	// expire.
+	var expiring bool
+	err := l.iterateAll(l.mutationMap.committedUidsTime, 0, func(p *pb.Posting) error {
+		if p.ExpiresAt != 0 {
+			expiring = true
+			return ErrStopIteration
+		}
 		if p.PostingType == pb.Posting_REF {
 			res = append(res, p.Uid)
 		}
@@ -1732,7 +1786,7 @@ func (l *List) calculateUids() error {
 
 	l.RUnlock()
 
-	if err != nil {
+	if err != nil || expiring {
 		return err
 	}
 
@@ -1792,7 +1846,8 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 		res := make([]uint64, 0, l.ApproxLen())
 		out := &pb.List{}
 
-		if l.mutationMap.len() == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 {
+		if l.mutationMap.len() == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 &&
+			!hasExpiringPostings(l.plist) {
 			if opt.ReadTs < l.minTs {
 				return out, errors.Wrapf(ErrTsTooOld, "While reading UIDs"), false
 			}
@@ -1805,12 +1860,13 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 		if opt.Intersect != nil && len(opt.Intersect.Uids) < l.ApproxLen() {
 			// Cache the iterator as it makes the search space smaller each time.
 			var pitr pIterator
+			now := uint64(time.Now().Unix())
 			for _, uid := range opt.Intersect.Uids {
-				ok, _, err := l.findPostingWithItr(opt.ReadTs, uid, pitr)
+				ok, p, err := l.findPostingWithItr(opt.ReadTs, uid, pitr)
 				if err != nil {
 					return nil, err, false
 				}
-				if ok {
+				if ok && !isExpired(p, now) {
 					res = append(res, uid)
 				}
 			}
@@ -1889,6 +1945,39 @@ func (l *List) Postings(opt ListOptions, postFn func(*pb.Posting) error) error {
 		hex.EncodeToString(l.key))
 }
 
+// ExpiredPostings returns the postings of the list that have expired by the given unix time.
+func (l *List) ExpiredPostings(readTs, now uint64) ([]*pb.Posting, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	var posts []*pb.Posting
+	err := l.iterateAll(readTs, 0, func(p *pb.Posting) error {
+		if isExpired(p, now) {
+			posts = append(posts, p)
+		}
+		return nil
+	})
+	return posts, errors.Wrapf(err, "cannot retrieve expired postings from list with key %s",
+		hex.EncodeToString(l.key))
+}
+
+// NextExpiry returns the earliest expiry of the postings of the list, or zero if none of them
+// expires. The postings that expired but aren't deleted yet count too.
+func (l *List) NextExpiry(readTs uint64) (uint64, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	var next uint64
+	err := l.iterateAll(readTs, 0, func(p *pb.Posting) error {
+		if p.ExpiresAt != 0 && (next == 0 || p.ExpiresAt < next) {
+			next = p.ExpiresAt
+		}
+		return nil
+	})
+	return next, errors.Wrapf(err, "cannot retrieve the expiry of list with key %s",
+		hex.EncodeToString(l.key))
+}
+
 // AllUntaggedValues returns all the values in the posting list with no language tag.
 func (l *List) AllUntaggedValues(readTs uint64) ([]types.Val, error) {
 	l.RLock()
@@ -2099,7 +2188,7 @@ func (l *List) postingForLangs(readTs uint64, langs []string) (*pb.Posting, erro
 
 	// look for value without language
 	if any || len(langs) == 0 {
-		found, pos, err := l.findPosting(readTs, math.MaxUint64)
+		found, pos, err := l.findLivePosting(readTs, math.MaxUint64)
 		switch {
 		case err != nil:
 			return nil, errors.Wrapf(err,
@@ -2139,7 +2228,7 @@ func (l *List) postingForLangs(readTs uint64, langs []string) (*pb.Posting, erro
 func (l *List) postingForTag(readTs uint64, tag string) (p *pb.Posting, rerr error) {
 	l.AssertRLock()
 	uid := farm.Fingerprint64([]byte(tag))
-	found, p, err := l.findPosting(readTs, uid)
+	found, p, err := l.findLivePosting(readTs, uid)
 	if err != nil {
 		return p, err
 	}
@@ -2152,7 +2241,7 @@ func (l *List) postingForTag(readTs uint64, tag string) (p *pb.Posting, rerr err
 
 func (l *List) findValue(readTs, uid uint64) (rval types.Val, found bool, err error) {
 	l.AssertRLock()
-	found, p, err := l.findPosting(readTs, uid)
+	found, p, err := l.findLivePosting(readTs, uid)
 	if !found {
 		return rval, found, err
 	}
@@ -2161,7 +2250,7 @@ func (l *List) findValue(readTs, uid uint64) (rval types.Val, found bool, err er
 }
 
 func (l *List) FindPosting(readTs uint64, uid uint64) (found bool, pos *pb.Posting, err error) {
-	return l.findPosting(readTs, uid)
+	return l.findLivePosting(readTs, uid)
 }
 
 func (l *List) findPostingWithItr(readTs uint64, uid uint64, pitr pIterator) (found bool, pos *pb.Posting, err error) {
@@ -2203,6 +2292,16 @@ func (l *List) findPosting(readTs uint64, uid uint64) (found bool, pos *pb.Posti
 	return l.findPostingWithItr(readTs, uid, pitr)
 }
 
+// findLivePosting is findPosting for reads, which don't see the postings that have expired.
+// Mutations use findPosting, as they need to update the indexes of the expired values too.
+func (l *List) findLivePosting(readTs uint64, uid uint64) (bool, *pb.Posting, error) {
+	found, p, err := l.findPosting(readTs, uid)
+	if found && isExpired(p, uint64(time.Now().Unix())) {
+		return false, nil, err
+	}
+	return found, p, err
+}
+
 // Facets gives facets for the posting representing value.
 func (l *List) Facets(readTs uint64, param *pb.FacetParams, langs []string,
 	listType bool) ([]*pb.Facets, error) {
@@ -14,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -583,6 +584,73 @@ func TestRollupMaxTsIsSet(t *testing.T) {
 	}
 }
 
+func TestExpiredPostings(t *testing.T) {
+	now := uint64(time.Now().Unix())
+	expiredUids := func(l *List, readTs uint64) []uint64 {
+		posts, err := l.ExpiredPostings(readTs, now)
+		require.NoError(t, err)
+		var uids []uint64
+		for _, p := range posts {
+			uids = append(uids, p.Uid)
+		}
+		return uids
+	}
+
+	attr := x.AttrInRootNamespace("session")
+	for _, key := range [][]byte{x.DataKey(attr, 1), x.ReverseKey(attr, 1)} {
+		pk, err := x.Parse(key)
+		require.NoError(t, err)
+		ol, err := readPostingListFromDisk(key, ps, math.MaxUint64)
+		require.NoError(t, err)
+		txn := &Txn{StartTs: 1}
+		addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 5, ExpiresAt: now - 10}, Set, txn)
+		addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 7}, Set, txn)
+		addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 9, ExpiresAt: now + 3600}, Set, txn)
+		require.NoError(t, ol.commitMutation(1, 2))
+		expiring := make(map[string]uint64)
+		txn.IterateExpiringKeys(func(key []byte, expiresAt uint64) {
+			expiring[string(key)] = expiresAt
+		})
+		require.Equal(t, map[string]uint64{string(key): now - 10}, expiring)
+
+		// The expired postings can't be read, but they are found by the expiry sweeper.
+		require.Equal(t, []uint64{7, 9}, listToArray(t, 0, ol, 3))
+		require.Equal(t, []uint64{7, 9}, uids(ol, 3))
+		require.Equal(t, []uint64{5}, expiredUids(ol, 3))
+		next, err := ol.NextExpiry(3)
+		require.NoError(t, err)
+		require.Equal(t, now-10, next)
+
+		// Rollups keep the expired postings of data lists, for their indexes to be updated
+		// when the sweeper deletes them, and drop the ones of the other lists.
+		kvs, err := ol.Rollup(nil, 3)
+		require.NoError(t, err)
+		require.NoError(t, writePostingListToDisk(kvs))
+		ol, err = readPostingListFromDisk(key, ps, math.MaxUint64)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{7, 9}, uids(ol, 3))
+		require.Equal(t, []uint64{7, 9}, listToArray(t, 0, ol, 3))
+		next, err = ol.NextExpiry(3)
+		require.NoError(t, err)
+		if pk.IsData() {
+			require.Equal(t, []uint64{5}, expiredUids(ol, 3))
+			require.Equal(t, now-10, next)
+		} else {
+			require.Empty(t, expiredUids(ol, 3))
+			require.Equal(t, now+3600, next)
+		}
+	}
+
+	key := x.DataKey(x.AttrInRootNamespace("token"), 1)
+	ol, err := readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, &pb.DirectedEdge{Value: []byte("abc"), ExpiresAt: now - 10}, Set, txn)
+	require.NoError(t, ol.commitMutation(1, 2))
+	_, err = ol.Value(3)
+	require.ErrorIs(t, err, ErrNoValue)
+}
+
 func TestMillion(t *testing.T) {
 	// Ensure list is stored in a single part.
 	defer setMaxListSize(maxListSize)
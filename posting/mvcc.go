@@ -238,6 +238,58 @@ func (txn *Txn) addConflictKey(conflictKey uint64) {
 	}
 }
 
+func (txn *Txn) addExpiringKey(key []byte, expiresAt uint64) {
+	txn.Lock()
+	defer txn.Unlock()
+	if txn.expiring == nil {
+		txn.expiring = make(map[string]uint64)
+	}
+	if cur, ok := txn.expiring[string(key)]; !ok || expiresAt < cur {
+		txn.expiring[string(key)] = expiresAt
+	}
+}
+
+// IterateExpiringKeys calls fn with every data or reverse key that got postings with an expiry
+// from the txn, along with the earliest of them.
+func (txn *Txn) IterateExpiringKeys(fn func(key []byte, expiresAt uint64)) {
+	if txn == nil {
+		return
+	}
+	txn.Lock()
+	defer txn.Unlock()
+	for key, expiresAt := range txn.expiring {
+		fn([]byte(key), expiresAt)
+	}
+}
+
+// AddStrictNodes adds nodes of the namespace touched by the txn in strict mode.
+func (txn *Txn) AddStrictNodes(ns uint64, uids []uint64) {
+	txn.Lock()
+	defer txn.Unlock()
+	if txn.strict == nil {
+		txn.strict = make(map[uint64]map[uint64]struct{})
+	}
+	if txn.strict[ns] == nil {
+		txn.strict[ns] = make(map[uint64]struct{})
+	}
+	for _, uid := range uids {
+		txn.strict[ns][uid] = struct{}{}
+	}
+}
+
+// IterateStrictNodes calls fn with every namespace in which the txn touched nodes in strict
+// mode, along with the nodes.
+func (txn *Txn) IterateStrictNodes(fn func(ns uint64, uids map[uint64]struct{})) {
+	if txn == nil {
+		return
+	}
+	txn.Lock()
+	defer txn.Unlock()
+	for ns, uids := range txn.strict {
+		fn(ns, uids)
+	}
+}
+
 // FillContext updates the given transaction context with data from this transaction.
 func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32, isErrored bool) {
 	txn.Lock()
@@ -53,6 +53,14 @@ type Txn struct {
 	// determine unhealthy, stale txns.
 	lastUpdate time.Time
 
+	// Keeps track of the data and reverse keys that got postings with an expiry, by the
+	// earliest of them.
+	expiring map[string]uint64
+
+	// Keeps track of the nodes touched in strict namespaces, by namespace. Their required
+	// fields are verified when the txn is committed.
+	strict map[uint64]map[uint64]struct{}
+
 	cache *LocalCache // This pointer does not get modified.
 }
 
@@ -379,6 +387,23 @@ func (o *oracle) GetTxn(startTs uint64) *Txn {
 	return o.pendingTxns[startTs]
 }
 
+// IterateDataKeys calls fn with the parsed key of every data posting list
+// modified by the txn.
+func (txn *Txn) IterateDataKeys(fn func(pk x.ParsedKey)) {
+	if txn == nil || txn.cache == nil {
+		return
+	}
+	txn.cache.RLock()
+	defer txn.cache.RUnlock()
+	for key := range txn.cache.deltas {
+		pk, err := x.Parse([]byte(key))
+		if err != nil || !pk.IsData() {
+			continue
+		}
+		fn(pk)
+	}
+}
+
 func (txn *Txn) matchesDelta(ok func(key []byte) bool) bool {
 	txn.Lock()
 	defer txn.Unlock()
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/dgryski/go-farm"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// A list of vectors predicate can hold many vectors for the same node, e.g. the
+// embeddings of the chunks of a document. The HNSW index works with one vector
+// per uid, so each vector of the list is indexed as a chunk with a uid of its
+// own. The vector of a chunk is stored in <attr>__vector_chunk_ and the uid of
+// the node owning it in <attr>__vector_owner_. The HNSW index itself is built
+// over <attr>__vector_chunk_.
+
+// VectorChunkUid returns the uid of the chunk holding vec, one of the vectors
+// of owner.
+func VectorChunkUid(owner uint64, vec []byte) uint64 {
+	buf := make([]byte, 8, 8+len(vec))
+	binary.BigEndian.PutUint64(buf, owner)
+	id := farm.Fingerprint64(append(buf, vec...))
+	if id == 0 {
+		// 0 is not a valid uid in the HNSW index.
+		id = 1
+	}
+	return id
+}
+
+// VectorChunkOwner returns the uid of the node owning the chunk, or 0 if the
+// chunk doesn't exist (anymore) at readTs.
+func VectorChunkOwner(c *LocalCache, attr string, chunk uint64) (uint64, error) {
+	pl, err := c.Get(x.DataKey(hnsw.ConcatStrings(attr, hnsw.VecChunkOwner), chunk))
+	if err != nil {
+		return 0, err
+	}
+	val, err := pl.Value(c.startTs)
+	switch {
+	case err == ErrNoValue:
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+	data, ok := val.Value.([]byte)
+	if !ok || len(data) != 8 {
+		return 0, nil
+	}
+	return hnsw.BytesToUint64(data), nil
+}
+
+// vectorBytes returns the binary encoding of the vector in val.
+func vectorBytes(val types.Val) ([]byte, error) {
+	if data, ok := val.Value.([]byte); ok && val.Tid == types.VFloatID {
+		return data, nil
+	}
+	if vec, ok := val.Value.([]float32); ok {
+		return types.FloatArrayAsBytes(vec), nil
+	}
+	// Vectors added before the predicate was given its vector type.
+	sv, err := types.Convert(val, types.VFloatID)
+	if err != nil {
+		return nil, err
+	}
+	return types.FloatArrayAsBytes(sv.Value.([]float32)), nil
+}
+
+// addVectorChunkIndexMutations maintains the vector index of a list of vectors
+// predicate for the value in info.
+func (txn *Txn) addVectorChunkIndexMutations(ctx context.Context,
+	info *indexMutationInfo) ([]*pb.DirectedEdge, error) {
+	attr := info.edge.Attr
+	owner := info.edge.Entity
+	vec, err := vectorBytes(info.val)
+	if err != nil {
+		return []*pb.DirectedEdge{}, err
+	}
+	if len(vec) == 0 {
+		return []*pb.DirectedEdge{}, nil
+	}
+
+	chunk := VectorChunkUid(owner, vec)
+	chunkAttr := hnsw.ConcatStrings(attr, hnsw.VecChunk)
+	ownerAttr := hnsw.ConcatStrings(attr, hnsw.VecChunkOwner)
+	if info.op == pb.DirectedEdge_DEL {
+		// The chunk stays in the HNSW graph, but searches skip it as it no
+		// longer has a vector.
+		for _, a := range []string{chunkAttr, ownerAttr} {
+			if err := txn.addChunkMutation(ctx, &pb.DirectedEdge{
+				Entity: chunk,
+				Attr:   a,
+				Value:  []byte(x.Star),
+				Op:     pb.DirectedEdge_DEL,
+			}); err != nil {
+				return []*pb.DirectedEdge{}, err
+			}
+		}
+		return []*pb.DirectedEdge{}, nil
+	}
+
+	if err := txn.addChunkMutation(ctx, &pb.DirectedEdge{
+		Entity:    chunk,
+		Attr:      ownerAttr,
+		Value:     hnsw.Uint64ToBytes(owner),
+		ValueType: pb.Posting_ValType(0),
+		Op:        pb.DirectedEdge_SET,
+	}); err != nil {
+		return []*pb.DirectedEdge{}, err
+	}
+	if err := txn.addChunkMutation(ctx, &pb.DirectedEdge{
+		Entity:    chunk,
+		Attr:      chunkAttr,
+		Value:     vec,
+		ValueType: pb.Posting_VFLOAT,
+		Op:        pb.DirectedEdge_SET,
+	}); err != nil {
+		return []*pb.DirectedEdge{}, err
+	}
+
+	tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
+	indexer, err := info.factorySpecs[0].CreateIndex(chunkAttr)
+	if err != nil {
+		return []*pb.DirectedEdge{}, err
+	}
+	edges, err := indexer.Insert(ctx, tc, chunk, types.BytesAsFloatArray(vec))
+	if err != nil {
+		return []*pb.DirectedEdge{}, err
+	}
+	pbEdges := make([]*pb.DirectedEdge, 0, len(edges))
+	for _, e := range edges {
+		pbEdges = append(pbEdges, indexEdgeToPbEdge(e))
+	}
+	return pbEdges, nil
+}
+
+func (txn *Txn) addChunkMutation(ctx context.Context, edge *pb.DirectedEdge) error {
+	pl, err := txn.Get(x.DataKey(edge.Attr, edge.Entity))
+	if err != nil {
+		return err
+	}
+	return pl.addMutation(ctx, txn, edge)
+}
@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package posting
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// VectorIndexBuildProgress tracks a vector index rebuild running on this alpha.
+type VectorIndexBuildProgress struct {
+	StartedAt time.Time
+	// Total is the number of vectors found for the predicate when the
+	// rebuild started.
+	Total     uint64
+	processed atomic.Uint64
+}
+
+// Processed returns the number of vectors added to the index so far.
+func (p *VectorIndexBuildProgress) Processed() uint64 {
+	return p.processed.Load()
+}
+
+// vectorIndexBuilds maps a predicate to its *VectorIndexBuildProgress while
+// its vector index is being rebuilt.
+var vectorIndexBuilds sync.Map
+
+// VectorIndexBuildStatus returns the progress of the vector index rebuild of attr.
+// The second return value is false if no rebuild is running for attr.
+func VectorIndexBuildStatus(attr string) (*VectorIndexBuildProgress, bool) {
+	p, ok := vectorIndexBuilds.Load(attr)
+	if !ok {
+		return nil, false
+	}
+	return p.(*VectorIndexBuildProgress), true
+}
+
+func startVectorIndexBuild(ctx context.Context, attr string, prefix []byte,
+	readTs uint64) *VectorIndexBuildProgress {
+	p := &VectorIndexBuildProgress{StartedAt: time.Now()}
+	err := MemLayerInstance.IterateDisk(ctx, IterateDiskArgs{
+		Prefix:         prefix,
+		ReadTs:         readTs,
+		AllVersions:    true,
+		CheckInclusion: func(uint64) error { return nil },
+		Function: func(_ *List, _ x.ParsedKey) error {
+			p.Total++
+			return nil
+		},
+	})
+	if err != nil {
+		// The total is only used to report progress, so we carry on without it.
+		p.Total = 0
+	}
+	vectorIndexBuilds.Store(attr, p)
+	return p
+}
+
+func finishVectorIndexBuild(attr string) {
+	vectorIndexBuilds.Delete(attr)
+}
+
+// VectorIndexGraphStats describes the shape of the HNSW graph of a predicate.
+type VectorIndexGraphStats struct {
+	// NumVectors is the number of nodes that hold a vector.
+	NumVectors uint64
+	// Orphaned is the number of vectors that have no neighbors on any
+	// level of the graph, either because they were never linked or because
+	// all their edges were removed.
+	Orphaned uint64
+	// Nodes[i] and Edges[i] hold the number of nodes with at least one edge
+	// and the total number of edges on level i respectively.
+	Nodes []uint64
+	Edges []uint64
+}
+
+// GetVectorIndexGraphStats walks the HNSW edges stored for attr at readTs and
+// returns statistics about the graph.
+func GetVectorIndexGraphStats(ctx context.Context, attr string,
+	readTs uint64) (*VectorIndexGraphStats, error) {
+	stats := &VectorIndexGraphStats{
+		Nodes: make([]uint64, hnsw.VectorIndexMaxLevels),
+		Edges: make([]uint64, hnsw.VectorIndexMaxLevels),
+	}
+
+	var linked uint64
+	var matrix [][]uint64
+	edgesPk := x.ParsedKey{Attr: hnsw.ConcatStrings(attr, hnsw.VecKeyword)}
+	err := MemLayerInstance.IterateDisk(ctx, IterateDiskArgs{
+		Prefix:         edgesPk.DataPrefix(),
+		ReadTs:         readTs,
+		AllVersions:    true,
+		CheckInclusion: func(uint64) error { return nil },
+		Function: func(l *List, _ x.ParsedKey) error {
+			val, err := l.Value(readTs)
+			switch {
+			case err == ErrNoValue:
+				return nil
+			case err != nil:
+				return err
+			}
+			data, ok := val.Value.([]byte)
+			if !ok {
+				return nil
+			}
+			if err := decodeUint64MatrixUnsafe(data, &matrix); err != nil {
+				return err
+			}
+			hasEdges := false
+			for level, neighbors := range matrix {
+				if len(neighbors) == 0 {
+					continue
+				}
+				hasEdges = true
+				for len(stats.Nodes) <= level {
+					stats.Nodes = append(stats.Nodes, 0)
+					stats.Edges = append(stats.Edges, 0)
+				}
+				stats.Nodes[level]++
+				stats.Edges[level] += uint64(len(neighbors))
+			}
+			if hasEdges {
+				linked++
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading vector index edges of %s",
+			x.ParseAttr(attr))
+	}
+
+	dataPk := x.ParsedKey{Attr: attr}
+	err = MemLayerInstance.IterateDisk(ctx, IterateDiskArgs{
+		Prefix:         dataPk.DataPrefix(),
+		ReadTs:         readTs,
+		AllVersions:    true,
+		CheckInclusion: func(uint64) error { return nil },
+		Function: func(_ *List, _ x.ParsedKey) error {
+			stats.NumVectors++
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while counting vectors of %s", x.ParseAttr(attr))
+	}
+
+	// A lone vector is the entry point of the graph and has nobody to link to.
+	if stats.NumVectors > 1 && stats.NumVectors > linked {
+		stats.Orphaned = stats.NumVectors - linked
+	}
+	return stats, nil
+}
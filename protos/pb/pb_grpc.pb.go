@@ -900,6 +900,8 @@ const (
 	Worker_TaskStatus_FullMethodName                      = "/pb.Worker/TaskStatus"
 	Worker_UpdateExtSnapshotStreamingState_FullMethodName = "/pb.Worker/UpdateExtSnapshotStreamingState"
 	Worker_StreamExtSnapshot_FullMethodName               = "/pb.Worker/StreamExtSnapshot"
+	Worker_VectorIndexStats_FullMethodName                = "/pb.Worker/VectorIndexStats"
+	Worker_StrictNodes_FullMethodName                     = "/pb.Worker/StrictNodes"
 )
 
 // WorkerClient is the client API for Worker service.
@@ -923,6 +925,8 @@ type WorkerClient interface {
 	TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error)
 	UpdateExtSnapshotStreamingState(ctx context.Context, in *api.UpdateExtSnapshotStreamingStateRequest, opts ...grpc.CallOption) (*Status, error)
 	StreamExtSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamExtSnapshotClient, error)
+	VectorIndexStats(ctx context.Context, in *VectorIndexStatsRequest, opts ...grpc.CallOption) (*VectorIndexStatsResponse, error)
+	StrictNodes(ctx context.Context, in *StrictNodesRequest, opts ...grpc.CallOption) (*StrictNodesResponse, error)
 }
 
 type workerClient struct {
@@ -1169,6 +1173,24 @@ func (x *workerStreamExtSnapshotClient) Recv() (*api.StreamExtSnapshotResponse,
 	return m, nil
 }
 
+func (c *workerClient) VectorIndexStats(ctx context.Context, in *VectorIndexStatsRequest, opts ...grpc.CallOption) (*VectorIndexStatsResponse, error) {
+	out := new(VectorIndexStatsResponse)
+	err := c.cc.Invoke(ctx, Worker_VectorIndexStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) StrictNodes(ctx context.Context, in *StrictNodesRequest, opts ...grpc.CallOption) (*StrictNodesResponse, error) {
+	out := new(StrictNodesResponse)
+	err := c.cc.Invoke(ctx, Worker_StrictNodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServer is the server API for Worker service.
 // All implementations must embed UnimplementedWorkerServer
 // for forward compatibility
@@ -1190,6 +1212,8 @@ type WorkerServer interface {
 	TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error)
 	UpdateExtSnapshotStreamingState(context.Context, *api.UpdateExtSnapshotStreamingStateRequest) (*Status, error)
 	StreamExtSnapshot(Worker_StreamExtSnapshotServer) error
+	VectorIndexStats(context.Context, *VectorIndexStatsRequest) (*VectorIndexStatsResponse, error)
+	StrictNodes(context.Context, *StrictNodesRequest) (*StrictNodesResponse, error)
 	mustEmbedUnimplementedWorkerServer()
 }
 
@@ -1245,6 +1269,12 @@ func (UnimplementedWorkerServer) UpdateExtSnapshotStreamingState(context.Context
 func (UnimplementedWorkerServer) StreamExtSnapshot(Worker_StreamExtSnapshotServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamExtSnapshot not implemented")
 }
+func (UnimplementedWorkerServer) VectorIndexStats(context.Context, *VectorIndexStatsRequest) (*VectorIndexStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VectorIndexStats not implemented")
+}
+func (UnimplementedWorkerServer) StrictNodes(context.Context, *StrictNodesRequest) (*StrictNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StrictNodes not implemented")
+}
 func (UnimplementedWorkerServer) mustEmbedUnimplementedWorkerServer() {}
 
 // UnsafeWorkerServer may be embedded to opt out of forward compatibility for this service.
@@ -1573,6 +1603,42 @@ func (x *workerStreamExtSnapshotServer) Recv() (*api.StreamExtSnapshotRequest, e
 	return m, nil
 }
 
+func _Worker_VectorIndexStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorIndexStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).VectorIndexStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_VectorIndexStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).VectorIndexStats(ctx, req.(*VectorIndexStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_StrictNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrictNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StrictNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_StrictNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StrictNodes(ctx, req.(*StrictNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Worker_ServiceDesc is the grpc.ServiceDesc for Worker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1628,6 +1694,14 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateExtSnapshotStreamingState",
 			Handler:    _Worker_UpdateExtSnapshotStreamingState_Handler,
 		},
+		{
+			MethodName: "VectorIndexStats",
+			Handler:    _Worker_VectorIndexStats_Handler,
+		},
+		{
+			MethodName: "StrictNodes",
+			Handler:    _Worker_StrictNodes_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
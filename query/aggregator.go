@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/types"
@@ -26,7 +27,7 @@ type aggregator struct {
 
 func isUnary(f string) bool {
 	return f == "ln" || f == "exp" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" || f == "length"
 }
 
 func isBinaryBoolean(f string) bool {
@@ -41,7 +42,7 @@ func isTernary(f string) bool {
 func isBinary(f string) bool {
 	return f == "+" || f == "*" || f == "-" || f == "/" || f == "%" ||
 		f == "max" || f == "min" || f == "logbase" || f == "pow" ||
-		f == "dot"
+		f == "dot" || f == "geodistance"
 }
 
 func convertTo(from *pb.TaskValue) (types.Val, error) {
@@ -573,17 +574,54 @@ func applySince(a, res *types.Val) error {
 	return errors.Errorf("Wrong type %v encountered for func since", a.Tid)
 }
 
+func applyLength(a, res *types.Val) error {
+	g, ok := a.Value.(geom.T)
+	if a.Tid != types.GeoID || !ok {
+		return errors.Errorf("Wrong type %v encountered for func length", a.Tid)
+	}
+	l, err := types.GeoLength(g)
+	if err != nil {
+		return err
+	}
+	res.Tid = types.FloatID
+	res.Value = float64(l)
+	return nil
+}
+
+func applyGeoDistance(a, b, c *types.Val) error {
+	ga, aok := a.Value.(geom.T)
+	gb, bok := b.Value.(geom.T)
+	if a.Tid != types.GeoID || b.Tid != types.GeoID || !aok || !bok {
+		return errors.Errorf("Wrong types %v and %v encountered for func geodistance", a.Tid, b.Tid)
+	}
+	p, ok := gb.(*geom.Point)
+	if !ok {
+		if p, ok = ga.(*geom.Point); !ok {
+			return errors.Errorf("geodistance requires one of its arguments to be a point")
+		}
+		ga = gb
+	}
+	d, err := types.GeoDistance(ga, p)
+	if err != nil {
+		return err
+	}
+	c.Tid = types.FloatID
+	c.Value = float64(d)
+	return nil
+}
+
 type unaryFunc func(a, res *types.Val) error
 type binaryFunc func(a, b, res *types.Val) error
 
 var unaryFunctions = map[string]unaryFunc{
-	"ln":    applyLn,
-	"exp":   applyExp,
-	"u-":    applyNeg,
-	"sqrt":  applySqrt,
-	"floor": applyFloor,
-	"ceil":  applyCeil,
-	"since": applySince,
+	"ln":     applyLn,
+	"exp":    applyExp,
+	"u-":     applyNeg,
+	"sqrt":   applySqrt,
+	"floor":  applyFloor,
+	"ceil":   applyCeil,
+	"since":  applySince,
+	"length": applyLength,
 }
 
 var binaryFunctions = map[string]binaryFunc{
@@ -597,6 +635,8 @@ var binaryFunctions = map[string]binaryFunc{
 	"min":     applyMin,
 	"max":     applyMax,
 	"dot":     applyDot,
+
+	"geodistance": applyGeoDistance,
 }
 
 // mixedScalarVectOps enumerates the binary functions that allow for
@@ -0,0 +1,207 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// indexCond is a filter on a single predicate that may be answered by a composite index.
+type indexCond struct {
+	fn   string
+	args []string
+}
+
+// useCompositeIndex rewrites the task query of a root of the form type(T) whose filters
+// constrain a prefix of the fields of a composite index of T, so that it reads the range of the
+// internal predicate of the index instead of all the nodes of the type. The filters are still
+// applied to the uids found, so the rewrite doesn't change the result.
+func useCompositeIndex(ctx context.Context, sg *SubGraph, q *pb.Query) error {
+	fn := sg.SrcFunc
+	if sg.Attr != "dgraph.type" || fn == nil || fn.Name != "eq" || len(fn.Args) != 1 ||
+		fn.IsCount || fn.IsValueVar || fn.Args[0].IsValueVar || len(sg.Filters) == 0 {
+		return nil
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return err
+	}
+	typ, ok := schema.State().GetType(x.NamespaceAttr(ns, fn.Args[0].Value))
+	if !ok || len(typ.CompositeIndexes) == 0 {
+		return nil
+	}
+
+	conds := make(map[string][]indexCond)
+	collectIndexConds(ns, sg, conds)
+	if len(conds) == 0 {
+		return nil
+	}
+	preds := make(map[string]struct{}, len(conds))
+	for pred := range conds {
+		preds[pred] = struct{}{}
+	}
+	predTypes, err := fetchPredTypes(ctx, preds)
+	if err != nil {
+		return err
+	}
+
+	var best *pb.CompositeIndex
+	var bestLo, bestHi string
+	bestLen := 0
+	for _, index := range typ.CompositeIndexes {
+		if index.Building {
+			// The index doesn't cover the nodes written before it was declared yet.
+			continue
+		}
+		n, lo, hi, ok := compositeIndexRange(index, conds, predTypes)
+		if ok && n > bestLen {
+			best, bestLo, bestHi, bestLen = index, lo, hi, n
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	q.Attr = schema.CompositeIndexPredicate(typ.TypeName, best)
+	q.SrcFunc = &pb.SrcFunction{Name: "between", Args: []string{bestLo, bestHi}}
+	return nil
+}
+
+// collectIndexConds collects the conditions of the filters of the subgraph that all the
+// results must satisfy, keyed by the namespaced predicate.
+func collectIndexConds(ns uint64, sg *SubGraph, conds map[string][]indexCond) {
+	for _, filter := range sg.Filters {
+		if filter.FilterOp == "and" {
+			collectIndexConds(ns, filter, conds)
+			continue
+		}
+		if filter.FilterOp != "" {
+			continue
+		}
+		fn := filter.SrcFunc
+		if fn == nil || fn.IsCount || fn.IsValueVar || fn.IsLenVar ||
+			len(filter.Params.Langs) > 0 || strings.HasPrefix(filter.Attr, "~") {
+			continue
+		}
+		want := 1
+		if fn.Name == "between" {
+			want = 2
+		}
+		if !isInequalityFn(fn.Name) || len(fn.Args) != want {
+			continue
+		}
+		cond := indexCond{fn: fn.Name}
+		for _, arg := range fn.Args {
+			if arg.IsValueVar {
+				cond.args = nil
+				break
+			}
+			cond.args = append(cond.args, arg.Value)
+		}
+		if len(cond.args) != want {
+			continue
+		}
+		attr := x.NamespaceAttr(ns, filter.Attr)
+		conds[attr] = append(conds[attr], cond)
+	}
+}
+
+// compositeIndexRange returns the number of leading fields of the index constrained by the
+// conditions, and the bounds of the values of the index satisfying them. The bounds are
+// inclusive even for strict inequalities, as the tokens of some types are lossy.
+func compositeIndexRange(index *pb.CompositeIndex, conds map[string][]indexCond,
+	predTypes map[string]types.TypeID) (int, string, string, bool) {
+
+	var eq [][]byte
+	for _, pred := range index.Predicates {
+		tid, ok := predTypes[pred]
+		if !ok {
+			break
+		}
+		var lo, hi []byte
+		var isEq, failed bool
+		for _, cond := range conds[pred] {
+			tokens := make([][]byte, len(cond.args))
+			for i, arg := range cond.args {
+				token, err := condToken(arg, tid)
+				if err != nil {
+					failed = true
+					break
+				}
+				tokens[i] = token
+			}
+			if failed {
+				break
+			}
+			switch cond.fn {
+			case "eq":
+				isEq = true
+				lo, hi = tokens[0], tokens[0]
+			case "ge", "gt":
+				lo = tokens[0]
+			case "le", "lt":
+				hi = tokens[0]
+			case "between":
+				lo, hi = tokens[0], tokens[1]
+			}
+			if isEq {
+				break
+			}
+		}
+		switch {
+		case failed:
+			return 0, "", "", false
+		case isEq:
+			eq = append(eq, lo)
+			continue
+		case lo != nil || hi != nil:
+			lower, upper := posting.CompositeIndexRange(eq, lo, hi)
+			return len(eq) + 1, lower, upper, true
+		}
+		break
+	}
+	if len(eq) == 0 {
+		return 0, "", "", false
+	}
+	lower, upper := posting.CompositeIndexRange(eq, nil, nil)
+	return len(eq), lower, upper, true
+}
+
+func condToken(arg string, tid types.TypeID) ([]byte, error) {
+	val, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(arg)}, tid)
+	if err != nil {
+		return nil, err
+	}
+	return posting.CompositeIndexToken(val)
+}
+
+// fetchPredTypes returns the schema types of the given predicates.
+func fetchPredTypes(ctx context.Context, preds map[string]struct{}) (map[string]types.TypeID,
+	error) {
+
+	req := &pb.SchemaRequest{Fields: []string{"type"}}
+	for pred := range preds {
+		req.Predicates = append(req.Predicates, pred)
+	}
+	nodes, err := worker.GetSchemaOverNetwork(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]types.TypeID, len(nodes))
+	for _, node := range nodes {
+		if tid, ok := types.TypeForName(node.Type); ok {
+			out[node.Predicate] = tid
+		}
+	}
+	return out, nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	geom "github.com/twpayne/go-geom"
 
 	"github.com/hypermodeinc/dgraph/v25/types"
 )
@@ -842,6 +843,53 @@ func TestProcessUnary(t *testing.T) {
 	}
 }
 
+func TestProcessLength(t *testing.T) {
+	route := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0, 0}, {0, 0.5}, {0, 1}})
+	vals := types.NewShardedMap()
+	vals.Set(1, types.Val{Tid: types.GeoID, Value: route})
+	vals.Set(2, types.Val{Tid: types.GeoID,
+		Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 2})})
+
+	tree := &mathTree{Fn: "length", Child: []*mathTree{{Val: vals}}}
+	require.NoError(t, processUnary(tree))
+	l, ok := tree.Val.Get(1)
+	require.True(t, ok)
+	require.Equal(t, types.FloatID, l.Tid)
+	require.InDelta(t, 111195, l.Value.(float64), 1)
+	l, ok = tree.Val.Get(2)
+	require.True(t, ok)
+	require.Zero(t, l.Value.(float64))
+
+	tree = &mathTree{Fn: "length", Child: []*mathTree{
+		{Const: types.Val{Tid: types.IntID, Value: int64(2)}}}}
+	require.ErrorContains(t, processUnary(tree), "Wrong type")
+}
+
+func TestProcessGeoDistance(t *testing.T) {
+	vals := types.NewShardedMap()
+	vals.Set(1, types.Val{Tid: types.GeoID,
+		Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 1})})
+	vals.Set(2, types.Val{Tid: types.GeoID,
+		Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 2})})
+	origin := types.Val{Tid: types.GeoID,
+		Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0})}
+
+	tree := &mathTree{Fn: "geodistance", Child: []*mathTree{{Val: vals}, {Const: origin}}}
+	require.NoError(t, processBinary(tree))
+	d, ok := tree.Val.Get(1)
+	require.True(t, ok)
+	require.Equal(t, types.FloatID, d.Tid)
+	require.InDelta(t, 111195, d.Value.(float64), 1)
+	d, ok = tree.Val.Get(2)
+	require.True(t, ok)
+	require.InDelta(t, 2*111195, d.Value.(float64), 2)
+
+	ag := aggregator{name: "geodistance"}
+	require.NoError(t, ag.ApplyVal(origin))
+	require.ErrorContains(t, ag.ApplyVal(types.Val{Tid: types.IntID, Value: int64(2)}),
+		"geodistance")
+}
+
 func TestProcessBinaryBoolean(t *testing.T) {
 	tests := []struct {
 		in  *mathTree
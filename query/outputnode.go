@@ -690,6 +690,8 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
 	case types.VFloatID:
 		return json.Marshal(v.Value.([]float32))
+	case types.DurationID, types.UUIDID, types.IPID, types.JSONID:
+		return v.MarshalJSON()
 	default:
 		return nil, errors.New("Unsupported types.Val.Tid")
 	}
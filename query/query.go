@@ -17,6 +17,7 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -295,6 +296,12 @@ type SubGraph struct {
 	pathMeta *pathMetadata
 
 	vectorMetrics map[string]uint64
+	// geoDistances stores the distance of every node in DestUIDs from the point of a near or
+	// nearest function at root. They become the values of the variable of the block.
+	geoDistances *types.ShardedMap
+	// byDistance is set once the nodes of a nearest function at root are ordered by their
+	// distance from its point.
+	byDistance bool
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -1421,7 +1428,7 @@ func (sg *SubGraph) updateFacetMatrix() {
 func (sg *SubGraph) updateUidMatrix() {
 	sg.updateFacetMatrix()
 	for _, l := range sg.uidMatrix {
-		if len(sg.Params.Order) > 0 || len(sg.Params.FacetsOrder) > 0 {
+		if len(sg.Params.Order) > 0 || len(sg.Params.FacetsOrder) > 0 || sg.byDistance {
 			// We can't do intersection directly as the list is not sorted by UIDs.
 			// So do filter.
 			algo.ApplyFilter(l, func(uid uint64, idx int) bool {
@@ -1608,10 +1615,15 @@ func (sg *SubGraph) populateUidValVar(doneVars map[string]varValue, sgPath []*Su
 		}
 
 		if v, ok = doneVars[sg.Params.Var]; !ok {
+			vals := types.NewShardedMap()
+			if sg.geoDistances != nil {
+				// The variable of a near or nearest block also holds the distance of each node.
+				vals = sg.geoDistances
+			}
 			doneVars[sg.Params.Var] = varValue{
 				Uids:    uids,
 				path:    sgPath,
-				Vals:    types.NewShardedMap(),
+				Vals:    vals,
 				strList: sg.valueMatrix,
 			}
 			return nil
@@ -2252,6 +2264,12 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
+			if parent == nil {
+				if err := useCompositeIndex(ctx, sg, taskQuery); err != nil {
+					rch <- err
+					return
+				}
+			}
 			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
@@ -2369,9 +2387,16 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	}
 
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetsOrder) == 0 {
+		switch {
+		case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "nearest":
+			// The nodes found by nearest are ordered by their distance from its point.
+			if err = sg.sortAndPaginateByDistance(ctx); err != nil {
+				rch <- err
+				return
+			}
 		// for `has` function when there is no filtering and ordering, we fetch
 		// correct paginated results so no need to apply pagination here.
-		if !(len(sg.Filters) == 0 && sg.SrcFunc != nil && sg.SrcFunc.Name == "has") {
+		case !(len(sg.Filters) == 0 && sg.SrcFunc != nil && sg.SrcFunc.Name == "has"):
 			// There is no ordering. Just apply pagination and return.
 			if err = sg.applyPagination(ctx); err != nil {
 				rch <- err
@@ -2389,6 +2414,14 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
+	if parent == nil && sg.Params.Var != "" && isGeoDistanceFunc(sg.SrcFunc) &&
+		sg.geoDistances == nil {
+		if err = sg.populateGeoDistances(ctx); err != nil {
+			rch <- err
+			return
+		}
+	}
+
 	// Here we consider handling count with filtering. We do this after
 	// pagination because otherwise, we need to do the count with pagination
 	// taken into account. For example, a PL might have only 50 entries but the
@@ -2747,7 +2780,8 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext", "ngram",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "facet",
+		"ip_within", "ip_contains", "json_path":
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2773,6 +2807,101 @@ func isUidFnWithoutVar(f *dql.Function) bool {
 	return f != nil && f.Name == "uid" && len(f.NeedsVar) == 0
 }
 
+func isGeoDistanceFunc(f *Function) bool {
+	return f != nil && (f.Name == "near" || f.Name == "nearest") && len(f.Args) > 0
+}
+
+// populateGeoDistances computes the distance in metres of every node in DestUIDs from the point
+// given to the near or nearest function at root.
+func (sg *SubGraph) populateGeoDistances(ctx context.Context) error {
+	g, err := types.ParseGeoArg(sg.SrcFunc.Args[0].Value)
+	if err != nil {
+		return err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return errors.Errorf("Require a point for %s query", sg.SrcFunc.Name)
+	}
+	sg.geoDistances = types.NewShardedMap()
+	if len(sg.DestUIDs.GetUids()) == 0 {
+		return nil
+	}
+
+	temp := &SubGraph{
+		Attr:    sg.Attr,
+		SrcUIDs: sg.DestUIDs,
+		ReadTs:  sg.ReadTs,
+	}
+	taskQuery, err := createTaskQuery(ctx, temp)
+	if err != nil {
+		return err
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+	if err != nil {
+		return err
+	}
+	for i, vl := range result.ValueMatrix {
+		if i >= len(sg.DestUIDs.Uids) {
+			break
+		}
+		var dist float64
+		var found bool
+		for _, tv := range vl.Values {
+			val, err := convertWithBestEffort(tv, sg.Attr)
+			if err != nil || val.Tid != types.GeoID {
+				continue
+			}
+			d, err := types.GeoDistance(val.Value.(geom.T), p)
+			if err != nil {
+				continue
+			}
+			if !found || float64(d) < dist {
+				dist, found = float64(d), true
+			}
+		}
+		if found {
+			sg.geoDistances.Set(sg.DestUIDs.Uids[i], types.Val{Tid: types.FloatID, Value: dist})
+		}
+	}
+	return nil
+}
+
+// sortAndPaginateByDistance orders the nodes found by a nearest function at root by their
+// distance from its point, closest first, before applying pagination. Nodes at the same distance
+// are kept in the order of their uids.
+func (sg *SubGraph) sortAndPaginateByDistance(ctx context.Context) error {
+	if err := sg.populateGeoDistances(ctx); err != nil {
+		return err
+	}
+	dist := func(uid uint64) float64 {
+		if v, ok := sg.geoDistances.Get(uid); ok {
+			return v.Value.(float64)
+		}
+		return math.Inf(1)
+	}
+
+	sg.updateUidMatrix()
+	for _, ul := range sg.uidMatrix {
+		sort.SliceStable(ul.Uids, func(i, j int) bool {
+			return dist(ul.Uids[i]) < dist(ul.Uids[j])
+		})
+		start, end := x.PageRange(sg.Params.Count, sg.Params.Offset, len(ul.Uids))
+		ul.Uids = ul.Uids[start:end]
+	}
+	sg.byDistance = true
+	sg.updateDestUids()
+
+	// Only the distances of the nodes kept are the values of the variable of the block.
+	kept := types.NewShardedMap()
+	for _, uid := range sg.DestUIDs.GetUids() {
+		if v, ok := sg.geoDistances.Get(uid); ok {
+			kept.Set(uid, v)
+		}
+	}
+	sg.geoDistances = kept
+	return nil
+}
+
 func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 	temp := &SubGraph{
 		Attr:    "dgraph.type",
@@ -3098,6 +3227,16 @@ func filterTypesForNamespace(namespace uint64, types []*pb.TypeUpdate) []*pb.Typ
 			fields = append(fields, field)
 		}
 		update.Fields = fields
+		for _, key := range update.UniqueKeys {
+			for i, pred := range key.Predicates {
+				key.Predicates[i] = x.ParseAttr(pred)
+			}
+		}
+		for _, index := range update.CompositeIndexes {
+			for i, pred := range index.Predicates {
+				index.Predicates[i] = x.ParseAttr(pred)
+			}
+		}
 		out = append(out, update)
 	}
 	return out
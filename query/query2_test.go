@@ -10,6 +10,7 @@ package query
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -2645,10 +2646,106 @@ func TestNearPoint2(t *testing.T) {
 	}`
 
 	js := processQueryNoErr(t, query)
-	expected := `{"data": {"me":[{"name":"Googleplex"},{"name":"Shoreline Amphitheater"}, {"name": "SF Bay area"}, {"name": "Mountain View"}]}}`
+	// The polygons contain the point, so they are as close to it as Googleplex.
+	expected := `{"data": {"me":[{"name":"Googleplex"}, {"name": "SF Bay area"}, {"name": "Mountain View"}, {"name":"Shoreline Amphitheater"}]}}`
 	require.JSONEq(t, expected, js)
 }
 
+func TestNearestPoint(t *testing.T) {
+	query := `{
+		me(func: nearest(geometry, [-122.082506, 37.4249518], 4)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	// The polygons contain the point, so they are as close to it as Googleplex.
+	expected := `{"data": {"me":[{"name":"Googleplex"}, {"name": "SF Bay area"}, {"name": "Mountain View"}, {"name":"Shoreline Amphitheater"}]}}`
+	require.JSONEq(t, expected, js)
+}
+
+func TestNearestPointPagination(t *testing.T) {
+	query := `{
+		me(func: nearest(geometry, [-122.082506, 37.4249518], 4), first: 2, offset: 2) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name": "Mountain View"}, {"name":"Shoreline Amphitheater"}]}}`, js)
+}
+
+func TestNearestPointFilter(t *testing.T) {
+	query := `{
+		me(func: near(geometry, [-122.082506, 37.4249518], 1000)) @filter(nearest(geometry, [-122.080668, 37.426753], 1)) {
+			name
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Shoreline Amphitheater"}]}}`, js)
+}
+
+func TestNearDistanceVar(t *testing.T) {
+	query := `{
+		d as var(func: near(geometry, [-122.080668, 37.426753], 1000))
+
+		me(func: uid(d), orderasc: val(d)) {
+			name
+			dist: val(d)
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	var res struct {
+		Data struct {
+			Me []struct {
+				Name string  `json:"name"`
+				Dist float64 `json:"dist"`
+			} `json:"me"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	require.NotEmpty(t, res.Data.Me)
+	dists := make(map[string]float64)
+	for i, node := range res.Data.Me {
+		if i > 0 {
+			require.LessOrEqual(t, res.Data.Me[i-1].Dist, node.Dist)
+		}
+		dists[node.Name] = node.Dist
+	}
+	require.Zero(t, dists["Shoreline Amphitheater"])
+	require.InDelta(t, 258, dists["Googleplex"], 5)
+}
+
+func TestGeoDistanceMath(t *testing.T) {
+	query := `{
+		var(func: near(geometry, [-122.080668, 37.426753], 1000)) {
+			g as geometry
+			d as math(geodistance(g, [-122.080668, 37.426753]))
+		}
+
+		me(func: uid(d)) @filter(eq(name, "Googleplex")) {
+			name
+			dist: val(d)
+		}
+	}`
+
+	js := processQueryNoErr(t, query)
+	var res struct {
+		Data struct {
+			Me []struct {
+				Name string  `json:"name"`
+				Dist float64 `json:"dist"`
+			} `json:"me"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	require.Len(t, res.Data.Me, 1)
+	require.Equal(t, "Googleplex", res.Data.Me[0].Name)
+	require.InDelta(t, 258, res.Data.Me[0].Dist, 5)
+}
+
 func TestIntersectsPolygon1(t *testing.T) {
 
 	query := `{
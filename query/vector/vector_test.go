@@ -442,6 +442,99 @@ func TestVectorInQueryArgument(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestVectorSearchWithEf(t *testing.T) {
+	dropPredicate("vtest")
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, "vtest", "4", "euclidean"))
+
+	numVectors := 100
+	vectorSize := 4
+
+	randomVectors, allVectors := generateRandomVectors(numVectors, vectorSize, "vtest")
+	require.NoError(t, addTriplesToCluster(randomVectors))
+
+	query := `query demo($v: float32vector) {
+		 vector(func: similar_to(vtest, 5, $v, "ef=64")) {
+				uid
+		 }
+	}`
+
+	vectorString := fmt.Sprintf(`[%s]`, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(allVectors[0])), ", "), "[]"))
+	vars := map[string]string{
+		"$v": vectorString,
+	}
+
+	resp, err := processQueryWithVars(t, query, vars)
+	require.NoError(t, err)
+	var data struct {
+		Data struct {
+			Vector []struct {
+				UID string `json:"uid"`
+			} `json:"vector"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resp), &data))
+	require.Len(t, data.Data.Vector, 5)
+
+	query = `{
+		 vector(func: similar_to(vtest, 5, "[1, 2, 3, 4]", "ef=-1")) {
+				uid
+		 }
+	}`
+	_, err = processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Invalid value")
+}
+
+func TestVectorSearchListOfVectors(t *testing.T) {
+	dropPredicate("vchunks")
+	setSchema(`vchunks: [float32vector] @index(hnsw(exponent: "4", metric: "euclidean")) .`)
+
+	// <0x1> has one vector close to the query and one far away from it, <0x2>
+	// has two vectors at a medium distance and <0x3> only far away vectors.
+	rdf := `<0x1> <vchunks> "[1, 1]" .
+	<0x1> <vchunks> "[100, 100]" .
+	<0x2> <vchunks> "[3, 3]" .
+	<0x2> <vchunks> "[4, 4]" .
+	<0x3> <vchunks> "[40, 40]" .
+	<0x3> <vchunks> "[50, 50]" .`
+	require.NoError(t, addTriplesToCluster(rdf))
+
+	search := func(opts string) []string {
+		query := fmt.Sprintf(`{
+			vector(func: similar_to(vchunks, 2, "[0, 0]", "%s")) {
+				uid
+			}
+		}`, opts)
+		resp, err := processQuery(context.Background(), t, query)
+		require.NoError(t, err)
+		var data struct {
+			Data struct {
+				Vector []struct {
+					UID string `json:"uid"`
+				} `json:"vector"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(resp), &data))
+		var uids []string
+		for _, v := range data.Data.Vector {
+			uids = append(uids, v.UID)
+		}
+		return uids
+	}
+
+	// Each node is returned once, scored by its closest vector.
+	require.Equal(t, []string{"0x1", "0x2"}, search("agg=max"))
+	// The far away vector of <0x1> brings its average below the one of <0x3>.
+	require.Equal(t, []string{"0x2", "0x3"}, search("agg=avg"))
+
+	query := `{
+		vector(func: similar_to(vchunks, 2, "[0, 0]", "agg=sum")) {
+			uid
+		}
+	}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Invalid value")
+}
+
 func TestVectorsMutateFixedLengthWithDiffrentIndexes(t *testing.T) {
 	dropPredicate("vtest")
 
@@ -7,8 +7,10 @@ package schema
 
 import (
 	"math"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -67,6 +69,46 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Unique = true
 	case "noconflict":
 		schema.NoConflict = true
+	case "strict":
+		if x.ParseAttr(schema.Predicate) != "dgraph.type" {
+			return next.Errorf("@strict directive can only be specified for dgraph.type."+
+				" Got: [%v]", x.ParseAttr(schema.Predicate))
+		}
+		schema.Strict = true
+	case "embedding":
+		if t != types.VFloatID || schema.List {
+			return next.Errorf("@embedding directive can only be specified for float32vector type."+
+				" Got: [%v] for attr: [%v]", t.Name(), x.ParseAttr(schema.Predicate))
+		}
+		spec, err := parseEmbeddingDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Embedding = spec
+	case "check":
+		spec, err := parseCheckDirective(it, schema.Predicate, t)
+		if err != nil {
+			return err
+		}
+		schema.Check = spec
+	case "facetindex":
+		names, err := parseFacetIndexDirective(it)
+		if err != nil {
+			return err
+		}
+		schema.FacetIndex = names
+	case "ttl":
+		ttl, err := parseTTLDirective(it)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = int64(ttl / time.Second)
+	case "where":
+		conds, err := parseWhereDirective(it, schema.Predicate, t)
+		if err != nil {
+			return err
+		}
+		schema.Where = conds
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
@@ -150,6 +192,14 @@ func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.Sch
 		next = it.Item()
 	}
 
+	if len(schema.Where) > 0 && len(schema.Tokenizer) == 0 {
+		return nil, next.Errorf("@where directive needs @index for attr: [%v]", predicate)
+	}
+	if len(schema.Where) > 0 && schema.Unique {
+		return nil, next.Errorf("@where directive cannot be used with @unique for attr: [%v]",
+			predicate)
+	}
+
 	if next.Typ != itemDot {
 		return nil, next.Errorf("Invalid ending")
 	}
@@ -403,6 +453,232 @@ func parseTokenOption(it *lex.ItemIterator, factory tok.IndexFactory) (*pb.Optio
 	return &pb.OptionPair{Key: optName, Value: optVal}, nil
 }
 
+// parseEmbeddingDirective parses the options of
+// @embedding(source: "text", url: "http://host/v1/embeddings", model: "name"),
+// assuming that "@embedding" has already been found.
+func parseEmbeddingDirective(it *lex.ItemIterator, predicate string) (*pb.EmbeddingSpec, error) {
+	next := it.Item()
+	opts, err := parseTokenOptions(it, nil)
+	if err != nil {
+		return nil, err
+	}
+	spec := &pb.EmbeddingSpec{}
+	for _, opt := range opts {
+		switch opt.Key {
+		case "source":
+			spec.Source = opt.Value
+		case "url":
+			spec.Url = opt.Value
+		case "model":
+			spec.Model = opt.Value
+		case "api_key_env":
+			spec.ApiKeyEnv = opt.Value
+		default:
+			return nil, next.Errorf("Unknown option %q in @embedding for attr: [%v]",
+				opt.Key, x.ParseAttr(predicate))
+		}
+	}
+	switch {
+	case spec.Source == "" || spec.Url == "":
+		return nil, next.Errorf("@embedding needs both source and url for attr: [%v]",
+			x.ParseAttr(predicate))
+	case spec.Source == x.ParseAttr(predicate):
+		return nil, next.Errorf("@embedding source of attr: [%v] cannot be itself",
+			x.ParseAttr(predicate))
+	}
+	return spec, nil
+}
+
+// parseCheckDirective parses the options of
+// @check(min: "0", max: "150", maxlen: "64", regex: "^[a-z]+$", enum: "a,b", dims: "384"),
+// assuming that "@check" has already been found.
+func parseCheckDirective(it *lex.ItemIterator, predicate string,
+	t types.TypeID) (*pb.CheckSpec, error) {
+
+	next := it.Item()
+	opts, err := parseTokenOptions(it, nil)
+	if err != nil {
+		return nil, err
+	}
+	attr := x.ParseAttr(predicate)
+	spec := &pb.CheckSpec{}
+	for _, opt := range opts {
+		var allowed bool
+		switch opt.Key {
+		case "min", "max":
+			allowed = t == types.IntID || t == types.FloatID || t == types.BigFloatID ||
+				t == types.DateTimeID || t == types.DurationID
+			if !allowed {
+				break
+			}
+			if _, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(opt.Value)},
+				t); err != nil {
+				return nil, next.Errorf("Invalid %s %q in @check for attr: [%v]: %v",
+					opt.Key, opt.Value, attr, err)
+			}
+			if opt.Key == "min" {
+				spec.Min = opt.Value
+			} else {
+				spec.Max = opt.Value
+			}
+		case "maxlen":
+			if allowed = t == types.StringID; !allowed {
+				break
+			}
+			n, err := strconv.ParseInt(opt.Value, 10, 64)
+			if err != nil || n <= 0 {
+				return nil, next.Errorf("Invalid maxlen %q in @check for attr: [%v]",
+					opt.Value, attr)
+			}
+			spec.MaxLength = n
+		case "regex":
+			if allowed = t == types.StringID; !allowed {
+				break
+			}
+			if _, err := regexp.Compile(opt.Value); err != nil {
+				return nil, next.Errorf("Invalid regex %q in @check for attr: [%v]: %v",
+					opt.Value, attr, err)
+			}
+			spec.Regex = opt.Value
+		case "enum":
+			if allowed = t == types.StringID; !allowed {
+				break
+			}
+			for _, v := range strings.Split(opt.Value, ",") {
+				spec.Allowed = append(spec.Allowed, strings.TrimSpace(v))
+			}
+		case "dims":
+			if allowed = t == types.VFloatID; !allowed {
+				break
+			}
+			n, err := strconv.ParseInt(opt.Value, 10, 64)
+			if err != nil || n <= 0 {
+				return nil, next.Errorf("Invalid dims %q in @check for attr: [%v]",
+					opt.Value, attr)
+			}
+			spec.Dims = n
+		default:
+			return nil, next.Errorf("Unknown option %q in @check for attr: [%v]", opt.Key, attr)
+		}
+		if !allowed {
+			return nil, next.Errorf("Option %q in @check is not valid for attr: [%v] of type %v",
+				opt.Key, attr, t.Name())
+		}
+	}
+
+	if spec.Min != "" && spec.Max != "" {
+		lo, _ := types.Convert(types.Val{Tid: types.StringID, Value: []byte(spec.Min)}, t)
+		hi, _ := types.Convert(types.Val{Tid: types.StringID, Value: []byte(spec.Max)}, t)
+		if types.CompareVals("gt", lo, hi) {
+			return nil, next.Errorf("min is greater than max in @check for attr: [%v]", attr)
+		}
+	}
+	if len(opts) == 0 {
+		return nil, next.Errorf("@check needs at least one option for attr: [%v]", attr)
+	}
+	return spec, nil
+}
+
+// parseFacetIndexDirective parses the names of the facets in @facetindex(facet1, facet2).
+func parseFacetIndexDirective(it *lex.ItemIterator) ([]string, error) {
+	next, ok := it.PeekOne()
+	if !ok || next.Typ != itemLeftRound {
+		return nil, next.Errorf("Expected ( after @facetindex. Got %v", next.Val)
+	}
+	it.Next()
+
+	var names []string
+	seen := make(map[string]struct{})
+	for it.Next() {
+		item := it.Item()
+		if item.Typ != itemText {
+			return nil, item.Errorf("Expected facet name in @facetindex. Got %v", item.Val)
+		}
+		if _, ok := seen[item.Val]; ok {
+			return nil, item.Errorf("Duplicate facet %s in @facetindex", item.Val)
+		}
+		seen[item.Val] = struct{}{}
+		names = append(names, item.Val)
+
+		it.Next()
+		if it.Item().Typ == itemRightRound {
+			return names, nil
+		}
+		if it.Item().Typ != itemComma {
+			return nil, it.Item().Errorf("Expected , or ) in @facetindex. Got %v", it.Item().Val)
+		}
+	}
+	return nil, it.Item().Errorf("Invalid ending while parsing @facetindex")
+}
+
+// parseTTLDirective parses the duration in @ttl(24h), after which the values written to the
+// predicate expire.
+func parseTTLDirective(it *lex.ItemIterator) (time.Duration, error) {
+	next, ok := it.PeekOne()
+	if !ok || next.Typ != itemLeftRound {
+		return 0, next.Errorf("Expected ( after @ttl. Got %v", next.Val)
+	}
+	it.Next()
+
+	var val string
+	for it.Next() {
+		item := it.Item()
+		if item.Typ == itemRightRound {
+			break
+		}
+		if item.Typ != itemText && item.Typ != itemNumber && item.Typ != itemDot {
+			return 0, item.Errorf("Expected duration in @ttl. Got %v", item.Val)
+		}
+		val += item.Val
+	}
+	if it.Item().Typ != itemRightRound {
+		return 0, it.Item().Errorf("Invalid ending while parsing @ttl")
+	}
+	ttl, err := time.ParseDuration(val)
+	if err != nil || ttl < time.Second {
+		return 0, it.Item().Errorf("Invalid duration %q in @ttl, it must be at least 1s", val)
+	}
+	return ttl, nil
+}
+
+// parseWhereDirective parses the conditions of @where(op:"value", ...), which restrict the
+// index of the predicate to the values satisfying all of them.
+func parseWhereDirective(it *lex.ItemIterator, predicate string,
+	t types.TypeID) ([]*pb.IndexCondition, error) {
+
+	next := it.Item()
+	attr := x.ParseAttr(predicate)
+	switch t {
+	case types.IntID, types.FloatID, types.BigFloatID, types.DateTimeID, types.StringID,
+		types.BoolID, types.DurationID, types.UUIDID, types.IPID:
+	default:
+		return nil, next.Errorf("@where directive is not supported for attr: [%v] of type %v",
+			attr, t.Name())
+	}
+	opts, err := parseTokenOptions(it, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts) == 0 {
+		return nil, next.Errorf("@where needs at least one condition for attr: [%v]", attr)
+	}
+	var conds []*pb.IndexCondition
+	for _, opt := range opts {
+		switch opt.Key {
+		case "eq", "ne", "lt", "le", "gt", "ge":
+		default:
+			return nil, next.Errorf("Unknown condition %q in @where for attr: [%v]", opt.Key, attr)
+		}
+		if _, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(opt.Value)},
+			t); err != nil {
+			return nil, next.Errorf("Invalid value %q of %s in @where for attr: [%v]: %v",
+				opt.Value, opt.Key, attr, err)
+		}
+		conds = append(conds, &pb.IndexCondition{Op: opt.Key, Value: opt.Value})
+	}
+	return conds, nil
+}
+
 func HasTokenizerOrVectorIndexSpec(update *pb.SchemaUpdate) bool {
 	if update == nil {
 		return false
@@ -480,6 +756,19 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 	typeUpdate := &pb.TypeUpdate{TypeName: x.NamespaceAttr(ns, it.Item().Val)}
 
 	it.Next()
+	for it.Item().Typ == itemAt {
+		name, preds, err := parseTypeDirective(it, ns)
+		if err != nil {
+			return nil, err
+		}
+		if name == "unique" {
+			typeUpdate.UniqueKeys = append(typeUpdate.UniqueKeys, &pb.UniqueKey{Predicates: preds})
+		} else {
+			typeUpdate.CompositeIndexes = append(typeUpdate.CompositeIndexes,
+				&pb.CompositeIndex{Predicates: preds})
+		}
+		it.Next()
+	}
 	if it.Item().Typ != itemLeftCurl {
 		return nil, it.Item().Errorf("Expected {. Got %v", it.Item().Val)
 	}
@@ -507,6 +796,23 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 				fieldSet[field.GetPredicate()] = struct{}{}
 			}
 
+			for _, key := range typeUpdate.UniqueKeys {
+				for _, pred := range key.Predicates {
+					if _, ok := fieldSet[pred]; !ok {
+						return nil, it.Item().Errorf("Field %s in @unique is not a field of type %s",
+							x.ParseAttr(pred), x.ParseAttr(typeUpdate.TypeName))
+					}
+				}
+			}
+			for _, index := range typeUpdate.CompositeIndexes {
+				for _, pred := range index.Predicates {
+					if _, ok := fieldSet[pred]; !ok {
+						return nil, it.Item().Errorf("Field %s in @index is not a field of type %s",
+							x.ParseAttr(pred), x.ParseAttr(typeUpdate.TypeName))
+					}
+				}
+			}
+
 			typeUpdate.Fields = fields
 			return typeUpdate, nil
 		case itemText:
@@ -524,13 +830,67 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 	return nil, errors.Errorf("Shouldn't reach here.")
 }
 
+// parseTypeDirective parses a directive of a type over some of its fields, either a composite
+// unique key, e.g. @unique(tenant, email), or a composite index, e.g. @index(status, created),
+// assuming that the iterator is on the "@". It returns the name of the directive and the fields.
+func parseTypeDirective(it *lex.ItemIterator, ns uint64) (string, []string, error) {
+	it.Next()
+	name := it.Item().Val
+	if it.Item().Typ != itemText || (name != "unique" && name != "index") {
+		return "", nil, it.Item().Errorf("Expected unique or index directive in type declaration."+
+			" Got %v", it.Item().Val)
+	}
+	it.Next()
+	if it.Item().Typ != itemLeftRound {
+		return "", nil, it.Item().Errorf("Expected ( after @%s. Got %v", name, it.Item().Val)
+	}
+
+	var preds []string
+	seen := make(map[string]struct{})
+	for it.Next() {
+		if it.Item().Typ != itemText {
+			return "", nil, it.Item().Errorf("Expected field name in @%s. Got %v",
+				name, it.Item().Val)
+		}
+		pred := x.NamespaceAttr(ns, it.Item().Val)
+		if _, ok := seen[pred]; ok {
+			return "", nil, it.Item().Errorf("Duplicate field %s in @%s", it.Item().Val, name)
+		}
+		seen[pred] = struct{}{}
+		preds = append(preds, pred)
+
+		it.Next()
+		if it.Item().Typ == itemRightRound {
+			break
+		}
+		if it.Item().Typ != itemComma {
+			return "", nil, it.Item().Errorf("Expected , or ) in @%s. Got %v",
+				name, it.Item().Val)
+		}
+	}
+	if len(preds) < 2 {
+		return "", nil, it.Item().Errorf("@%s on a type needs at least two fields,"+
+			" use @%s on the predicate instead", name, name)
+	}
+	return name, preds, nil
+}
+
 func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.SchemaUpdate, error) {
 	field := &pb.SchemaUpdate{Predicate: x.NamespaceAttr(ns, it.Item().Val)}
 	var list bool
 	it.Next()
 
 	// Simplified type definitions only require the field name. If a new line is found,
-	// proceed to the next field in the type.
+	// proceed to the next field in the type. A trailing exclamation mark marks the field
+	// as required, which is enforced when the namespace is in strict mode.
+	if it.Item().Typ == itemExclamationMark {
+		field.NonNullable = true
+		it.Next()
+		if it.Item().Typ != itemNewLine {
+			return nil, it.Item().Errorf("Expected new line after field declaration. Got %v",
+				it.Item().Val)
+		}
+	}
 	if it.Item().Typ == itemNewLine {
 		return field, nil
 	}
@@ -618,7 +978,7 @@ func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
 	case nextItems[0].Typ != itemText:
 		return false
 
-	case nextItems[1].Typ != itemLeftCurl:
+	case nextItems[1].Typ != itemLeftCurl && nextItems[1].Typ != itemAt:
 		return false
 	}
 
@@ -389,6 +389,59 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseEmbedding(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		description: string .
+		description_vec: float32vector @index(hnsw) @embedding(source: "description", url: "http://localhost:8000/v1/embeddings", model: "small") .
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 2)
+	require.Equal(t, &pb.EmbeddingSpec{
+		Source: "description",
+		Url:    "http://localhost:8000/v1/embeddings",
+		Model:  "small",
+	}, result.Preds[1].Embedding)
+
+	_, err = Parse(`description_vec: float32vector @embedding(url: "http://localhost") .`)
+	require.ErrorContains(t, err, "needs both source and url")
+	_, err = Parse(`description_vec: string @embedding(source: "a", url: "http://localhost") .`)
+	require.ErrorContains(t, err, "can only be specified for float32vector")
+	_, err = Parse(`description_vec: float32vector @embedding(source: "a", url: "b", foo: "c") .`)
+	require.ErrorContains(t, err, "Unknown option")
+}
+
+func TestParseCheck(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		age: int @check(min: "0", max: "150") .
+		born: datetime @check(min: "1900-01-01") .
+		name: string @index(exact) @check(maxlen: "64", regex: "^[A-Za-z ]+$") .
+		color: string @check(enum: "red, green, blue") .
+		vec: float32vector @check(dims: "3") .
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 5)
+	require.Equal(t, &pb.CheckSpec{Min: "0", Max: "150"}, result.Preds[0].Check)
+	require.Equal(t, &pb.CheckSpec{Min: "1900-01-01"}, result.Preds[1].Check)
+	require.Equal(t, &pb.CheckSpec{MaxLength: 64, Regex: "^[A-Za-z ]+$"}, result.Preds[2].Check)
+	require.Equal(t, &pb.CheckSpec{Allowed: []string{"red", "green", "blue"}}, result.Preds[3].Check)
+	require.Equal(t, &pb.CheckSpec{Dims: 3}, result.Preds[4].Check)
+
+	_, err = Parse(`age: int @check(min: "10", max: "1") .`)
+	require.ErrorContains(t, err, "min is greater than max")
+	_, err = Parse(`age: int @check(min: "ten") .`)
+	require.ErrorContains(t, err, "Invalid min")
+	_, err = Parse(`age: int @check(maxlen: "10") .`)
+	require.ErrorContains(t, err, `Option "maxlen" in @check is not valid for attr: [age] of type int`)
+	_, err = Parse(`name: string @check(regex: "[a-") .`)
+	require.ErrorContains(t, err, "Invalid regex")
+	_, err = Parse(`vec: float32vector @check(dims: "0") .`)
+	require.ErrorContains(t, err, "Invalid dims")
+	_, err = Parse(`name: string @check(foo: "bar") .`)
+	require.ErrorContains(t, err, "Unknown option")
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -553,6 +606,210 @@ func TestOldAndNewTypeFormat(t *testing.T) {
 	}, result.Types[0])
 }
 
+func TestParseRequiredTypeField(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person {
+			name!
+			address
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, &pb.TypeUpdate{
+		TypeName: x.AttrInRootNamespace("Person"),
+		Fields: []*pb.SchemaUpdate{
+			{
+				Predicate:   x.AttrInRootNamespace("name"),
+				NonNullable: true,
+			},
+			{
+				Predicate: x.AttrInRootNamespace("address"),
+			},
+		},
+	}, result.Types[0])
+
+	_, err = Parse(`
+		type Person {
+			name! address
+		}
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected new line after field declaration")
+}
+
+func TestParseStrict(t *testing.T) {
+	reset()
+	result, err := Parse(`dgraph.type: [string] @index(exact) @strict .`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Preds))
+	require.True(t, result.Preds[0].Strict)
+	require.False(t, CheckAndModifyPreDefPredicate(result.Preds[0]))
+
+	result, err = Parse(`dgraph.type: [string] @index(hash) @strict .`)
+	require.NoError(t, err)
+	require.True(t, CheckAndModifyPreDefPredicate(result.Preds[0]))
+
+	_, err = Parse(`name: string @strict .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "@strict directive can only be specified for dgraph.type")
+}
+
+func TestParseTypeUniqueKeys(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type User @unique(tenant, email) @unique(tenant, handle) {
+			tenant
+			email
+			handle
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, []*pb.UniqueKey{
+		{Predicates: []string{x.AttrInRootNamespace("tenant"), x.AttrInRootNamespace("email")}},
+		{Predicates: []string{x.AttrInRootNamespace("tenant"), x.AttrInRootNamespace("handle")}},
+	}, result.Types[0].UniqueKeys)
+	require.Equal(t, x.AttrInRootNamespace("dgraph.unique.User.tenant.email"),
+		UniqueKeyPredicate(result.Types[0].TypeName, result.Types[0].UniqueKeys[0]))
+
+	_, err = Parse(`
+		type User @unique(tenant, email) {
+			tenant
+		}
+	`)
+	require.ErrorContains(t, err, "Field email in @unique is not a field of type User")
+
+	_, err = Parse(`
+		type User @unique(email) {
+			email
+		}
+	`)
+	require.ErrorContains(t, err, "@unique on a type needs at least two fields")
+
+	_, err = Parse(`
+		type User @unique(email, email) {
+			email
+		}
+	`)
+	require.ErrorContains(t, err, "Duplicate field email in @unique")
+}
+
+func TestParseWhere(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		status: string @index(exact) @where(ne: "archived") .
+		score: int @where(ge: "0", lt: "100") @index(int) .
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 2)
+	require.Equal(t, []*pb.IndexCondition{{Op: "ne", Value: "archived"}}, result.Preds[0].Where)
+	require.Equal(t, []*pb.IndexCondition{{Op: "ge", Value: "0"}, {Op: "lt", Value: "100"}},
+		result.Preds[1].Where)
+
+	_, err = Parse(`status: string @where(ne: "archived") .`)
+	require.ErrorContains(t, err, "@where directive needs @index")
+	_, err = Parse(`score: int @index(int) @where(ge: "zero") .`)
+	require.ErrorContains(t, err, "Invalid value")
+	_, err = Parse(`score: int @index(int) @where(in: "1") .`)
+	require.ErrorContains(t, err, "Unknown condition")
+	_, err = Parse(`email: string @index(exact) @unique @where(ne: "") .`)
+	require.ErrorContains(t, err, "cannot be used with @unique")
+}
+
+func TestParseFacetIndex(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		friend: [uid] @reverse @facetindex(since, weight) .
+		name: string @facetindex(origin) .
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 2)
+	require.Equal(t, []string{"since", "weight"}, result.Preds[0].FacetIndex)
+	require.Equal(t, []string{"origin"}, result.Preds[1].FacetIndex)
+
+	_, err = Parse(`friend: [uid] @facetindex .`)
+	require.ErrorContains(t, err, "Expected ( after @facetindex")
+	_, err = Parse(`friend: [uid] @facetindex(since, since) .`)
+	require.ErrorContains(t, err, "Duplicate facet since in @facetindex")
+}
+
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		token: string @index(exact) @ttl(24h) .
+		session: [uid] @reverse @ttl(1h30m) .
+		event: string @ttl(1.5h) .
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 3)
+	require.EqualValues(t, 24*3600, result.Preds[0].Ttl)
+	require.EqualValues(t, 5400, result.Preds[1].Ttl)
+	require.EqualValues(t, 5400, result.Preds[2].Ttl)
+
+	_, err = Parse(`token: string @ttl .`)
+	require.ErrorContains(t, err, "Expected ( after @ttl")
+	_, err = Parse(`token: string @ttl(10ms) .`)
+	require.ErrorContains(t, err, "it must be at least 1s")
+	_, err = Parse(`token: string @ttl(day) .`)
+	require.ErrorContains(t, err, "Invalid duration")
+}
+
+func TestParseDurationUUIDAndIPTypes(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		timeout: duration @index(duration) @check(min: "1s", max: "PT1H") .
+		key: uuid @index(uuid) @upsert .
+		addr: [ip] @index(ip) .
+	`)
+	require.NoError(t, err)
+	require.Len(t, result.Preds, 3)
+	require.Equal(t, pb.Posting_DURATION, result.Preds[0].ValueType)
+	require.Equal(t, []string{"duration"}, result.Preds[0].Tokenizer)
+	require.Equal(t, pb.Posting_UUID, result.Preds[1].ValueType)
+	require.Equal(t, pb.Posting_IP, result.Preds[2].ValueType)
+	require.True(t, result.Preds[2].List)
+
+	_, err = Parse(`addr: ip @index(exact) .`)
+	require.Error(t, err)
+	_, err = Parse(`timeout: duration @check(min: "soon") .`)
+	require.ErrorContains(t, err, "Invalid min")
+}
+
+func TestParseTypeCompositeIndexes(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Order @index(status, created) @unique(tenant, number) {
+			tenant
+			number
+			status
+			created
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, []*pb.CompositeIndex{
+		{Predicates: []string{x.AttrInRootNamespace("status"), x.AttrInRootNamespace("created")}},
+	}, result.Types[0].CompositeIndexes)
+	require.Equal(t, 1, len(result.Types[0].UniqueKeys))
+	require.Equal(t, x.AttrInRootNamespace("dgraph.index.Order.status.created"),
+		CompositeIndexPredicate(result.Types[0].TypeName, result.Types[0].CompositeIndexes[0]))
+
+	_, err = Parse(`
+		type Order @index(status, created) {
+			status
+		}
+	`)
+	require.ErrorContains(t, err, "Field created in @index is not a field of type Order")
+
+	_, err = Parse(`
+		type Order @index(status) {
+			status
+		}
+	`)
+	require.ErrorContains(t, err, "@index on a type needs at least two fields")
+}
+
 func TestParseTypeErrMissingNewLine(t *testing.T) {
 	reset()
 	_, err := Parse(`
@@ -11,7 +11,9 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -64,6 +66,10 @@ type state struct {
 	elog      trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
+	// embeddings maps a predicate to the predicates whose vectors are computed
+	// from its values. It is reset whenever a predicate changes and rebuilt
+	// on demand by EmbeddingTargets.
+	embeddings map[string][]string
 }
 
 // State returns the struct holding the current schema.
@@ -86,6 +92,7 @@ func (s *state) DeleteAll() {
 	for pred := range s.mutSchema {
 		delete(s.mutSchema, pred)
 	}
+	s.embeddings = nil
 }
 
 // Delete updates the schema in memory and disk
@@ -106,6 +113,7 @@ func (s *state) Delete(attr string, ts uint64) error {
 
 	delete(s.predicate, attr)
 	delete(s.mutSchema, attr)
+	s.embeddings = nil
 	return nil
 }
 
@@ -163,6 +171,7 @@ func (s *state) DeletePredsForNs(delNs uint64) {
 			delete(s.mutSchema, pred)
 		}
 	}
+	s.embeddings = nil
 	for typ := range s.types {
 		ns := x.ParseNamespace(typ)
 		if ns == delNs {
@@ -198,6 +207,7 @@ func (s *state) Set(pred string, schema *pb.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.predicate[pred] = schema
+	s.embeddings = nil
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
@@ -362,6 +372,49 @@ func (s *state) Tokenizer(ctx context.Context, pred string) []tok.Tokenizer {
 	return tokenizers
 }
 
+// IndexConditions returns the conditions that the values of the predicate must satisfy to be
+// indexed. All the values are indexed if there are none.
+func (s *state) IndexConditions(ctx context.Context, pred string) []*pb.IndexCondition {
+	isWrite, _ := ctx.Value(IsWrite).(bool)
+	s.RLock()
+	defer s.RUnlock()
+	if isWrite {
+		if schema, ok := s.mutSchema[pred]; ok {
+			return schema.Where
+		}
+	}
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.Where
+	}
+	return nil
+}
+
+// FacetIndexes returns the names of the indexed facets of the predicate.
+func (s *state) FacetIndexes(ctx context.Context, pred string) []string {
+	isWrite, _ := ctx.Value(IsWrite).(bool)
+	s.RLock()
+	defer s.RUnlock()
+	if isWrite {
+		if schema, ok := s.mutSchema[pred]; ok {
+			return schema.FacetIndex
+		}
+	}
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.FacetIndex
+	}
+	return nil
+}
+
+// TTL returns the time-to-live of the values written to the predicate, zero if they don't expire.
+func (s *state) TTL(pred string) time.Duration {
+	s.RLock()
+	defer s.RUnlock()
+	if schema, ok := s.predicate[pred]; ok {
+		return time.Duration(schema.Ttl) * time.Second
+	}
+	return 0
+}
+
 // FactoryCreateSpec(ctx, pred) returns the list of versioned
 // FactoryCreateSpec instances for given predicate.
 // The FactoryCreateSpec type defines the IndexFactory instance(s)
@@ -475,11 +528,63 @@ func (s *state) PredicatesToDelete(pred string) []string {
 			preds = append(preds, pred+hnsw.VecEntry)
 			preds = append(preds, pred+hnsw.VecKeyword)
 			preds = append(preds, pred+hnsw.VecDead)
+			if schema.List {
+				chunkPred := pred + hnsw.VecChunk
+				preds = append(preds, chunkPred, pred+hnsw.VecChunkOwner)
+				preds = append(preds, chunkPred+hnsw.VecEntry, chunkPred+hnsw.VecKeyword,
+					chunkPred+hnsw.VecDead)
+			}
 		}
 	}
 	return preds
 }
 
+// EmbeddingTargets returns the predicates of this group whose vectors are
+// computed from the values of the source predicate, see @embedding.
+func (s *state) EmbeddingTargets(source string) []string {
+	return s.embeddingTargets()[source]
+}
+
+// Embeddings returns the predicates of this group whose vectors are computed
+// by the server.
+func (s *state) Embeddings() []string {
+	var preds []string
+	for _, targets := range s.embeddingTargets() {
+		preds = append(preds, targets...)
+	}
+	return preds
+}
+
+// HasEmbeddings returns whether any predicate has its vectors computed by the
+// server.
+func (s *state) HasEmbeddings() bool {
+	return len(s.embeddingTargets()) > 0
+}
+
+// embeddingTargets returns s.embeddings, building it if needed. The returned
+// map is never modified, so it can be read without holding the lock.
+func (s *state) embeddingTargets() map[string][]string {
+	s.RLock()
+	if s.embeddings != nil {
+		defer s.RUnlock()
+		return s.embeddings
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+	if s.embeddings == nil {
+		s.embeddings = make(map[string][]string)
+		for pred, su := range s.predicate {
+			if spec := su.GetEmbedding(); spec != nil {
+				src := x.NamespaceAttr(x.ParseNamespace(pred), spec.Source)
+				s.embeddings[src] = append(s.embeddings[src], pred)
+			}
+		}
+	}
+	return s.embeddings
+}
+
 // IsList returns whether the predicate is of list type.
 func (s *state) IsList(pred string) bool {
 	s.RLock()
@@ -508,6 +613,14 @@ func (s *state) HasLang(pred string) bool {
 	return false
 }
 
+// IsStrict returns whether the namespace is in strict mode, i.e. whether its
+// dgraph.type predicate has the @strict directive.
+func (s *state) IsStrict(ns uint64) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[x.NamespaceAttr(ns, "dgraph.type")].GetStrict()
+}
+
 func (s *state) HasNoConflict(pred string) bool {
 	s.RLock()
 	defer s.RUnlock()
@@ -868,6 +981,63 @@ func initialSchemaInternal(namespace uint64, all bool) []*pb.SchemaUpdate {
 	return initialSchema
 }
 
+// UniqueKeyPrefix is the prefix of the internal predicates of composite unique keys.
+const UniqueKeyPrefix = "dgraph.unique."
+
+// CompositeIndexPrefix is the prefix of the internal predicates of composite indexes.
+const CompositeIndexPrefix = "dgraph.index."
+
+// UniqueKeyPredicate returns the internal predicate that indexes the combined values of the
+// fields of a composite unique key of the type.
+func UniqueKeyPredicate(typeName string, key *pb.UniqueKey) string {
+	return tuplePredicate(UniqueKeyPrefix, typeName, key.Predicates)
+}
+
+// UniqueKeySchema returns the schema of the internal predicate of a composite unique key.
+// The @upsert directive makes concurrent transactions writing the same combined values
+// conflict with each other.
+func UniqueKeySchema(typeName string, key *pb.UniqueKey) *pb.SchemaUpdate {
+	return &pb.SchemaUpdate{
+		Predicate: UniqueKeyPredicate(typeName, key),
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		Upsert:    true,
+	}
+}
+
+// CompositeIndexPredicate returns the internal predicate that indexes the combined tokens of
+// the values of the fields of a composite index of the type.
+func CompositeIndexPredicate(typeName string, index *pb.CompositeIndex) string {
+	return tuplePredicate(CompositeIndexPrefix, typeName, index.Predicates)
+}
+
+// CompositeIndexSchema returns the schema of the internal predicate of a composite index.
+func CompositeIndexSchema(typeName string, index *pb.CompositeIndex) *pb.SchemaUpdate {
+	return &pb.SchemaUpdate{
+		Predicate: CompositeIndexPredicate(typeName, index),
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+	}
+}
+
+// IsTuplePredicate returns whether the predicate is the internal predicate of a composite
+// unique key or of a composite index.
+func IsTuplePredicate(pred string) bool {
+	attr := x.ParseAttr(pred)
+	return strings.HasPrefix(attr, UniqueKeyPrefix) || strings.HasPrefix(attr, CompositeIndexPrefix)
+}
+
+func tuplePredicate(prefix, typeName string, preds []string) string {
+	ns, name := x.ParseNamespaceAttr(typeName)
+	parts := []string{name}
+	for _, pred := range preds {
+		parts = append(parts, x.ParseAttr(pred))
+	}
+	return x.NamespaceAttr(ns, prefix+strings.Join(parts, "."))
+}
+
 // CheckAndModifyPreDefPredicate returns true if the initial update for the pre-defined
 // predicate is different from the passed update. It may also modify certain predicates
 // under specific conditions.
@@ -893,6 +1063,13 @@ func CheckAndModifyPreDefPredicate(update *pb.SchemaUpdate) bool {
 				return false
 			}
 		}
+
+		// The dgraph.type predicate may additionally be marked @strict to turn on strict
+		// mode for the namespace.
+		if update.Predicate == x.NamespaceAttr(ns, "dgraph.type") && update.Strict {
+			changed := compareSchemaUpdates(original, update)
+			return len(changed) != 1 || changed[0] != "Strict"
+		}
 		return !proto.Equal(original, update)
 	}
 	return true
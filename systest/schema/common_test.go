@@ -0,0 +1,35 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/dgraphapi"
+	"github.com/hypermodeinc/dgraph/v25/dgraphtest"
+)
+
+func setUpDgraph(t *testing.T) *dgraphapi.GrpcClient {
+	c := dgraphtest.ComposeCluster{}
+	dg, close, err := c.Client()
+	require.NoError(t, err)
+	t.Cleanup(close)
+	require.NoError(t, dg.Login(context.Background(), "groot", "password"))
+	require.NoError(t, dg.DropAll())
+	return dg
+}
+
+// checkQuery runs the query and compares its result with the expected JSON.
+func checkQuery(t *testing.T, dg *dgraphapi.GrpcClient, q, expected string) {
+	resp, err := dg.Query(q)
+	require.NoError(t, err)
+	require.NoError(t, dgraphapi.CompareJSON(expected, string(resp.GetJson())))
+}
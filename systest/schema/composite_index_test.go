@@ -0,0 +1,101 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+const orderSchema = `
+	status: string .
+	total: int .
+	note: string .
+	type Order @index(status, total) {
+		status
+		total
+		note
+	}
+`
+
+func TestCompositeIndex(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		status: string .
+		total: int .
+		note: string .
+		type Order {
+			status
+			total
+			note
+		}
+	`))
+
+	// Nodes written before the index is declared are indexed in the background.
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "Order" .
+			_:a <status> "open" .
+			_:a <total> "10" .
+			_:a <note> "a" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, dg.SetupSchema(orderSchema))
+	require.Eventually(t, func() bool {
+		resp, err := dg.Query(`{ q(func: has(<dgraph.index.Order.status.total>)) { count(uid) } }`)
+		return err == nil && string(resp.GetJson()) == `{"q":[{"count":1}]}`
+	}, time.Minute, time.Second)
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:b <dgraph.type> "Order" .
+			_:b <status> "open" .
+			_:b <total> "25" .
+			_:b <note> "b" .
+			_:c <dgraph.type> "Order" .
+			_:c <status> "closed" .
+			_:c <total> "15" .
+			_:c <note> "c" .
+			_:d <dgraph.type> "Order" .
+			_:d <status> "open" .
+			_:d <note> "d" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	orders := func(filter string) string {
+		return `{ q(func: type(Order), orderasc: note) @filter(` + filter + `) { note } }`
+	}
+	checkQuery(t, dg, orders(`eq(status, "open")`),
+		`{"q":[{"note":"a"},{"note":"b"},{"note":"d"}]}`)
+	checkQuery(t, dg, orders(`eq(status, "open") AND gt(total, 10)`), `{"q":[{"note":"b"}]}`)
+	checkQuery(t, dg, orders(`eq(status, "open") AND between(total, 5, 30)`),
+		`{"q":[{"note":"a"},{"note":"b"}]}`)
+	checkQuery(t, dg, orders(`eq(status, "closed") AND le(total, 15)`), `{"q":[{"note":"c"}]}`)
+
+	// The index follows the changes of the values and types of the nodes.
+	_, err = dg.NewTxn().Do(t.Context(), &api.Request{
+		Query: `{
+			v as var(func: type(Order)) @filter(eq(note, "b"))
+			w as var(func: type(Order)) @filter(eq(note, "a"))
+		}`,
+		Mutations: []*api.Mutation{
+			{SetNquads: []byte(`uid(v) <status> "closed" .`)},
+			{DelNquads: []byte(`uid(w) <dgraph.type> "Order" .`)},
+		},
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	checkQuery(t, dg, orders(`eq(status, "open")`), `{"q":[{"note":"d"}]}`)
+	checkQuery(t, dg, orders(`eq(status, "closed")`), `{"q":[{"note":"b"},{"note":"c"}]}`)
+}
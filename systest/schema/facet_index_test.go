@@ -0,0 +1,81 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+func TestFacetIndex(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		friend: [uid] @reverse @facetindex(since, close) .
+	`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "a" .
+			_:b <name> "b" .
+			_:c <name> "c" .
+			_:d <name> "d" .
+			_:a <friend> _:b (since=2015-01-01T00:00:00, close=true) .
+			_:a <friend> _:c (since=2021-06-01T00:00:00) .
+			_:b <friend> _:d (since=2019-03-01T00:00:00, close=false) .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	checkQuery(t, dg, `{ q(func: facet(friend, close, eq, true)) { name } }`, `{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: facet(friend, since, ge, "2019-01-01"), orderasc: name) { name } }`,
+		`{"q":[{"name":"a"},{"name":"b"}]}`)
+	checkQuery(t, dg, `{ q(func: facet(friend, since, lt, "2019-03-01")) { name } }`,
+		`{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: facet(~friend, since, between, "2019-01-01", "2022-01-01"),
+		orderasc: name) { name } }`, `{"q":[{"name":"c"},{"name":"d"}]}`)
+	checkQuery(t, dg, `{ q(func: has(name), orderasc: name) @filter(facet(friend, close, eq, false)) {
+		name
+	} }`, `{"q":[{"name":"b"}]}`)
+
+	_, err = dg.Query(`{ q(func: facet(friend, weight, eq, 1)) { name } }`)
+	require.ErrorContains(t, err, "Facet weight of predicate friend is not indexed")
+
+	// Updating and deleting edges keeps the index up to date.
+	_, err = dg.NewTxn().Do(t.Context(), &api.Request{
+		Query: `{ a as var(func: eq(name, "a")) b as var(func: eq(name, "b"))
+			c as var(func: eq(name, "c")) d as var(func: eq(name, "d")) }`,
+		Mutations: []*api.Mutation{
+			{
+				SetNquads: []byte(`uid(a) <friend> uid(c) (since=2010-01-01T00:00:00, close=true) .`),
+				DelNquads: []byte(`uid(b) <friend> uid(d) .`),
+			},
+		},
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	checkQuery(t, dg, `{ q(func: facet(friend, since, ge, "2019-01-01")) { name } }`, `{"q":[]}`)
+	checkQuery(t, dg, `{ q(func: facet(~friend, close, eq, true), orderasc: name) { name } }`,
+		`{"q":[{"name":"b"},{"name":"c"}]}`)
+
+	// An index on a facet added later covers the existing edges.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:e <name> "e" .
+			_:e <friend> _:f (rank=3) .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, dg.SetupSchema(`
+		friend: [uid] @reverse @facetindex(since, close, rank) .
+	`))
+	checkQuery(t, dg, `{ q(func: facet(friend, rank, gt, 2)) { name } }`, `{"q":[{"name":"e"}]}`)
+}
@@ -0,0 +1,129 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/testutil"
+)
+
+func TestJSONType(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		attrs: json @index(jsonpath) .
+		extra: json .
+	`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetJson: []byte(`[
+			{"name": "a", "attrs": {"color": "red", "size": 10, "tags": ["new", "sale"]},
+				"extra": {"note": "x"}},
+			{"name": "b", "attrs": {"color": "blue", "dims": {"w": 2}}, "extra": [1, 2]}
+		]`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:c <name> "c" .
+			_:c <attrs> "{\"color\": \"red\", \"size\": 12}" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:d <attrs> "{\"color\": }" .`),
+		CommitNow: true,
+	})
+	require.Error(t, err)
+
+	// The documents are returned as nested values.
+	checkQuery(t, dg, `{ q(func: eq(name, "a")) { attrs extra } }`,
+		`{"q":[{"attrs":{"color":"red","size":10,"tags":["new","sale"]},"extra":{"note":"x"}}]}`)
+
+	// With the path index.
+	checkQuery(t, dg, `{ q(func: json_path(attrs, "color", "red"), orderasc: name) { name } }`,
+		`{"q":[{"name":"a"},{"name":"c"}]}`)
+	checkQuery(t, dg, `{ q(func: json_path(attrs, "$.size", "12")) { name } }`, `{"q":[{"name":"c"}]}`)
+	checkQuery(t, dg, `{ q(func: json_path(attrs, "tags", "sale")) { name } }`, `{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: json_path(attrs, "dims")) { name } }`, `{"q":[{"name":"b"}]}`)
+	checkQuery(t, dg, `{ q(func: json_path(attrs, "dims", "{\"w\": 2}")) { name } }`,
+		`{"q":[{"name":"b"}]}`)
+	checkQuery(t, dg, `{ q(func: has(name), orderasc: name) @filter(json_path(attrs, "color", "red")) {
+		name
+	} }`, `{"q":[{"name":"a"},{"name":"c"}]}`)
+
+	// Without it.
+	checkQuery(t, dg, `{ q(func: json_path(extra, "note")) { name } }`, `{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: json_path(extra, "1", "2")) { name } }`, `{"q":[{"name":"b"}]}`)
+	checkQuery(t, dg, `{ q(func: has(name)) @filter(json_path(extra, "note", "y")) { name } }`,
+		`{"q":[]}`)
+
+	_, err = dg.Query(`{ q(func: json_path(name, "a")) { name } }`)
+	require.ErrorContains(t, err, "Function json_path requires predicate name to be of type json")
+}
+
+// TestJSONTypeMultiGroup mutates a json predicate through the same alpha before and after its
+// tablet moves to another group, so that one of the mutations is parsed by an alpha outside the
+// group serving the predicate.
+func TestJSONTypeMultiGroup(t *testing.T) {
+	state, err := testutil.GetState()
+	require.NoError(t, err)
+	if len(state.Groups) < 2 {
+		t.Skip("the cluster has a single group")
+	}
+
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		doc: json .
+	`))
+	mutate := func(name string) error {
+		_, err := dg.Mutate(&api.Mutation{
+			SetJson:   []byte(fmt.Sprintf(`{"name": %q, "doc": {"group": [%q]}}`, name, name)),
+			CommitNow: true,
+		})
+		return err
+	}
+
+	require.NoError(t, mutate("a"))
+	state, err = testutil.GetState()
+	require.NoError(t, err)
+	var src, dst string
+	for gid, group := range state.Groups {
+		if _, ok := group.Tablets["0-doc"]; ok {
+			src = gid
+		} else if len(group.Members) > 0 {
+			dst = gid
+		}
+	}
+	require.NotEmpty(t, src)
+	require.NotEmpty(t, dst)
+	resp, err := http.Get(fmt.Sprintf("http://%s/moveTablet?tablet=doc&group=%s",
+		testutil.GetSockAddrZeroHttp(), dst))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+	// The alpha serves the mutations once it learns about the move.
+	require.Eventually(t, func() bool {
+		return mutate("b") == nil
+	}, 30*time.Second, 500*time.Millisecond)
+
+	checkQuery(t, dg, `{ q(func: has(name), orderasc: name) { name doc } }`,
+		`{"q":[{"name":"a","doc":{"group":["a"]}},{"name":"b","doc":{"group":["b"]}}]}`)
+}
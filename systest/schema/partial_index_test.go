@@ -0,0 +1,78 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+func TestPartialIndex(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		status: string @index(exact) @where(ne: "archived") .
+	`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "a" .
+			_:a <status> "open" .
+			_:b <name> "b" .
+			_:b <status> "archived" .
+			_:c <name> "c" .
+			_:c <status> "closed" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	checkQuery(t, dg, `{ q(func: eq(status, "open")) { name } }`, `{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: eq(status, ["open", "closed"]), orderasc: name) { name } }`,
+		`{"q":[{"name":"a"},{"name":"c"}]}`)
+
+	// The archived values are not indexed, so they can only be found through a filter.
+	_, err = dg.Query(`{ q(func: eq(status, "archived")) { name } }`)
+	require.ErrorContains(t, err, "has a partial index")
+	checkQuery(t, dg, `{
+		q(func: has(name), orderasc: name) @filter(eq(status, "archived") OR eq(status, "open")) {
+			name
+		}
+	}`, `{"q":[{"name":"a"},{"name":"b"}]}`)
+
+	// Sorting doesn't use the partial index, so no value is left out.
+	checkQuery(t, dg, `{ q(func: has(status), orderasc: status) { name } }`,
+		`{"q":[{"name":"b"},{"name":"c"},{"name":"a"}]}`)
+
+	// Moving a value out of the condition removes it from the index.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:d <name> "d" .
+			_:d <status> "open" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	resp, err := dg.Query(`{ q(func: eq(name, "d")) { uid } }`)
+	require.NoError(t, err)
+	var res struct {
+		Q []struct {
+			UID string `json:"uid"`
+		} `json:"q"`
+	}
+	require.NoError(t, json.Unmarshal(resp.GetJson(), &res))
+	require.Len(t, res.Q, 1)
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<` + res.Q[0].UID + `> <status> "archived" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	checkQuery(t, dg, `{ q(func: eq(status, "open")) { name } }`, `{"q":[{"name":"a"}]}`)
+}
@@ -0,0 +1,78 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+func TestDurationUUIDAndIPTypes(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		timeout: duration @index(duration) .
+		key: uuid @index(uuid) .
+		addr: [ip] @index(ip) .
+	`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "a" .
+			_:a <timeout> "PT1M30S" .
+			_:a <key> "6F9619FF-8B86-D011-B42D-00C04FC964FF" .
+			_:a <addr> "10.1.2.3" .
+			_:a <addr> "2001:db8::1" .
+			_:b <name> "b" .
+			_:b <timeout> "250ms" .
+			_:b <addr> "10.1.0.0/16" .
+			_:c <name> "c" .
+			_:c <timeout> "2h" .
+			_:c <addr> "192.168.1.10/8" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:d <addr> "10.1.2.300" .`),
+		CommitNow: true,
+	})
+	require.Error(t, err)
+
+	checkQuery(t, dg, `{ q(func: eq(name, "a")) { timeout key addr } }`,
+		`{"q":[{"timeout":"1m30s","key":"6f9619ff-8b86-d011-b42d-00c04fc964ff",
+			"addr":["10.1.2.3","2001:db8::1"]}]}`)
+	checkQuery(t, dg, `{ q(func: ge(timeout, "1m"), orderdesc: timeout) { name } }`,
+		`{"q":[{"name":"c"},{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: has(timeout), orderasc: timeout) { name } }`,
+		`{"q":[{"name":"b"},{"name":"a"},{"name":"c"}]}`)
+	checkQuery(t, dg, `{ q(func: eq(key, "6f9619ff-8b86-d011-b42d-00c04fc964ff")) { name } }`,
+		`{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ var(func: has(timeout)) { t as timeout } q() { m: max(val(t)) } }`,
+		`{"q":[{"m":"2h0m0s"}]}`)
+
+	// Networks are stored in their canonical form.
+	checkQuery(t, dg, `{ q(func: eq(name, "c")) { addr } }`, `{"q":[{"addr":["192.0.0.0/8"]}]}`)
+
+	checkQuery(t, dg, `{ q(func: ip_within(addr, "10.0.0.0/8"), orderasc: name) { name } }`,
+		`{"q":[{"name":"a"},{"name":"b"}]}`)
+	checkQuery(t, dg, `{ q(func: ip_within(addr, "10.1.2.0/24")) { name } }`, `{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: ip_within(addr, "2001:db8::/32")) { name } }`, `{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: ip_contains(addr, "10.1.9.9"), orderasc: name) { name } }`,
+		`{"q":[{"name":"b"}]}`)
+	checkQuery(t, dg, `{ q(func: ip_contains(addr, "192.168.1.1")) { name } }`, `{"q":[{"name":"c"}]}`)
+	checkQuery(t, dg, `{ q(func: has(name), orderasc: name) @filter(ip_contains(addr, "10.1.2.3")) {
+		name
+	} }`, `{"q":[{"name":"a"},{"name":"b"}]}`)
+
+	_, err = dg.Query(`{ q(func: ip_within(name, "10.0.0.0/8")) { name } }`)
+	require.ErrorContains(t, err, "Function ip_within requires predicate name to be of type ip")
+}
@@ -0,0 +1,128 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/dgraphapi"
+	"github.com/hypermodeinc/dgraph/v25/dgraphtest"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+type schemaDefinitionChange struct {
+	Name   string  `json:"name"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+type schemaChange struct {
+	Version    uint64                   `json:"version"`
+	User       string                   `json:"user"`
+	RollbackTo uint64                   `json:"rollbackTo"`
+	Predicates []schemaDefinitionChange `json:"predicates"`
+	Types      []schemaDefinitionChange `json:"types"`
+}
+
+func TestSchemaHistoryAndRollback(t *testing.T) {
+	dg := setUpDgraph(t)
+	hc, err := (&dgraphtest.ComposeCluster{}).HTTPClient()
+	require.NoError(t, err)
+	require.NoError(t, hc.LoginIntoNamespace(dgraphapi.DefaultUser, dgraphapi.DefaultPassword,
+		x.RootNamespace))
+
+	history := func() []schemaChange {
+		resp, err := hc.RunGraphqlQuery(dgraphapi.GraphQLParams{Query: `{
+			schemaHistory {
+				version user rollbackTo
+				predicates { name before after }
+				types { name before after }
+			}
+		}`}, true)
+		require.NoError(t, err)
+		var res struct {
+			SchemaHistory []schemaChange `json:"schemaHistory"`
+		}
+		require.NoError(t, json.Unmarshal(resp, &res))
+		return res.SchemaHistory
+	}
+
+	require.NoError(t, dg.SetupSchema(`
+		name: string .
+		type Person {
+			name
+		}
+	`))
+	v1 := history()[0]
+	require.Equal(t, "groot", v1.User)
+
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		age: int .
+		type Person {
+			name
+			age
+		}
+		type Pet {
+			name
+		}
+	`))
+	v2 := history()[0]
+	require.Greater(t, v2.Version, v1.Version)
+	require.Equal(t, []schemaDefinitionChange{
+		{Name: "age", After: ptr("<age>:int .")},
+		{Name: "name", Before: ptr("<name>:string ."), After: ptr("<name>:string @index(exact) .")},
+	}, v2.Predicates)
+	require.Len(t, v2.Types, 2)
+	require.Nil(t, v2.Types[1].Before)
+
+	// Setting the same schema again doesn't change it.
+	require.NoError(t, dg.SetupSchema(`name: string @index(exact) .`))
+	require.Equal(t, v2.Version, history()[0].Version)
+
+	_, err = dg.Query(`{ q(func: eq(name, "a")) { name } }`)
+	require.NoError(t, err)
+
+	resp, err := hc.RunGraphqlQuery(dgraphapi.GraphQLParams{Query: fmt.Sprintf(`mutation {
+		rollbackSchema(input: {version: "%d"}) { response { code } }
+	}`, v1.Version)}, true)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"rollbackSchema":{"response":{"code":"Success"}}}`, string(resp))
+
+	rollback := history()[0]
+	require.Equal(t, v1.Version, rollback.RollbackTo)
+	require.Equal(t, []schemaDefinitionChange{
+		{Name: "name", Before: ptr("<name>:string @index(exact) ."), After: ptr("<name>:string .")},
+	}, rollback.Predicates)
+
+	// The index is dropped in the background, and the type created after the version too.
+	require.Eventually(t, func() bool {
+		_, err := dg.Query(`{ q(func: eq(name, "a")) { name } }`)
+		return err != nil
+	}, 10*time.Second, 100*time.Millisecond)
+	checkQuery(t, dg, `schema(type: [Person, Pet]) {}`,
+		`{"types":[{"name":"Person","fields":[{"name":"name"}]}]}`)
+
+	// The predicate created after the version is kept.
+	checkQuery(t, dg, `schema(pred: [age]) { type }`,
+		`{"schema":[{"predicate":"age","type":"int"}]}`)
+
+	_, err = hc.RunGraphqlQuery(dgraphapi.GraphQLParams{Query: `mutation {
+		rollbackSchema(input: {version: "1"}) { response { code } }
+	}`}, true)
+	require.ErrorContains(t, err, "Schema version 1 not found in the history")
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,113 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+const strictSchema = `
+	dgraph.type: [string] @index(exact) @strict .
+	name: string @index(exact) .
+	age: int .
+	nickname: string .
+	type Person {
+		name!
+		age
+	}
+`
+
+func TestStrictUndeclaredPredicate(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(strictSchema))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "Person" .
+			_:a <name> "Alice" .
+			_:a <nickname> "Al" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err,
+		"strict mode: predicate nickname is not declared in the types [Person]")
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <name> "Alice" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "strict mode: cannot set predicate name")
+	require.ErrorContains(t, err, "as the node has no type")
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "Person" .
+			_:a <name> "Alice" .
+			_:a <age> "30" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestStrictRequiredField(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(strictSchema))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "Person" .
+			_:a <age> "30" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "is missing required predicate name of types [Person]")
+
+	// The required field may be set by a later mutation of the same transaction.
+	ctx := context.Background()
+	txn := dg.NewTxn()
+	resp, err := txn.Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "Person" .
+			_:a <age> "30" .`),
+	})
+	require.NoError(t, err)
+	uid := resp.Uids["a"]
+	_, err = txn.Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`<` + uid + `> <name> "Alice" .`),
+	})
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit(ctx))
+
+	_, err = dg.Mutate(&api.Mutation{
+		DelNquads: []byte(`<` + uid + `> <name> * .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "strict mode: cannot delete required predicate name")
+
+	// Deleting the whole node is allowed.
+	_, err = dg.Mutate(&api.Mutation{
+		DelNquads: []byte(`<` + uid + `> * * .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestStrictDisabled(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(strictSchema))
+	require.NoError(t, dg.SetupSchema(`dgraph.type: [string] @index(exact) .`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <nickname> "Al" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+func TestTTL(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string @index(exact) .
+		token: string @index(exact) @ttl(2s) .
+		session: [uid] @reverse @count .
+	`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <name> "a" .
+			_:a <token> "t1" .
+			_:b <name> "b" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// The edges of a request with a time-to-live expire with it.
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "ttl", "2s")
+	_, err = dg.NewTxn().Do(ctx, &api.Request{
+		Query: `{ a as var(func: eq(name, "a")) b as var(func: eq(name, "b")) }`,
+		Mutations: []*api.Mutation{
+			{SetNquads: []byte(`uid(a) <session> uid(b) .`)},
+		},
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	checkQuery(t, dg, `{ q(func: eq(token, "t1")) { name token session { name } } }`,
+		`{"q":[{"name":"a","token":"t1","session":[{"name":"b"}]}]}`)
+
+	// Once expired, the values are left out of the results, including the ones found through
+	// the indexes and the reverse edges.
+	time.Sleep(3 * time.Second)
+	checkQuery(t, dg, `{ q(func: eq(token, "t1")) { name } }`, `{"q":[]}`)
+	checkQuery(t, dg, `{ q(func: eq(name, "a")) { name token session { name } } }`,
+		`{"q":[{"name":"a"}]}`)
+	checkQuery(t, dg, `{ q(func: eq(name, "b")) { name ~session { name } } }`, `{"q":[{"name":"b"}]}`)
+
+	// The counts leave them out too, before the expiry sweeper deletes them.
+	checkQuery(t, dg, `{ q(func: ge(count(session), 1)) { name } }`, `{"q":[]}`)
+	checkQuery(t, dg, `{ q(func: ge(count(~session), 1)) { name } }`, `{"q":[]}`)
+
+	// Values written again live again.
+	_, err = dg.NewTxn().Do(t.Context(), &api.Request{
+		Query:     `{ a as var(func: eq(name, "a")) }`,
+		Mutations: []*api.Mutation{{SetNquads: []byte(`uid(a) <token> "t2" .`)}},
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	checkQuery(t, dg, `{ q(func: eq(token, "t2")) { name token } }`,
+		`{"q":[{"name":"a","token":"t2"}]}`)
+	checkQuery(t, dg, `{ q(func: eq(token, "t1")) { name } }`, `{"q":[]}`)
+}
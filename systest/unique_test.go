@@ -860,3 +860,150 @@ func TestUniqueMultipleMutationsInSingleReq(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorContains(t, err, "could not insert duplicate value [example@email.com] for predicate [email]")
 }
+
+const tenantEmailSchema = `
+	tenant: string @index(exact) .
+	email: string @index(exact) .
+	type User @unique(tenant, email) {
+		tenant
+		email
+	}
+`
+
+func TestCompositeUnique(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(tenantEmailSchema))
+
+	user := func(tenant, email string) []byte {
+		return []byte(fmt.Sprintf(`
+			_:u <dgraph.type> "User" .
+			_:u <tenant> "%s" .
+			_:u <email> "%s" .`, tenant, email))
+	}
+	_, err := dg.Mutate(&api.Mutation{SetNquads: user("acme", "a@b.com"), CommitNow: true})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{SetNquads: user("other", "a@b.com"), CommitNow: true})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{SetNquads: user("acme", "a@b.com"), CommitNow: true})
+	require.ErrorContains(t, err, "could not insert duplicate values [acme, a@b.com] for predicates"+
+		" [tenant, email] of type [User]")
+
+	// Two nodes with the same values within a mutation.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "User" .
+			_:a <tenant> "new" .
+			_:a <email> "x@y.com" .
+			_:b <dgraph.type> "User" .
+			_:b <tenant> "new" .
+			_:b <email> "x@y.com" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "could not insert duplicate values [new, x@y.com]")
+}
+
+func TestCompositeUniqueUpdateAndDelete(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(tenantEmailSchema))
+
+	resp, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:u <dgraph.type> "User" .
+			_:u <tenant> "acme" .
+			_:u <email> "a@b.com" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	uid := resp.Uids["u"]
+
+	// Changing one of the fields frees the previous combined values.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<` + uid + `> <email> "c@d.com" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:u <dgraph.type> "User" .
+			_:u <tenant> "acme" .
+			_:u <email> "a@b.com" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// Deleting the node frees its combined values too.
+	_, err = dg.Mutate(&api.Mutation{DelNquads: []byte(`<` + uid + `> * * .`), CommitNow: true})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:u <dgraph.type> "User" .
+			_:u <tenant> "acme" .
+			_:u <email> "c@d.com" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestCompositeUniqueConcurrentTxns(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(tenantEmailSchema))
+
+	ctx := context.Background()
+	mu := &api.Mutation{SetNquads: []byte(`
+		_:u <dgraph.type> "User" .
+		_:u <tenant> "acme" .
+		_:u <email> "a@b.com" .`)}
+	txn1, txn2 := dg.NewTxn(), dg.NewTxn()
+	_, err := txn1.Mutate(ctx, mu)
+	require.NoError(t, err)
+	_, err = txn2.Mutate(ctx, mu)
+	require.NoError(t, err)
+	require.NoError(t, txn1.Commit(ctx))
+	require.Error(t, txn2.Commit(ctx))
+}
+
+func TestCompositeUniqueExistingData(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		tenant: string @index(exact) .
+		email: string @index(exact) .
+		type User {
+			tenant
+			email
+		}
+	`))
+
+	resp, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:a <dgraph.type> "User" .
+			_:a <tenant> "acme" .
+			_:a <email> "a@b.com" .
+			_:b <dgraph.type> "User" .
+			_:b <tenant> "acme" .
+			_:b <email> "a@b.com" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// The key can't be declared while the existing nodes share their combined values.
+	err = dg.SetupSchema(tenantEmailSchema)
+	require.ErrorContains(t, err, "there are duplicates [acme, a@b.com] in existing data for"+
+		" predicates [tenant, email] of type [User]")
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<` + resp.Uids["b"] + `> <email> "c@d.com" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, dg.SetupSchema(tenantEmailSchema))
+
+	// The nodes written before the key was declared are covered by it.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`
+			_:u <dgraph.type> "User" .
+			_:u <tenant> "acme" .
+			_:u <email> "c@d.com" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "could not insert duplicate values [acme, c@d.com]")
+}
@@ -36,6 +36,11 @@ const (
 	searchTime           = "vector_search_time"
 	VecEntry             = "__vector_entry"
 	VecDead              = "__vector_dead"
+	// VecChunk and VecChunkOwner suffix the predicates that hold the vectors
+	// of a list of vectors predicate and the uid of the node owning each of
+	// them respectively.
+	VecChunk             = "__vector_chunk_"
+	VecChunkOwner        = "__vector_owner_"
 	VectorIndexMaxLevels = 5
 	EfConstruction       = 16
 	EfSearch             = 12
@@ -8,6 +8,7 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -254,10 +255,59 @@ func (ph *persistentHNSW[T]) Search(ctx context.Context, c index.CacheType, quer
 	return r.Neighbors, err
 }
 
+// SearchWithOptions allows persistentHNSW to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchWithOptions for more info.
+func (ph *persistentHNSW[T]) SearchWithOptions(ctx context.Context, c index.CacheType, query []T,
+	maxResults int, filter index.SearchFilter[T], opts index.SearchOptions) (nnUids []uint64, err error) {
+	r, err := ph.searchWithPath(ctx, c, query, maxResults, filter, opts)
+	return r.Neighbors, err
+}
+
+// searchEf returns the size of the candidate list to use on the upper
+// layers and on the last layer of the graph, respectively. Unless ef is
+// overridden in opts, the last layer is searched with maxResults candidates.
+func (ph *persistentHNSW[T]) searchEf(maxResults int, opts index.SearchOptions) (int, int) {
+	if opts.Ef <= 0 {
+		return ph.efSearch, maxResults
+	}
+	return opts.Ef, max(opts.Ef, maxResults)
+}
+
+// SimilarityScores allows persistentHNSW to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SimilarityScores for more info.
+func (ph *persistentHNSW[T]) SimilarityScores(_ context.Context, c index.CacheType, query []T,
+	uids []uint64) ([]float64, error) {
+	scores := make([]float64, len(uids))
+	var vec []T
+	for i, uid := range uids {
+		if err := ph.getVecFromUid(uid, c, &vec); err != nil || len(vec) == 0 {
+			scores[i] = math.Inf(-1)
+			continue
+		}
+		score, err := ph.simType.distanceScore(vec, query, ph.floatBits)
+		if err != nil {
+			return nil, err
+		}
+		scores[i] = float64(score)
+		if ph.simType.indexType == Euclidean {
+			scores[i] = -scores[i]
+		}
+	}
+	return scores, nil
+}
+
 // SearchWithUid searches the hnsw graph for the nearest neighbors of the query uid
 // and returns the traversal path and the nearest neighbors
-func (ph *persistentHNSW[T]) SearchWithUid(_ context.Context, c index.CacheType, queryUid uint64,
+func (ph *persistentHNSW[T]) SearchWithUid(ctx context.Context, c index.CacheType, queryUid uint64,
 	maxResults int, filter index.SearchFilter[T]) (nnUids []uint64, err error) {
+	return ph.SearchWithUidAndOptions(ctx, c, queryUid, maxResults, filter, index.SearchOptions{})
+}
+
+// SearchWithUidAndOptions allows persistentHNSW to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchWithUidAndOptions for more info.
+func (ph *persistentHNSW[T]) SearchWithUidAndOptions(_ context.Context, c index.CacheType,
+	queryUid uint64, maxResults int, filter index.SearchFilter[T],
+	opts index.SearchOptions) (nnUids []uint64, err error) {
 	var queryVec []T
 	err = ph.getVecFromUid(queryUid, c, &queryVec)
 	if err != nil {
@@ -279,10 +329,14 @@ func (ph *persistentHNSW[T]) SearchWithUid(_ context.Context, c index.CacheType,
 	// for the best entry node to the last layer since we already know the
 	// best entry node (since it already exists in the lowest level), we
 	// can just search the last layer and return the results.
+	_, lastLayerEf := ph.searchEf(maxResults, opts)
 	r, err := ph.searchPersistentLayer(
 		c, ph.maxLevels-1, queryUid, queryVec, queryVec,
-		shouldFilterOutQueryVec, maxResults, filter)
+		shouldFilterOutQueryVec, lastLayerEf, filter)
 	for _, n := range r.neighbors {
+		if len(nnUids) == maxResults {
+			break
+		}
 		nnUids = append(nnUids, n.index)
 	}
 	return nnUids, err
@@ -343,7 +397,18 @@ func (ph *persistentHNSW[T]) SearchWithPath(
 	query []T,
 	maxResults int,
 	filter index.SearchFilter[T]) (r *index.SearchPathResult, err error) {
+	return ph.searchWithPath(ctx, c, query, maxResults, filter, index.SearchOptions{})
+}
+
+func (ph *persistentHNSW[T]) searchWithPath(
+	ctx context.Context,
+	c index.CacheType,
+	query []T,
+	maxResults int,
+	filter index.SearchFilter[T],
+	opts index.SearchOptions) (r *index.SearchPathResult, err error) {
 	start := time.Now().UnixMilli()
+	efSearch, lastLayerEf := ph.searchEf(maxResults, opts)
 	r = index.NewSearchPathResult()
 
 	// 0-profile_vector_entry
@@ -361,7 +426,7 @@ func (ph *persistentHNSW[T]) SearchWithPath(
 		}
 		filterOut := !filter(query, startVec, entry)
 		layerResult, err := ph.searchPersistentLayer(
-			c, level, entry, startVec, query, filterOut, ph.efSearch, filter)
+			c, level, entry, startVec, query, filterOut, efSearch, filter)
 		if err != nil {
 			return ph.emptyFinalResultWithError(err)
 		}
@@ -376,13 +441,13 @@ func (ph *persistentHNSW[T]) SearchWithPath(
 	}
 	filterOut := !filter(query, startVec, entry)
 	layerResult, err := ph.searchPersistentLayer(
-		c, ph.maxLevels-1, entry, startVec, query, filterOut, maxResults, filter)
+		c, ph.maxLevels-1, entry, startVec, query, filterOut, lastLayerEf, filter)
 	if err != nil {
 		return ph.emptyFinalResultWithError(err)
 	}
 	layerResult.updateFinalMetrics(r)
 	layerResult.updateFinalPath(r)
-	layerResult.addFinalNeighbors(r)
+	layerResult.addFinalNeighbors(r, maxResults)
 	t := time.Now().UnixMilli()
 	elapsed := t - start
 	r.Metrics[searchTime] = uint64(elapsed)
@@ -549,3 +549,27 @@ func TestBasicSearchPersistentFlatStorage(t *testing.T) {
 		}
 	}
 }
+
+func TestSearchWithOptionsPersistentFlatStorage(t *testing.T) {
+	for _, flatPh := range flatPhs {
+		emptyTsDbs()
+		err := flatPopulateInserts(flatPopulateBasicInsertsForSearch, flatPh)
+		if err != nil {
+			t.Errorf("Error populating inserts: %s", err)
+			return
+		}
+		for _, test := range searchPersistentFlatStorageTests {
+			for _, ef := range []int{0, 1, 64} {
+				nns, err := flatPh.SearchWithOptions(context.TODO(), test.qc, test.query,
+					test.maxResults, index.AcceptAll[float64], index.SearchOptions{Ef: ef})
+				if err != test.expectedErr {
+					t.Errorf("Output %q not equal to expected %q", err, test.expectedErr)
+				}
+				if !equalUint64Slice(nns, test.expectedNns) {
+					t.Errorf("Nearest neighbors with ef %d expected value: %v, Got: %v",
+						ef, test.expectedNns, nns)
+				}
+			}
+		}
+	}
+}
@@ -113,8 +113,11 @@ func (slr *searchLayerResult[T]) updateFinalPath(r *index.SearchPathResult) {
 	r.Path = append(r.Path, slr.path...)
 }
 
-func (slr *searchLayerResult[T]) addFinalNeighbors(r *index.SearchPathResult) {
+func (slr *searchLayerResult[T]) addFinalNeighbors(r *index.SearchPathResult, maxResults int) {
 	for _, n := range slr.neighbors {
+		if len(r.Neighbors) == maxResults {
+			break
+		}
 		if !n.filteredOut {
 			r.Neighbors = append(r.Neighbors, n.index)
 		}
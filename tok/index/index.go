@@ -87,6 +87,46 @@ type OptionalIndexSupport[T c.Float] interface {
 		query []T,
 		maxResults int,
 		filter SearchFilter[T]) (*SearchPathResult, error)
+
+	// SearchWithOptions(ctx, c, query, maxResults, filter, opts) is similar
+	// to Search(ctx, c, query, maxResults, filter), but allows the caller to
+	// override search-time parameters fixed at index creation time for this
+	// query only. See SearchOptions for the parameters that can be changed.
+	SearchWithOptions(
+		ctx context.Context,
+		c CacheType,
+		query []T,
+		maxResults int,
+		filter SearchFilter[T],
+		opts SearchOptions) ([]uint64, error)
+
+	// SearchWithUidAndOptions(ctx, c, queryUid, maxResults, filter, opts) is
+	// the SearchWithUid counterpart of SearchWithOptions.
+	SearchWithUidAndOptions(
+		ctx context.Context,
+		c CacheType,
+		queryUid uint64,
+		maxResults int,
+		filter SearchFilter[T],
+		opts SearchOptions) ([]uint64, error)
+	// SimilarityScores(ctx, c, query, uids) returns the score of the vector
+	// of each of uids against query, using the metric of the index. Higher
+	// scores always mean more similar vectors, so distances are negated.
+	// A uid without a vector gets a score of negative infinity.
+	SimilarityScores(
+		ctx context.Context,
+		c CacheType,
+		query []T,
+		uids []uint64) ([]float64, error)
+}
+
+// SearchOptions holds the search-time parameters that can be overridden
+// for a single query. The zero value of a field means that the value the
+// index was created with is used.
+type SearchOptions struct {
+	// Ef is the size of the dynamic candidate list used while searching.
+	// Larger values trade latency for better recall.
+	Ef int
 }
 
 // A VectorIndex can be used to Search for vectors and add vectors to an index.
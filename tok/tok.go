@@ -8,12 +8,14 @@ package tok
 import (
 	"encoding/binary"
 	"math/big"
+	"net/netip"
 	"plugin"
 	"strings"
 	"time"
 
 	"github.com/blevesearch/bleve/v2/analysis"
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/twpayne/go-geom"
 	"golang.org/x/crypto/blake2b"
@@ -50,6 +52,11 @@ const (
 	IdentBigFloat  = 0xD
 	IdentVFloat    = 0xE
 	IdentNGram     = 0xF
+	IdentFacet     = 0x10 // Prefix of the tokens of facet indexes.
+	IdentDuration  = 0x11
+	IdentUUID      = 0x12
+	IdentIP        = 0x13
+	IdentJSONPath  = 0x14
 	IdentCustom    = 0x80
 	IdentDelimiter = 0x1f // ASCII 31 - Unit separator
 )
@@ -102,6 +109,10 @@ func init() {
 	registerTokenizer(FullTextTokenizer{})
 	registerTokenizer(NGramTokenizer{})
 	registerTokenizer(Sha256Tokenizer{})
+	registerTokenizer(DurationTokenizer{})
+	registerTokenizer(UUIDTokenizer{})
+	registerTokenizer(IPTokenizer{})
+	registerTokenizer(JSONPathTokenizer{})
 	setupBleve()
 }
 
@@ -610,6 +621,57 @@ func (t BoolTokenizer) Identifier() byte { return IdentBool }
 func (t BoolTokenizer) IsSortable() bool { return false }
 func (t BoolTokenizer) IsLossy() bool    { return false }
 
+// DurationTokenizer generates tokens from duration data.
+type DurationTokenizer struct{}
+
+func (t DurationTokenizer) Name() string { return "duration" }
+func (t DurationTokenizer) Type() string { return "duration" }
+func (t DurationTokenizer) Tokens(v interface{}) ([]string, error) {
+	return []string{encodeInt(int64(v.(time.Duration)))}, nil
+}
+func (t DurationTokenizer) Identifier() byte { return IdentDuration }
+func (t DurationTokenizer) IsSortable() bool { return true }
+func (t DurationTokenizer) IsLossy() bool    { return false }
+
+// UUIDTokenizer generates tokens from UUID data. The tokens are the 16 bytes of the UUIDs.
+type UUIDTokenizer struct{}
+
+func (t UUIDTokenizer) Name() string { return "uuid" }
+func (t UUIDTokenizer) Type() string { return "uuid" }
+func (t UUIDTokenizer) Tokens(v interface{}) ([]string, error) {
+	u := v.(uuid.UUID)
+	return []string{string(u[:])}, nil
+}
+func (t UUIDTokenizer) Identifier() byte { return IdentUUID }
+func (t UUIDTokenizer) IsSortable() bool { return true }
+func (t UUIDTokenizer) IsLossy() bool    { return false }
+
+// IPTokenizer generates tokens from IP addresses and networks. The tokens are ordered like the
+// values, so the networks within a network are found by iterating over a range of tokens.
+type IPTokenizer struct{}
+
+func (t IPTokenizer) Name() string { return "ip" }
+func (t IPTokenizer) Type() string { return "ip" }
+func (t IPTokenizer) Tokens(v interface{}) ([]string, error) {
+	return []string{string(types.IPToBinary(v.(netip.Prefix)))}, nil
+}
+func (t IPTokenizer) Identifier() byte { return IdentIP }
+func (t IPTokenizer) IsSortable() bool { return true }
+func (t IPTokenizer) IsLossy() bool    { return false }
+
+// JSONPathTokenizer generates tokens from JSON documents, for the paths of the documents and the
+// scalar values at those paths.
+type JSONPathTokenizer struct{}
+
+func (t JSONPathTokenizer) Name() string { return "jsonpath" }
+func (t JSONPathTokenizer) Type() string { return "json" }
+func (t JSONPathTokenizer) Tokens(v interface{}) ([]string, error) {
+	return types.JSONPathTokens(v.(string))
+}
+func (t JSONPathTokenizer) Identifier() byte { return IdentJSONPath }
+func (t JSONPathTokenizer) IsSortable() bool { return false }
+func (t JSONPathTokenizer) IsLossy() bool    { return true }
+
 // TrigramTokenizer returns trigram tokens from string data.
 type TrigramTokenizer struct{}
 
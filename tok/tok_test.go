@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/types"
 )
 
 type encL struct {
@@ -127,6 +129,31 @@ func TestDateTimeTokenizer(t *testing.T) {
 	require.Equal(t, 1+2, len(tokens[0]))
 }
 
+func TestDurationAndIPTokenizers(t *testing.T) {
+	// The tokens are ordered like the values, so that they can be used for range queries and
+	// sorting.
+	for name, values := range map[string][]string{
+		"duration": {"-2h", "-1s", "0s", "1ms", "1s", "1h"},
+		"ip":       {"0.0.0.0/0", "9.255.255.255", "10.0.0.0/8", "10.0.0.1", "::1", "2001:db8::/32"},
+	} {
+		tokenizer, has := GetTokenizer(name)
+		require.True(t, has)
+		tid, ok := types.TypeForName(tokenizer.Type())
+		require.True(t, ok)
+
+		var prev string
+		for _, s := range values {
+			v, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(s)}, tid)
+			require.NoError(t, err)
+			tokens, err := BuildTokens(v.Value, tokenizer)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(tokens))
+			require.Less(t, prev, tokens[0], s)
+			prev = tokens[0]
+		}
+	}
+}
+
 func TestFullTextTokenizerLang(t *testing.T) {
 	tokenizer, has := GetTokenizer("fulltext")
 	require.True(t, has)
@@ -11,11 +11,13 @@ import (
 	"encoding/json"
 	"math"
 	"math/big"
+	"net/netip"
 	"strconv"
 	"strings"
 	"time"
 	"unsafe"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	geom "github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/geojson"
@@ -162,6 +164,25 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, errors.Errorf("invalid data for vector of floats: %v", data)
 				}
 				*res = BytesAsFloatArray(data)
+			case DurationID:
+				if len(data) < 8 {
+					return to, errors.Errorf("invalid data for duration %v", data)
+				}
+				*res = time.Duration(binary.LittleEndian.Uint64(data))
+			case UUIDID:
+				u, err := uuid.FromBytes(data)
+				if err != nil {
+					return to, err
+				}
+				*res = u
+			case IPID:
+				p, err := IPFromBinary(data)
+				if err != nil {
+					return to, err
+				}
+				*res = p
+			case JSONID:
+				*res = string(data)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -228,6 +249,30 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, err
 				}
 				*res = vf
+			case DurationID:
+				d, err := ParseDuration(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = d
+			case UUIDID:
+				u, err := uuid.Parse(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = u
+			case IPID:
+				p, err := ParseIP(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = p
+			case JSONID:
+				doc, err := ParseJSONDocument(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = doc
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -259,6 +304,14 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = time.Unix(vc, 0).UTC()
 			case VFloatID:
 				*res = []float32{float32(vc)}
+			case DurationID:
+				// Like for datetimes, ints are taken as a number of seconds.
+				if vc > math.MaxInt64/nanoSecondsInSec || vc < math.MinInt64/nanoSecondsInSec {
+					return to, errors.Errorf("Int out of duration range")
+				}
+				*res = time.Duration(vc) * time.Second
+			case JSONID:
+				*res = strconv.FormatInt(vc, 10)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -334,6 +387,17 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = time.Unix(secs, nsecs).UTC()
 			case VFloatID:
 				*res = []float32{float32(vc)}
+			case DurationID:
+				ns := vc * nanoSecondsInSec
+				if ns > math.MaxInt64 || ns < math.MinInt64 || math.IsNaN(ns) {
+					return to, errors.Errorf("Float out of duration range")
+				}
+				*res = time.Duration(ns)
+			case JSONID:
+				if math.IsInf(vc, 0) || math.IsNaN(vc) {
+					return to, errors.Errorf("Float %v can't be a JSON value", vc)
+				}
+				*res = strconv.FormatFloat(vc, 'g', -1, 64)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -376,7 +440,7 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				} else {
 					*res = big.NewFloat(0).SetPrec(BigFloatPrecision)
 				}
-			case StringID, DefaultID:
+			case StringID, DefaultID, JSONID:
 				*res = strconv.FormatBool(vc)
 			default:
 				return to, cantConvert(fromID, toID)
@@ -472,6 +536,75 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				return to, cantConvert(fromID, toID)
 			}
 		}
+	case DurationID:
+		{
+			if len(data) < 8 {
+				return to, errors.Errorf("invalid data for duration %v", data)
+			}
+			vc := time.Duration(binary.LittleEndian.Uint64(data))
+			switch toID {
+			case DurationID:
+				*res = vc
+			case BinaryID:
+				var bs [8]byte
+				binary.LittleEndian.PutUint64(bs[:], uint64(vc))
+				*res = bs[:]
+			case StringID, DefaultID:
+				*res = vc.String()
+			case IntID:
+				*res = int64(vc / time.Second)
+			case FloatID:
+				*res = vc.Seconds()
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case UUIDID:
+		{
+			vc, err := uuid.FromBytes(data)
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case UUIDID:
+				*res = vc
+			case BinaryID:
+				*res = vc[:]
+			case StringID, DefaultID:
+				*res = vc.String()
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case IPID:
+		{
+			vc, err := IPFromBinary(data)
+			if err != nil {
+				return to, err
+			}
+			switch toID {
+			case IPID:
+				*res = vc
+			case BinaryID:
+				*res = IPToBinary(vc)
+			case StringID, DefaultID:
+				*res = IPString(vc)
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case JSONID:
+		{
+			vc := string(data)
+			switch toID {
+			case JSONID, StringID, DefaultID:
+				*res = vc
+			case BinaryID:
+				*res = []byte(vc)
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
 	default:
 		return to, cantConvert(fromID, toID)
 	}
@@ -627,12 +760,106 @@ func Marshal(from Val, to *Val) error {
 		default:
 			return cantConvert(fromID, toID)
 		}
+	case DurationID:
+		vc := val.(time.Duration)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc.String()
+		case BinaryID:
+			var bs [8]byte
+			binary.LittleEndian.PutUint64(bs[:], uint64(vc))
+			*res = bs[:]
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case UUIDID:
+		vc := val.(uuid.UUID)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc.String()
+		case BinaryID:
+			*res = vc[:]
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case IPID:
+		vc := val.(netip.Prefix)
+		switch toID {
+		case StringID, DefaultID:
+			*res = IPString(vc)
+		case BinaryID:
+			*res = IPToBinary(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case JSONID:
+		vc := val.(string)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc
+		case BinaryID:
+			*res = []byte(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
 	default:
 		return cantConvert(fromID, toID)
 	}
 	return nil
 }
 
+// ValFrom converts the api.Value into a Val of the matching type.
+func ValFrom(val *api.Value) Val {
+	switch val.Val.(type) {
+	case *api.Value_BytesVal:
+		return Val{Tid: BinaryID, Value: val.GetBytesVal()}
+	case *api.Value_IntVal:
+		return Val{Tid: IntID, Value: val.GetIntVal()}
+	case *api.Value_StrVal:
+		return Val{Tid: StringID, Value: val.GetStrVal()}
+	case *api.Value_BoolVal:
+		return Val{Tid: BoolID, Value: val.GetBoolVal()}
+	case *api.Value_DoubleVal:
+		return Val{Tid: FloatID, Value: val.GetDoubleVal()}
+	case *api.Value_GeoVal:
+		return Val{Tid: GeoID, Value: val.GetGeoVal()}
+	case *api.Value_BigfloatVal:
+		return Val{Tid: BigFloatID, Value: val.GetBigfloatVal()}
+	case *api.Value_DatetimeVal:
+		return Val{Tid: DateTimeID, Value: val.GetDatetimeVal()}
+	case *api.Value_PasswordVal:
+		return Val{Tid: PasswordID, Value: val.GetPasswordVal()}
+	case *api.Value_Vfloat32Val:
+		return Val{
+			Tid:   VFloatID,
+			Value: BytesAsFloatArray(val.GetVfloat32Val()),
+		}
+	case *api.Value_DefaultVal:
+		return Val{Tid: DefaultID, Value: val.GetDefaultVal()}
+	}
+
+	return Val{Tid: StringID, Value: ""}
+}
+
+// StorageValue converts the api.Value into the binary form it is stored in. The type of the
+// returned Val is the one of the api.Value.
+func StorageValue(val *api.Value) (Val, error) {
+	// We infer object type from type of value. We set appropriate type in parse
+	// function or the Go client has already set.
+	p := ValFrom(val)
+	// These three would have already been marshalled to bytes by the client or
+	// in parse function.
+	if p.Tid == GeoID || p.Tid == DateTimeID || p.Tid == BigFloatID {
+		return p, nil
+	}
+
+	p1 := ValueForType(BinaryID)
+	if err := Marshal(p, &p1); err != nil {
+		return Val{Tid: p.Tid, Value: []byte{}}, err
+	}
+	return Val{Tid: p.Tid, Value: p1.Value}, nil
+}
+
 // ObjectValue converts into api.Value.
 func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 	def := &api.Value{Val: &api.Value_StrVal{StrVal: ""}}
@@ -707,6 +934,14 @@ func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 			return def, err
 		}
 		return &api.Value{Val: &api.Value_Vfloat32Val{Vfloat32Val: vf}}, nil
+	// There are no values of these types in the N-Quad, they are sent as strings and converted
+	// using the schema.
+	case DurationID, UUIDID, IPID, JSONID:
+		str := ValueForType(StringID)
+		if err := Marshal(Val{id, value}, &str); err != nil {
+			return def, err
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: str.Value.(string)}}, nil
 	default:
 		return def, errors.Errorf("ObjectValue not available for: %v", id)
 	}
@@ -744,6 +979,15 @@ func (v Val) MarshalJSON() ([]byte, error) {
 		return json.Marshal(v.Safe().(string))
 	case PasswordID:
 		return json.Marshal(v.Value.(string))
+	case DurationID:
+		return json.Marshal(v.Value.(time.Duration).String())
+	case UUIDID:
+		return json.Marshal(v.Value.(uuid.UUID).String())
+	case IPID:
+		return json.Marshal(IPString(v.Value.(netip.Prefix)))
+	case JSONID:
+		// The documents are validated when they are stored.
+		return []byte(v.Value.(string)), nil
 	}
 	return nil, errors.Errorf("invalid type for MarshalJSON: %v", v.Tid)
 }
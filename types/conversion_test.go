@@ -844,3 +844,61 @@ func TestConvertToString(t *testing.T) {
 		require.EqualValues(t, Val{Tid: StringID, Value: tc.out}, out)
 	}
 }
+
+func TestConvertDurationUUIDIP(t *testing.T) {
+	tests := []struct {
+		in  string
+		tid TypeID
+		out string
+	}{
+		{in: "PT1H30M", tid: DurationID, out: "1h30m0s"},
+		{in: "-250ms", tid: DurationID, out: "-250ms"},
+		{in: "6F9619FF-8B86-D011-B42D-00C04FC964FF", tid: UUIDID,
+			out: "6f9619ff-8b86-d011-b42d-00c04fc964ff"},
+		{in: "10.1.2.3", tid: IPID, out: "10.1.2.3"},
+		{in: "10.1.2.3/8", tid: IPID, out: "10.0.0.0/8"},
+		{in: "2001:DB8::1", tid: IPID, out: "2001:db8::1"},
+		{in: "::ffff:10.1.2.3", tid: IPID, out: "::ffff:10.1.2.3"},
+		{in: "2001:db8::/32", tid: IPID, out: "2001:db8::/32"},
+	}
+	for _, tc := range tests {
+		v, err := Convert(Val{Tid: StringID, Value: []byte(tc.in)}, tc.tid)
+		require.NoError(t, err, tc.in)
+
+		// The values are stored as binary and read back as the same values.
+		b := ValueForType(BinaryID)
+		require.NoError(t, Marshal(v, &b))
+		out, err := Convert(Val{Tid: tc.tid, Value: b.Value}, tc.tid)
+		require.NoError(t, err, tc.in)
+		require.Equal(t, v, out, tc.in)
+
+		str, err := Convert(Val{Tid: tc.tid, Value: b.Value}, StringID)
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.out, str.Value, tc.in)
+	}
+
+	for _, tc := range []struct {
+		in  string
+		tid TypeID
+	}{
+		{in: "10", tid: DurationID},
+		{in: "not-a-uuid", tid: UUIDID},
+		{in: "10.1.2", tid: IPID},
+		{in: "10.1.2.3/33", tid: IPID},
+		{in: "fe80::1%eth0", tid: IPID},
+	} {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(tc.in)}, tc.tid)
+		require.Error(t, err, tc.in)
+	}
+
+	// Ints and floats are taken as a number of seconds.
+	d, err := Convert(Val{Tid: IntID, Value: bs(int64(90))}, DurationID)
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Second, d.Value)
+	d, err = Convert(Val{Tid: FloatID, Value: bs(1.5)}, DurationID)
+	require.NoError(t, err)
+	require.Equal(t, 1500*time.Millisecond, d.Value)
+	s, err := Convert(Val{Tid: DurationID, Value: bs(int64(1500 * time.Millisecond))}, FloatID)
+	require.NoError(t, err)
+	require.Equal(t, 1.5, s.Value)
+}
@@ -7,6 +7,7 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"strconv"
 	"strings"
 
@@ -31,19 +32,28 @@ const (
 	QueryTypeIntersects
 	// QueryTypeNear finds all points that are within the given distance from the given point.
 	QueryTypeNear
+	// QueryTypeNearest finds the given number of objects closest to the given point.
+	QueryTypeNearest
 )
 
+// nearestMaxRadius is the largest distance in metres from the query point at which nearest
+// looks for objects, a quarter of the circumference of the earth.
+const nearestMaxRadius = EarthRadiusMeters * math.Pi / 2
+
 // GeoQueryData is pb.data used by the geo query filter to additionally filter the geometries.
 type GeoQueryData struct {
 	pt    *s2.Point  // If not nil, the input data was a point
 	loops []*s2.Loop // If not empty, the input data was a polygon/multipolygon or it was a near query.
+	// If not empty, the input data was a linestring/multilinestring.
+	lines []*s2.Polyline
 	qtype QueryType
+	k     int // The number of objects asked for by a nearest query.
 }
 
 // IsGeoFunc returns if a function is of geo type.
 func IsGeoFunc(str string) bool {
 	switch str {
-	case "near", "contains", "within", "intersects":
+	case "near", "nearest", "contains", "within", "intersects":
 		return true
 	}
 
@@ -73,6 +83,30 @@ func GetGeoTokens(srcFunc *pb.SrcFunction) ([]string, *GeoQueryData, error) {
 			return nil, nil, err
 		}
 		return queryTokensGeo(QueryTypeNear, g, maxDist)
+	case "nearest":
+		if len(srcFunc.Args) != 2 {
+			return nil, nil, errors.Errorf("nearest function requires 2 arguments, but got %d",
+				len(srcFunc.Args))
+		}
+		k, err := strconv.Atoi(srcFunc.Args[1])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "Error while converting count to int")
+		}
+		if k <= 0 {
+			return nil, nil, errors.Errorf("Count should be positive for nearest function")
+		}
+		g, err := convertToGeom(srcFunc.Args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		p, ok := g.(*geom.Point)
+		if !ok {
+			return nil, nil, errors.Errorf("Require a point for nearest query")
+		}
+		pt := pointFromPoint(p)
+		// The index is looked up by NearTokens with a growing radius, so there are no tokens
+		// to start with.
+		return []string{}, &GeoQueryData{pt: &pt, qtype: QueryTypeNearest, k: k}, nil
 	case "within":
 		if len(srcFunc.Args) != 1 {
 			return nil, nil, errors.Errorf("within function requires 1 arguments, but got %d",
@@ -110,10 +144,12 @@ func GetGeoTokens(srcFunc *pb.SrcFunction) ([]string, *GeoQueryData, error) {
 
 // queryTokensGeo returns the tokens to be used to look up the geo index for a given filter.
 // qt is the type of Geo query - near/intersects/contains/within
-// g is the geom.T representation of the input. It could be a point/polygon/multipolygon.
+// g is the geom.T representation of the input. It could be a point/polygon/multipolygon or a
+// linestring/multilinestring.
 // maxDistance is distance in metres, only used for near query.
 func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *GeoQueryData, error) {
 	var loops []*s2.Loop
+	var lines []*s2.Polyline
 	var pt *s2.Point
 	var err error
 	switch v := g.(type) {
@@ -149,11 +185,28 @@ func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *Geo
 			loops = append(loops, l)
 		}
 
+	case *geom.LineString:
+		l, err := polylineFromLineString(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		lines = append(lines, l)
+
+	case *geom.MultiLineString:
+		for i := range v.NumLineStrings() {
+			l, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return nil, nil, err
+			}
+			lines = append(lines, l)
+		}
+
 	default:
 		return nil, nil, errors.Errorf("Cannot query using a geometry of type %T", v)
 	}
 
-	x.AssertTruef(len(loops) > 0 || pt != nil, "We should have a point or a loop.")
+	x.AssertTruef(len(loops) > 0 || len(lines) > 0 || pt != nil,
+		"We should have a point, a loop or a line.")
 
 	var cover, parents s2.CellUnion
 	if qt == QueryTypeNear {
@@ -182,7 +235,8 @@ func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *Geo
 	case QueryTypeContains:
 		// For a contains query, we only need to look at the objects whose cover matches our
 		// parents. So we take our parents and prefix with the coverPrefix to look in the index.
-		return createTokens(parents, coverPrefix), &GeoQueryData{pt: pt, loops: loops, qtype: qt}, nil
+		return createTokens(parents, coverPrefix),
+			&GeoQueryData{pt: pt, loops: loops, lines: lines, qtype: qt}, nil
 
 	case QueryTypeNear:
 		if pt == nil {
@@ -197,17 +251,51 @@ func queryTokensGeo(qt QueryType, g geom.T, maxDistance float64) ([]string, *Geo
 		// An intersects query is as the name suggests all the entities which intersect with the
 		// given region. So we look at all the objects whose parents match our cover as well as
 		// all the objects whose cover matches our parents.
-		if len(loops) == 0 {
-			return nil, nil, errors.Errorf("Require a polygon for intersects query")
+		if len(loops) == 0 && len(lines) == 0 {
+			return nil, nil, errors.Errorf("Require a polygon or a line for intersects query")
 		}
 		toks := parentCoverTokens(parents, cover)
-		return toks, &GeoQueryData{loops: loops, qtype: qt}, nil
+		return toks, &GeoQueryData{loops: loops, lines: lines, qtype: qt}, nil
 
 	default:
 		return nil, nil, errors.Errorf("Unknown query type")
 	}
 }
 
+// Nearest returns the number of objects asked for if q is a nearest query, and 0 otherwise.
+func (q *GeoQueryData) Nearest() int {
+	if q.qtype != QueryTypeNearest {
+		return 0
+	}
+	return q.k
+}
+
+// NearTokens returns the tokens to look up the geo index with for the objects within radius
+// metres of the point of a nearest query. The returned bool is false once radius goes past the
+// largest radius nearest looks at.
+func (q *GeoQueryData) NearTokens(radius float64) ([]string, bool, error) {
+	if q.pt == nil {
+		return nil, false, errors.Errorf("Require a point for nearest query")
+	}
+	radius = math.Min(radius, nearestMaxRadius)
+	l := s2.RegularLoop(*q.pt, EarthAngle(radius), 100)
+	cover := coverLoop(l, MinCellLevel, MaxCellLevel, MaxCells)
+	parents := getParentCells(cover, MinCellLevel)
+	return parentCoverTokens(parents, cover), radius < nearestMaxRadius, nil
+}
+
+// Distance returns the distance in metres from the point of the query to g.
+func (q *GeoQueryData) Distance(g geom.T) (Length, error) {
+	if q.pt == nil {
+		return 0, errors.Errorf("Require a point to compute a distance")
+	}
+	a, err := distanceToPoint(g, *q.pt)
+	if err != nil {
+		return 0, err
+	}
+	return EarthDistance(a), nil
+}
+
 // MatchesFilter applies the query filter to a geo value
 func (q GeoQueryData) MatchesFilter(g geom.T) bool {
 	switch q.qtype {
@@ -277,6 +365,35 @@ func (q GeoQueryData) isWithin(g geom.T) bool {
 			}
 			return true
 		}
+	case *geom.LineString:
+		l, err := polylineFromLineString(geometry)
+		if err != nil {
+			return false
+		}
+		return polylineWithinMultiloops(l, q.loops)
+	case *geom.MultiLineString:
+		// Each line in the multilinestring should be within some loop of q.loops.
+		if len(q.loops) > 0 {
+			for i := range geometry.NumLineStrings() {
+				l, err := polylineFromLineString(geometry.LineString(i))
+				if err != nil {
+					return false
+				}
+				if !polylineWithinMultiloops(l, q.loops) {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func polylineWithinMultiloops(p *s2.Polyline, loops []*s2.Loop) bool {
+	for _, l := range loops {
+		if loopContainsPolyline(l, p) {
+			return true
+		}
 	}
 	return false
 }
@@ -298,7 +415,8 @@ func multiPolygonContainsLoop(g *geom.MultiPolygon, l *s2.Loop) bool {
 // returns true if the geometry represented by g contains the given point/polygon.
 // g is the geom.T representation of the value which is the stored in the DB.
 func (q GeoQueryData) contains(g geom.T) bool {
-	x.AssertTruef(q.pt != nil || len(q.loops) > 0, "At least a point or loop should be defined.")
+	x.AssertTruef(q.pt != nil || len(q.loops) > 0 || len(q.lines) > 0,
+		"At least a point, loop or line should be defined.")
 	switch v := g.(type) {
 	case *geom.Polygon:
 		if q.pt != nil {
@@ -310,6 +428,12 @@ func (q GeoQueryData) contains(g geom.T) bool {
 			return false
 		}
 
+		for _, l := range q.lines {
+			if !loopContainsPolyline(s2loop, l) {
+				return false
+			}
+		}
+
 		// Input could be a multipolygon, in which q.loops would have more than 1 loop. Each loop
 		// in the query should be part of the s2loop.
 		for _, l := range q.loops {
@@ -329,13 +453,18 @@ func (q GeoQueryData) contains(g geom.T) bool {
 			return false
 		}
 
-		if len(q.loops) > 0 {
-			// All the loops that are part of the query should be part of some loop of v.
+		if len(q.loops) > 0 || len(q.lines) > 0 {
+			// All the loops and lines that are part of the query should be part of some loop of v.
 			for _, l := range q.loops {
 				if !multiPolygonContainsLoop(v, l) {
 					return false
 				}
 			}
+			for _, l := range q.lines {
+				if !multiPolygonContainsPolyline(v, l) {
+					return false
+				}
+			}
 			return true
 		}
 
@@ -346,6 +475,19 @@ func (q GeoQueryData) contains(g geom.T) bool {
 	}
 }
 
+func multiPolygonContainsPolyline(g *geom.MultiPolygon, p *s2.Polyline) bool {
+	for i := range g.NumPolygons() {
+		s2loop, err := loopFromPolygon(g.Polygon(i))
+		if err != nil {
+			return false
+		}
+		if loopContainsPolyline(s2loop, p) {
+			return true
+		}
+	}
+	return false
+}
+
 func polygonContainsCoord(v *geom.Polygon, pt *s2.Point) bool {
 	for i := range v.NumLinearRings() {
 		r := v.LinearRing(i)
@@ -359,9 +501,10 @@ func polygonContainsCoord(v *geom.Polygon, pt *s2.Point) bool {
 	return false
 }
 
-// returns true if the geometry represented by uid/attr intersects the given loop or point
+// returns true if the geometry represented by uid/attr intersects the given loops or lines
 func (q GeoQueryData) intersects(g geom.T) bool {
-	x.AssertTruef(len(q.loops) > 0, "Loop should be defined for intersects.")
+	x.AssertTruef(len(q.loops) > 0 || len(q.lines) > 0,
+		"Loop or line should be defined for intersects.")
 	switch v := g.(type) {
 	case *geom.Point:
 		p := pointFromPoint(v)
@@ -371,6 +514,11 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 				return true
 			}
 		}
+		for _, l := range q.lines {
+			if proj, _ := l.Project(p); proj.ApproxEqual(p) {
+				return true
+			}
+		}
 		return false
 
 	case *geom.Polygon:
@@ -378,12 +526,7 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 		if err != nil {
 			return false
 		}
-		for _, loop := range q.loops {
-			if Intersects(l, loop) {
-				return true
-			}
-		}
-		return false
+		return q.intersectsLoop(l)
 	case *geom.MultiPolygon:
 		// We must compare all polygons in g with those in the query.
 		for i := range v.NumPolygons() {
@@ -391,10 +534,25 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 			if err != nil {
 				return false
 			}
-			for _, loop := range q.loops {
-				if Intersects(l, loop) {
-					return true
-				}
+			if q.intersectsLoop(l) {
+				return true
+			}
+		}
+		return false
+	case *geom.LineString:
+		l, err := polylineFromLineString(v)
+		if err != nil {
+			return false
+		}
+		return q.intersectsPolyline(l)
+	case *geom.MultiLineString:
+		for i := range v.NumLineStrings() {
+			l, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return false
+			}
+			if q.intersectsPolyline(l) {
+				return true
 			}
 		}
 		return false
@@ -404,6 +562,34 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 	}
 }
 
+func (q GeoQueryData) intersectsLoop(l *s2.Loop) bool {
+	for _, loop := range q.loops {
+		if Intersects(l, loop) {
+			return true
+		}
+	}
+	for _, line := range q.lines {
+		if polylineIntersectsLoop(l, line) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q GeoQueryData) intersectsPolyline(p *s2.Polyline) bool {
+	for _, loop := range q.loops {
+		if polylineIntersectsLoop(loop, p) {
+			return true
+		}
+	}
+	for _, line := range q.lines {
+		if p.Intersects(line) {
+			return true
+		}
+	}
+	return false
+}
+
 // MatchGeo matches values and GeoQueryData and ensures that the value actually
 // matches the query criteria.
 func MatchGeo(value *pb.TaskValue, q *GeoQueryData) bool {
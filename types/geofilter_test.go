@@ -15,6 +15,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/twpayne/go-geom"
 	"github.com/twpayne/go-geom/encoding/wkb"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 )
 
 func queryTokens(qt QueryType, data string, maxDistance float64) ([]string, *GeoQueryData, error) {
@@ -436,3 +438,182 @@ func BenchmarkMatchesFilterContainsPoint(b *testing.B) {
 		qd.contains(us)
 	}
 }
+
+func TestMatchesFilterIntersectsLineString(t *testing.T) {
+	p := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	data := formDataPolygon(t, p)
+	_, qd, err := queryTokens(QueryTypeIntersects, data, 0.0)
+	require.NoError(t, err)
+
+	// Line starting inside the polygon
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.5, 37.5}, {-121, 37.5}})
+	require.True(t, qd.MatchesFilter(l))
+
+	// Line crossing the polygon with all its points outside
+	l = geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-124, 37.5}, {-121, 37.5}})
+	require.True(t, qd.MatchesFilter(l))
+
+	// Line outside the polygon
+	l = geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-124, 36}, {-121, 36}})
+	require.False(t, qd.MatchesFilter(l))
+
+	// Multilinestring with one line crossing the polygon
+	ml := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-124, 36}, {-121, 36}},
+		{{-122.5, 39}, {-122.5, 36}},
+	})
+	require.True(t, qd.MatchesFilter(ml))
+}
+
+func TestMatchesFilterIntersectsWithLineString(t *testing.T) {
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-124, 37.5}, {-121, 37.5}})
+	data := formDataPolygon(t, l)
+	toks, qd, err := queryTokens(QueryTypeIntersects, data, 0.0)
+	require.NoError(t, err)
+	require.NotEmpty(t, toks)
+
+	// Polygon crossed by the line
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	require.True(t, qd.MatchesFilter(poly))
+
+	// Polygon away from the line
+	poly = geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 35}, {-123, 35}, {-123, 36}, {-122, 36}, {-122, 35}},
+	})
+	require.False(t, qd.MatchesFilter(poly))
+
+	// Crossing line
+	l2 := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.5, 39}, {-122.5, 36}})
+	require.True(t, qd.MatchesFilter(l2))
+
+	// Parallel line
+	l2 = geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-124, 38.5}, {-121, 38.5}})
+	require.False(t, qd.MatchesFilter(l2))
+
+	// A line can't be used for within queries.
+	_, _, err = queryTokens(QueryTypeWithin, data, 0.0)
+	require.Error(t, err)
+}
+
+func TestMatchesFilterWithinLineString(t *testing.T) {
+	p := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	data := formDataPolygon(t, p)
+	_, qd, err := queryTokens(QueryTypeWithin, data, 0.0)
+	require.NoError(t, err)
+
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.2, 37.2}, {-122.5, 37.5}, {-122.8, 37.2}})
+	require.True(t, qd.MatchesFilter(l))
+
+	l = geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.5, 37.5}, {-121, 37.5}})
+	require.False(t, qd.MatchesFilter(l))
+
+	ml := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.2, 37.2}, {-122.5, 37.5}},
+		{{-122.5, 37.5}, {-121, 37.5}},
+	})
+	require.False(t, qd.MatchesFilter(ml))
+}
+
+func TestMatchesFilterContainsLineString(t *testing.T) {
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.2, 37.2}, {-122.5, 37.5}, {-122.8, 37.2}})
+	data := formDataPolygon(t, l)
+	_, qd, err := queryTokens(QueryTypeContains, data, 0.0)
+	require.NoError(t, err)
+
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	require.True(t, qd.MatchesFilter(poly))
+
+	poly = geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-122.4, 37}, {-122.4, 38}, {-122, 38}, {-122, 37}},
+	})
+	require.False(t, qd.MatchesFilter(poly))
+}
+
+func TestGeoLength(t *testing.T) {
+	// One degree of latitude along a meridian.
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{0, 0}, {0, 0.5}, {0, 1}})
+	length, err := GeoLength(l)
+	require.NoError(t, err)
+	require.InDelta(t, 111195, float64(length), 1)
+
+	ml := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{0, 0}, {0, 1}},
+		{{10, 0}, {10, 1}},
+	})
+	length, err = GeoLength(ml)
+	require.NoError(t, err)
+	require.InDelta(t, 2*111195, float64(length), 2)
+
+	length, err = GeoLength(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 2}))
+	require.NoError(t, err)
+	require.Zero(t, length)
+}
+
+func TestGeoDistance(t *testing.T) {
+	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0})
+
+	// One degree of latitude along a meridian.
+	d, err := GeoDistance(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 1}), p)
+	require.NoError(t, err)
+	require.InDelta(t, 111195, float64(d), 1)
+
+	// The closest point of a line can be between its vertices.
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-1, 1}, {1, 1}})
+	d, err = GeoDistance(l, p)
+	require.NoError(t, err)
+	require.InDelta(t, 111195, float64(d), 50)
+
+	// A polygon containing the point is at distance 0, otherwise it's the distance to its
+	// boundary.
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}},
+	})
+	d, err = GeoDistance(poly, p)
+	require.NoError(t, err)
+	require.Zero(t, d)
+	d, err = GeoDistance(poly, geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 2}))
+	require.NoError(t, err)
+	require.InDelta(t, 111195, float64(d), 50)
+}
+
+func TestQueryTokensNearest(t *testing.T) {
+	_, qd, err := GetGeoTokens(&pb.SrcFunction{Name: "nearest", Args: []string{"[0, 0]", "3"}})
+	require.NoError(t, err)
+	require.Equal(t, 3, qd.Nearest())
+
+	toks, more, err := qd.NearTokens(1000)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.NotEmpty(t, toks)
+	_, more, err = qd.NearTokens(2 * nearestMaxRadius)
+	require.NoError(t, err)
+	require.False(t, more)
+
+	d, err := qd.Distance(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 1}))
+	require.NoError(t, err)
+	require.InDelta(t, 111195, float64(d), 1)
+
+	_, near, err := GetGeoTokens(&pb.SrcFunction{Name: "near", Args: []string{"[0, 0]", "10"}})
+	require.NoError(t, err)
+	require.Zero(t, near.Nearest())
+
+	for _, args := range [][]string{
+		{"[0, 0]"},
+		{"[0, 0]", "0"},
+		{"[0, 0]", "two"},
+		{"[[[0, 0], [1, 0], [1, 1], [0, 0]]]", "2"},
+	} {
+		_, _, err := GetGeoTokens(&pb.SrcFunction{Name: "nearest", Args: args})
+		require.Error(t, err, "%v", args)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Values of the ip type are held as netip.Prefix. An IP address is the network of a single
+// address, that is a /32 IPv4 or a /128 IPv6 network. Networks are kept in their canonical form,
+// with the bits after the prefix set to zero, so that "10.1.2.3/8" is stored as "10.0.0.0/8".
+
+// ParseIP parses an IP address or a network in CIDR notation.
+func ParseIP(val string) (netip.Prefix, error) {
+	if strings.Contains(val, "/") {
+		p, err := netip.ParsePrefix(val)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return p.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(val)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if addr.Zone() != "" {
+		return netip.Prefix{}, errors.Errorf("IP address %q with a zone is not supported", val)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// IPString returns the string form of an IP value. Addresses are written without a prefix
+// length.
+func IPString(p netip.Prefix) string {
+	if p.IsSingleIP() {
+		return p.Addr().String()
+	}
+	return p.String()
+}
+
+// IPToBinary encodes an IP value as the IP version (4 or 6), the bytes of the address and the
+// prefix length. The encodings of two values compare like the values do.
+func IPToBinary(p netip.Prefix) []byte {
+	addr := p.Addr().AsSlice()
+	b := make([]byte, 0, len(addr)+2)
+	if p.Addr().Is4() {
+		b = append(b, 4)
+	} else {
+		b = append(b, 6)
+	}
+	b = append(b, addr...)
+	return append(b, byte(p.Bits()))
+}
+
+// IPFromBinary decodes an IP value encoded by IPToBinary.
+func IPFromBinary(data []byte) (netip.Prefix, error) {
+	if len(data) != 6 && len(data) != 18 {
+		return netip.Prefix{}, errors.Errorf("invalid data for ip %v", data)
+	}
+	if (data[0] == 4) != (len(data) == 6) || (data[0] != 4 && data[0] != 6) {
+		return netip.Prefix{}, errors.Errorf("invalid data for ip %v", data)
+	}
+	addr, _ := netip.AddrFromSlice(data[1 : len(data)-1])
+	p := netip.PrefixFrom(addr, int(data[len(data)-1]))
+	if !p.IsValid() {
+		return netip.Prefix{}, errors.Errorf("invalid data for ip %v", data)
+	}
+	return p, nil
+}
+
+// CompareIP orders IP values by address, IPv4 addresses first, and then by prefix length.
+func CompareIP(a, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return a.Bits() - b.Bits()
+}
+
+// IPWithin returns whether the address or network v lies within the network n.
+func IPWithin(v, n netip.Prefix) bool {
+	return v.Addr().BitLen() == n.Addr().BitLen() && v.Bits() >= n.Bits() &&
+		n.Contains(v.Addr())
+}
+
+// IPLast returns the last address of the network n, as a single address.
+func IPLast(n netip.Prefix) netip.Prefix {
+	b := n.Addr().AsSlice()
+	for i := n.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return netip.PrefixFrom(addr, addr.BitLen())
+}
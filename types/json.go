@@ -0,0 +1,267 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Values of the json type are held as strings with the document in compact form. The documents
+// are validated when they are stored, so they are returned as they are in query results.
+
+// ParseJSONDocument validates a JSON document and returns it in compact form.
+func ParseJSONDocument(val string) (string, error) {
+	if _, err := decodeJSON(val); err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := json.Compact(&b, []byte(val)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func decodeJSON(val string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(val))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, errors.Wrapf(err, "invalid JSON document %q", val)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.Errorf("invalid JSON document %q: unexpected data after the document",
+			val)
+	}
+	return v, nil
+}
+
+// canonicalJSON returns the JSON encoding of a decoded value in which the keys of the objects
+// are sorted and the numbers are written the same way, so that equal values have the same
+// encoding.
+func canonicalJSON(v interface{}) string {
+	var b strings.Builder
+	writeCanonicalJSON(&b, v)
+	return b.String()
+}
+
+func writeCanonicalJSON(b *strings.Builder, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			b.WriteString(strconv.FormatInt(i, 10))
+		} else if f, err := v.Float64(); err == nil {
+			b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		} else {
+			b.WriteString(v.String())
+		}
+	case string:
+		b.Write(stringJSON(v))
+	case []interface{}:
+		b.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			writeCanonicalJSON(b, e)
+		}
+		b.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.Write(stringJSON(k))
+			b.WriteByte(':')
+			writeCanonicalJSON(b, v[k])
+		}
+		b.WriteByte('}')
+	}
+}
+
+func stringJSON(s string) []byte {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	// Encoding a string can't fail.
+	_ = enc.Encode(s)
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n"))
+}
+
+// ParseJSONPath parses a path into a JSON document. The path is made of keys separated by dots,
+// optionally starting with "$.", like "$.address.city". An array is indexed with a number, like
+// in "tags.0", and any other key applies to each of its elements, like in "items.name".
+func ParseJSONPath(path string) ([]string, error) {
+	p := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if p == "" {
+		return nil, errors.Errorf("Empty JSON path %q", path)
+	}
+	keys := strings.Split(p, ".")
+	for _, k := range keys {
+		if k == "" {
+			return nil, errors.Errorf("Invalid JSON path %q", path)
+		}
+	}
+	return keys, nil
+}
+
+// JSONQueryValue returns the canonical encoding of a value compared with the values of a JSON
+// document. The value is taken as JSON if it is valid JSON, and as a string otherwise, so that
+// "red" and "\"red\"" are the same value.
+func JSONQueryValue(val string) (string, bool) {
+	v, err := decodeJSON(val)
+	if err != nil {
+		v = val
+	}
+	return canonicalJSON(v), isJSONScalar(v)
+}
+
+func isJSONScalar(v interface{}) bool {
+	switch v.(type) {
+	case []interface{}, map[string]interface{}:
+		return false
+	}
+	return true
+}
+
+func jsonPathValues(v interface{}, path []string) []interface{} {
+	if len(path) == 0 {
+		return []interface{}{v}
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if e, ok := v[path[0]]; ok {
+			return jsonPathValues(e, path[1:])
+		}
+	case []interface{}:
+		if i, err := strconv.Atoi(path[0]); err == nil {
+			if i >= 0 && i < len(v) {
+				return jsonPathValues(v[i], path[1:])
+			}
+			return nil
+		}
+		var vals []interface{}
+		for _, e := range v {
+			vals = append(vals, jsonPathValues(e, path)...)
+		}
+		return vals
+	}
+	return nil
+}
+
+// JSONPathMatch returns whether the JSON document has a value at the path. If val, a value
+// returned by JSONQueryValue, is given, the value at the path must be equal to it, or be an
+// array containing it.
+func JSONPathMatch(doc string, path []string, val *string) (bool, error) {
+	v, err := decodeJSON(doc)
+	if err != nil {
+		return false, err
+	}
+	for _, found := range jsonPathValues(v, path) {
+		if val == nil || canonicalJSON(found) == *val {
+			return true, nil
+		}
+		if arr, ok := found.([]interface{}); ok {
+			for _, e := range arr {
+				if canonicalJSON(e) == *val {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// JSONPathToken returns the token of the path index for the documents having a value at the
+// path.
+func JSONPathToken(path []string) string {
+	return "e" + strings.Join(path, ".")
+}
+
+// JSONPathValueToken returns the token of the path index for the documents having the scalar
+// value, as returned by JSONQueryValue, at the path.
+func JSONPathValueToken(path []string, val string) string {
+	return "v" + strings.Join(path, ".") + "\x00" + val
+}
+
+// JSONPathTokens returns the tokens of the path index of a JSON document. There is a token for
+// each path of the document, and one for each path and scalar value at that path. The elements of
+// arrays are found both with and without their index, as they are by JSONPathMatch.
+func JSONPathTokens(doc string) ([]string, error) {
+	v, err := decodeJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make(map[string]struct{})
+	var walk func(v interface{}, path []string)
+	walk = func(v interface{}, path []string) {
+		if len(path) > 0 {
+			tokens[JSONPathToken(path)] = struct{}{}
+			if isJSONScalar(v) {
+				tokens[JSONPathValueToken(path, canonicalJSON(v))] = struct{}{}
+			}
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, e := range v {
+				walk(e, append(path[:len(path):len(path)], k))
+			}
+		case []interface{}:
+			for i, e := range v {
+				walk(e, append(path[:len(path):len(path)], strconv.Itoa(i)))
+				// The elements are also found under the path of the array, so that a path
+				// matches the arrays containing the value and the keys of the elements apply
+				// to each of them.
+				if !isJSONScalar(e) {
+					walkChildren(e, path, walk)
+				} else if len(path) > 0 {
+					tokens[JSONPathValueToken(path, canonicalJSON(e))] = struct{}{}
+				}
+			}
+		}
+	}
+	walk(v, nil)
+
+	out := make([]string, 0, len(tokens))
+	for t := range tokens {
+		out = append(out, t)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// walkChildren walks the children of v as if v was at the path. The elements of nested arrays
+// are walked the same way, as JSONPathMatch looks up keys in each of them.
+func walkChildren(v interface{}, path []string, walk func(interface{}, []string)) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			walk(e, append(path[:len(path):len(path)], k))
+		}
+	case []interface{}:
+		for _, e := range v {
+			if !isJSONScalar(e) {
+				walkChildren(e, path, walk)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertJSON(t *testing.T) {
+	v, err := Convert(Val{Tid: StringID, Value: []byte(`{ "a": [1, 2.50], "b": {"c": "<x>"} }`)},
+		JSONID)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":[1,2.50],"b":{"c":"<x>"}}`, v.Value)
+
+	out, err := v.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `{"a":[1,2.50],"b":{"c":"<x>"}}`, string(out))
+
+	for _, in := range []string{`{"a":}`, `{"a":1} {"b":2}`, `abc`, ``} {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(in)}, JSONID)
+		require.Error(t, err, in)
+	}
+
+	v, err = Convert(Val{Tid: IntID, Value: bs(int64(42))}, JSONID)
+	require.NoError(t, err)
+	require.Equal(t, "42", v.Value)
+}
+
+func TestJSONPathMatch(t *testing.T) {
+	doc := `{"color":"red","size":10,"tags":["a","b"],"dims":{"w":2.0,"h":[3]},
+		"items":[{"name":"x","qty":1},{"name":"y"}]}`
+	tokens, err := JSONPathTokens(doc)
+	require.NoError(t, err)
+
+	tests := []struct {
+		path  string
+		val   string
+		match bool
+	}{
+		{path: "color", match: true},
+		{path: "$.color", val: "red", match: true},
+		{path: "color", val: `"red"`, match: true},
+		{path: "color", val: "blue"},
+		{path: "size", val: "10.0", match: true},
+		{path: "size", val: `"10"`},
+		{path: "tags", val: "b", match: true},
+		{path: "tags.1", val: "b", match: true},
+		{path: "tags.0", val: "b"},
+		{path: "tags", val: `["a","b"]`, match: true},
+		{path: "dims.w", val: "2", match: true},
+		{path: "dims", val: `{"h":[3],"w":2}`, match: true},
+		{path: "dims.h", val: "3", match: true},
+		{path: "items.name", val: "y", match: true},
+		{path: "items.1.name", val: "y", match: true},
+		{path: "items.0.name", val: "y"},
+		{path: "items.qty", match: true},
+		{path: "weight"},
+		{path: "color.x"},
+	}
+	for _, tc := range tests {
+		path, err := ParseJSONPath(tc.path)
+		require.NoError(t, err)
+		var val *string
+		scalar := true
+		if tc.val != "" {
+			v, s := JSONQueryValue(tc.val)
+			val, scalar = &v, s
+		}
+		ok, err := JSONPathMatch(doc, path, val)
+		require.NoError(t, err)
+		require.Equal(t, tc.match, ok, "%s %s", tc.path, tc.val)
+
+		// The path index finds the same documents.
+		token := JSONPathToken(path)
+		if val != nil && scalar {
+			token = JSONPathValueToken(path, *val)
+		}
+		if scalar {
+			require.Equal(t, tc.match, slices.Contains(tokens, token), "%s %s", tc.path, tc.val)
+		}
+	}
+
+	_, err = ParseJSONPath("$")
+	require.Error(t, err)
+	_, err = ParseJSONPath("a..b")
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ package types
 import (
 	"encoding/json"
 
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"github.com/pkg/errors"
 	geom "github.com/twpayne/go-geom"
@@ -29,6 +30,50 @@ func edgesCrossPoints(l *s2.Loop, pts []s2.Point) bool {
 	return false
 }
 
+// edgesCrossPolyline returns true if any edge of the polyline crosses the loop.
+func edgesCrossPolyline(l *s2.Loop, p *s2.Polyline) bool {
+	for i := 0; i < p.NumEdges(); i++ {
+		e := p.Edge(i)
+		crosser := s2.NewChainEdgeCrosser(e.V0, e.V1, l.Vertex(0))
+		for j := 1; j <= l.NumEdges(); j++ {
+			if crosser.EdgeOrVertexChainCrossing(l.Vertex(j)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// polylineIntersectsLoop returns true if the polyline touches the loop.
+func polylineIntersectsLoop(l *s2.Loop, p *s2.Polyline) bool {
+	if !l.RectBound().Intersects(p.RectBound()) {
+		return false
+	}
+	for _, v := range *p {
+		if l.ContainsPoint(v) {
+			return true
+		}
+	}
+	// All the vertices are outside the loop, so the polyline can only touch it by crossing its
+	// boundary.
+	return edgesCrossPolyline(l, p)
+}
+
+// loopContainsPolyline returns true if the polyline lies entirely inside the loop.
+func loopContainsPolyline(l *s2.Loop, p *s2.Polyline) bool {
+	if !l.RectBound().Contains(p.RectBound()) {
+		return false
+	}
+	for _, v := range *p {
+		if !l.ContainsPoint(v) {
+			return false
+		}
+	}
+	// A polyline with all its vertices inside the loop can still leave it if the loop is
+	// not convex.
+	return !edgesCrossPolyline(l, p)
+}
+
 func intersects(l *s2.Loop, loop *s2.Loop) bool {
 	// Quick check if the bounding boxes intersect
 	if !l.RectBound().Intersects(loop.RectBound()) {
@@ -121,6 +166,105 @@ func Intersects(l1 *s2.Loop, l2 *s2.Loop) bool {
 	return intersects(l1, l2)
 }
 
+// GeoLength returns the length on earth of the lines in g. Points and polygons have no length.
+func GeoLength(g geom.T) (Length, error) {
+	var length Length
+	switch v := g.(type) {
+	case *geom.LineString:
+		l, err := polylineFromLineString(v)
+		if err != nil {
+			return 0, err
+		}
+		length = EarthDistance(l.Length())
+	case *geom.MultiLineString:
+		for i := range v.NumLineStrings() {
+			l, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return 0, err
+			}
+			length += EarthDistance(l.Length())
+		}
+	case *geom.Point, *geom.Polygon, *geom.MultiPolygon:
+	default:
+		return 0, errors.Errorf("Cannot compute length of geometry of type %T", v)
+	}
+	return length, nil
+}
+
+// GeoDistance returns the shortest distance on earth between the geometry g and the point p.
+// The distance is 0 if p lies inside a polygon of g.
+func GeoDistance(g geom.T, p *geom.Point) (Length, error) {
+	a, err := distanceToPoint(g, pointFromPoint(p))
+	if err != nil {
+		return 0, err
+	}
+	return EarthDistance(a), nil
+}
+
+func distanceToPoint(g geom.T, p s2.Point) (s1.Angle, error) {
+	switch v := g.(type) {
+	case *geom.Point:
+		return pointFromPoint(v).Distance(p), nil
+	case *geom.LineString:
+		l, err := polylineFromLineString(v)
+		if err != nil {
+			return 0, err
+		}
+		return distanceToEdges(p, *l, false), nil
+	case *geom.MultiLineString:
+		d := s1.InfAngle()
+		for i := range v.NumLineStrings() {
+			l, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return 0, err
+			}
+			d = min(d, distanceToEdges(p, *l, false))
+		}
+		return d, nil
+	case *geom.Polygon:
+		l, err := loopFromPolygon(v)
+		if err != nil {
+			return 0, err
+		}
+		if l.ContainsPoint(p) {
+			return 0, nil
+		}
+		return distanceToEdges(p, l.Vertices(), true), nil
+	case *geom.MultiPolygon:
+		d := s1.InfAngle()
+		for i := range v.NumPolygons() {
+			pd, err := distanceToPoint(v.Polygon(i), p)
+			if err != nil {
+				return 0, err
+			}
+			d = min(d, pd)
+		}
+		return d, nil
+	default:
+		return 0, errors.Errorf("Cannot compute distance to geometry of type %T", v)
+	}
+}
+
+// distanceToEdges returns the distance from p to the chain of edges through pts. If closed is
+// true, the last point is connected back to the first one.
+func distanceToEdges(p s2.Point, pts []s2.Point, closed bool) s1.Angle {
+	d := s1.InfAngle()
+	n := len(pts)
+	edges := n - 1
+	if closed {
+		edges = n
+	}
+	for i := range edges {
+		d = min(d, s2.DistanceFromSegment(p, pts[i], pts[(i+1)%n]))
+	}
+	return d
+}
+
+// ParseGeoArg parses a geo function argument, which is either GeoJSON or bare coordinates.
+func ParseGeoArg(str string) (geom.T, error) {
+	return convertToGeom(str)
+}
+
 func convertToGeom(str string) (geom.T, error) {
 	// validate would ensure that we have a closed loop for all the polygons. We don't support open
 	// loop polygons.
@@ -138,8 +282,29 @@ func convertToGeom(str string) (geom.T, error) {
 		return errors.Errorf("Last coord not same as first")
 	}
 
+	// A line needs at least two points.
+	line := func(l *geom.LineString) error {
+		if l.NumCoords() < 2 {
+			return errors.Errorf("Line string needs at least 2 coords")
+		}
+		return nil
+	}
+
 	validate := func(g geom.T) (geom.T, error) {
 		switch v := g.(type) {
+		case *geom.MultiLineString:
+			if v.NumLineStrings() == 0 {
+				return nil, errors.Errorf("Got empty multi line string.")
+			}
+			for i := range v.NumLineStrings() {
+				if err := line(v.LineString(i)); err != nil {
+					return nil, err
+				}
+			}
+		case *geom.LineString:
+			if err := line(v); err != nil {
+				return nil, err
+			}
 		case *geom.MultiPolygon:
 			for i := range v.NumPolygons() {
 				if err := closed(v.Polygon(i)); err != nil {
@@ -6,6 +6,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -42,3 +43,26 @@ func TestConvertToGeoJson_PolyError2(t *testing.T) {
 	_, err := convertToGeom(s)
 	require.Error(t, err)
 }
+
+func TestConvertToGeoJson_LineString(t *testing.T) {
+	s := `{'type':'LineString','coordinates':[[1.5, 2.5], [3.5, 4.5], [5.5, 6.5]]}`
+	b, err := convertToGeom(strings.ReplaceAll(s, "'", "\""))
+	require.NoError(t, err)
+	require.Equal(t, []geom.Coord{{1.5, 2.5}, {3.5, 4.5}, {5.5, 6.5}},
+		b.(*geom.LineString).Coords())
+
+	s = `{'type':'MultiLineString','coordinates':[[[1, 2], [3, 4]], [[5, 6], [7, 8]]]}`
+	b, err = convertToGeom(strings.ReplaceAll(s, "'", "\""))
+	require.NoError(t, err)
+	require.Equal(t, 2, b.(*geom.MultiLineString).NumLineStrings())
+}
+
+func TestConvertToGeoJson_LineStringError(t *testing.T) {
+	s := `{"type":"LineString","coordinates":[[1.5, 2.5]]}`
+	_, err := convertToGeom(s)
+	require.Error(t, err)
+
+	s = `{"type":"MultiLineString","coordinates":[[[1, 2], [3, 4]], [[5, 6]]]}`
+	_, err = convertToGeom(s)
+	require.Error(t, err)
+}
@@ -99,6 +99,36 @@ func TestIndexCellsPolygonError(t *testing.T) {
 	require.Contains(t, err.Error(), "Last coordinate not same as first")
 }
 
+func TestIndexCellsLineString(t *testing.T) {
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.4, 37.7}, {-122.3, 37.8}, {-122.2, 37.8}})
+	parents, cover, err := indexCells(l)
+	require.NoError(t, err)
+	require.NotEmpty(t, cover)
+	require.LessOrEqual(t, len(cover), MaxCells)
+	for _, c := range cover {
+		require.True(t, c.Level() <= MaxCellLevel && c.Level() >= MinCellLevel)
+		require.Contains(t, parents, c)
+	}
+
+	// A multilinestring is covered by the cells of all its lines.
+	ml := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.4, 37.7}, {-122.3, 37.8}, {-122.2, 37.8}},
+		{{2.35, 48.85}, {2.36, 48.86}},
+	})
+	mparents, mcover, err := indexCells(ml)
+	require.NoError(t, err)
+	require.Greater(t, len(mcover), len(cover))
+	require.Greater(t, len(mparents), len(parents))
+}
+
+func TestIndexCellsLineStringError(t *testing.T) {
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.4, 37.7}})
+	_, _, err := indexCells(l)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "less than 2 pts")
+}
+
 func TestKeyGeneratorPoint(t *testing.T) {
 	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
 	data, err := wkb.Marshal(p, binary.LittleEndian)
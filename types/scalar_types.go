@@ -6,9 +6,15 @@
 package types
 
 import (
+	"math"
 	"math/big"
+	"net/netip"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/twpayne/go-geom"
 
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
@@ -62,6 +68,14 @@ const (
 	UndefinedID = TypeID(100)
 	// BigFloatID represents the arbitrary precision type.
 	BigFloatID = TypeID(pb.Posting_BIGFLOAT)
+	// DurationID represents the duration type.
+	DurationID = TypeID(pb.Posting_DURATION)
+	// UUIDID represents the UUID type.
+	UUIDID = TypeID(pb.Posting_UUID)
+	// IPID represents the type of IP addresses and of networks in CIDR notation.
+	IPID = TypeID(pb.Posting_IP)
+	// JSONID represents the type of JSON documents.
+	JSONID = TypeID(pb.Posting_JSON)
 )
 
 var typeNameMap = map[string]TypeID{
@@ -77,6 +91,10 @@ var typeNameMap = map[string]TypeID{
 	"password":      PasswordID,
 	"bigfloat":      BigFloatID,
 	"float32vector": VFloatID,
+	"duration":      DurationID,
+	"uuid":          UUIDID,
+	"ip":            IPID,
+	"json":          JSONID,
 }
 
 // TypeID represents the type of the data.
@@ -114,6 +132,14 @@ func (t TypeID) Name() string {
 		return "bigfloat"
 	case VFloatID:
 		return "float32vector"
+	case DurationID:
+		return "duration"
+	case UUIDID:
+		return "uuid"
+	case IPID:
+		return "ip"
+	case JSONID:
+		return "json"
 	}
 	return ""
 }
@@ -205,6 +231,18 @@ func ValueForType(id TypeID) Val {
 	case VFloatID:
 		var v []float32
 		return Val{VFloatID, &v}
+	case DurationID:
+		var d time.Duration
+		return Val{DurationID, &d}
+	case UUIDID:
+		var u uuid.UUID
+		return Val{UUIDID, &u}
+	case IPID:
+		var p netip.Prefix
+		return Val{IPID, &p}
+	case JSONID:
+		var s string
+		return Val{JSONID, s}
 	default:
 		return Val{}
 	}
@@ -240,3 +278,66 @@ func ParseTime(val string) (time.Time, error) {
 	// Try without timezone.
 	return time.Parse(dateTimeFormat, val)
 }
+
+// ParseDuration parses a duration written the way Go does, like "1h30m" or "-1.5s", or as an
+// ISO 8601 duration made of weeks, days, hours, minutes and seconds, like "P1DT12H" or "PT0.5S".
+// Years and months aren't accepted, since their length varies.
+func ParseDuration(val string) (time.Duration, error) {
+	s := val
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	if !strings.HasPrefix(s, "P") {
+		return time.ParseDuration(val)
+	}
+	invalid := errors.Errorf("invalid ISO 8601 duration %q", val)
+
+	s = s[1:]
+	var d time.Duration
+	inTime, empty := false, true
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			if inTime {
+				return 0, invalid
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+		i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return 0, invalid
+		}
+		n, err := strconv.ParseFloat(s[:i], 64)
+		if err != nil {
+			return 0, invalid
+		}
+		var unit time.Duration
+		switch {
+		case !inTime && s[i] == 'W':
+			unit = 7 * 24 * time.Hour
+		case !inTime && s[i] == 'D':
+			unit = 24 * time.Hour
+		case inTime && s[i] == 'H':
+			unit = time.Hour
+		case inTime && s[i] == 'M':
+			unit = time.Minute
+		case inTime && s[i] == 'S':
+			unit = time.Second
+		default:
+			return 0, invalid
+		}
+		if n*float64(unit) > math.MaxInt64 {
+			return 0, invalid
+		}
+		d += time.Duration(n * float64(unit))
+		s = s[i+1:]
+		empty = false
+	}
+	if empty {
+		return 0, invalid
+	}
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
@@ -167,3 +167,23 @@ func BenchmarkParseTimeRejections(b *testing.B) {
 		}
 	}
 }
+
+func TestParseDuration(t *testing.T) {
+	for in, out := range map[string]time.Duration{
+		"1h30m":        90 * time.Minute,
+		"-1.5s":        -1500 * time.Millisecond,
+		"P1W":          7 * 24 * time.Hour,
+		"P1DT12H":      36 * time.Hour,
+		"PT0.5S":       500 * time.Millisecond,
+		"-PT2M":        -2 * time.Minute,
+		"P2DT1H30M10S": 49*time.Hour + 30*time.Minute + 10*time.Second,
+	} {
+		d, err := ParseDuration(in)
+		require.NoError(t, err, in)
+		require.Equal(t, out, d, in)
+	}
+	for _, in := range []string{"", "P", "P1Y", "P1M", "PT1D", "P1H", "PT1H2", "PTT1H", "1x"} {
+		_, err := ParseDuration(in)
+		require.Error(t, err, in)
+	}
+}
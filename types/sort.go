@@ -6,10 +6,13 @@
 package types
 
 import (
+	"bytes"
 	"math/big"
+	"net/netip"
 	"sort"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/text/collate"
 	"golang.org/x/text/language"
@@ -84,7 +87,7 @@ func (s byValue) Less(i, j int) bool {
 // IsSortable returns true, if tid is sortable. Otherwise it returns false.
 func IsSortable(tid TypeID) bool {
 	switch tid {
-	case DateTimeID, IntID, FloatID, StringID, DefaultID, BigFloatID:
+	case DateTimeID, IntID, FloatID, StringID, DefaultID, BigFloatID, DurationID, UUIDID, IPID:
 		return true
 	default:
 		return false
@@ -177,7 +180,8 @@ func Less(a, b Val) (bool, error) {
 	}
 	typ := a.Tid
 	switch typ {
-	case DateTimeID, UidID, IntID, FloatID, StringID, DefaultID, BigFloatID:
+	case DateTimeID, UidID, IntID, FloatID, StringID, DefaultID, BigFloatID, DurationID, UUIDID,
+		IPID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return false, errors.Errorf("Compare not supported for type: %v", a.Tid)
@@ -209,6 +213,13 @@ func less(a, b Val, cl *collate.Collator) bool {
 		lValue = a.Value.(big.Float)
 		rValue = b.Value.(big.Float)
 		return lValue.Cmp(&rValue) == -1
+	case DurationID:
+		return a.Value.(time.Duration) < b.Value.(time.Duration)
+	case UUIDID:
+		aVal, bVal := a.Value.(uuid.UUID), b.Value.(uuid.UUID)
+		return bytes.Compare(aVal[:], bVal[:]) < 0
+	case IPID:
+		return CompareIP(a.Value.(netip.Prefix), b.Value.(netip.Prefix)) < 0
 	}
 	return false
 }
@@ -238,7 +249,8 @@ func Equal(a, b Val) (bool, error) {
 	}
 	typ := a.Tid
 	switch typ {
-	case DateTimeID, IntID, FloatID, StringID, DefaultID, BoolID, BigFloatID:
+	case DateTimeID, IntID, FloatID, StringID, DefaultID, BoolID, BigFloatID, DurationID, UUIDID,
+		IPID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return false, errors.Errorf("Equal not supported for type: %v", a.Tid)
@@ -275,6 +287,18 @@ func equal(a, b Val) bool {
 		aVal := a.Value.(big.Float)
 		bVal := b.Value.(big.Float)
 		return aVal.Cmp(&bVal) == 0
+	case DurationID:
+		aVal, aOk := a.Value.(time.Duration)
+		bVal, bOk := b.Value.(time.Duration)
+		return aOk && bOk && aVal == bVal
+	case UUIDID:
+		aVal, aOk := a.Value.(uuid.UUID)
+		bVal, bOk := b.Value.(uuid.UUID)
+		return aOk && bOk && aVal == bVal
+	case IPID:
+		aVal, aOk := a.Value.(netip.Prefix)
+		bVal, bOk := b.Value.(netip.Prefix)
+		return aOk && bOk && aVal == bVal
 	}
 	return false
 }
@@ -283,3 +283,30 @@ func TestSortMismatchedTypes(t *testing.T) {
 	require.True(t, idx21 < idx33)
 	require.True(t, idx33 < idx55)
 }
+
+func TestSortIPs(t *testing.T) {
+	list := getInput(t, IPID, []string{"2001:db8::1", "10.0.0.0/8", "10.1.2.3", "9.255.0.1",
+		"10.0.0.0/16", "::ffff:1.2.3.4"})
+	ul := getUIDList(len(list))
+	require.NoError(t, Sort(list, &ul.Uids, []bool{false}, ""))
+	require.Equal(t, []string{"9.255.0.1", "10.0.0.0/8", "10.0.0.0/16", "10.1.2.3",
+		"::ffff:1.2.3.4", "2001:db8::1"}, toString(t, list, IPID))
+}
+
+func TestIPWithin(t *testing.T) {
+	n, err := ParseIP("10.1.0.0/16")
+	require.NoError(t, err)
+	for s, within := range map[string]bool{
+		"10.1.0.0/16":     true,
+		"10.1.2.0/24":     true,
+		"10.1.255.255":    true,
+		"10.0.0.0/8":      false,
+		"10.2.0.1":        false,
+		"::ffff:10.1.0.1": false,
+	} {
+		ip, err := ParseIP(s)
+		require.NoError(t, err)
+		require.Equal(t, within, IPWithin(ip, n), s)
+	}
+	require.Equal(t, "10.1.255.255", IPString(IPLast(n)))
+}
@@ -18,6 +18,7 @@ func couldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 		return typ == types.IntID ||
 			typ == types.FloatID ||
 			typ == types.DateTimeID ||
+			typ == types.DurationID ||
 			typ == types.StringID ||
 			typ == types.DefaultID
 	case "sum", "avg":
@@ -274,7 +274,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// 10ms. If we restrict the size here, then Raft goes into a loop trying
 		// to maintain quorum health.
 		applyCh:    make(chan []raftpb.Entry, 1000),
-		closer:     z.NewCloser(4), // Matches CLOSER:1
+		closer:     z.NewCloser(7), // Matches CLOSER:1
 		ops:        make(map[op]operation),
 		cdcTracker: newCDC(),
 	}
@@ -420,8 +420,27 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		return nil
 	}
 
+	// A change of the schema is recorded by the proposal applying it, once it's applied. The
+	// groups not serving any of the predicates changed only record it.
+	recordChange := func() error {
+		if change := proposal.Mutations.SchemaChange; change != nil {
+			span.AddEvent("Recording schema change")
+			return writeSchemaChange(change)
+		}
+		return nil
+	}
+
 	if proposal.Mutations.DropOp == pb.Mutations_TYPE {
-		return schema.State().DeleteType(proposal.Mutations.DropValue, proposal.StartTs)
+		if err := schema.State().DeleteType(proposal.Mutations.DropValue,
+			proposal.StartTs); err != nil {
+			return err
+		}
+		return recordChange()
+	}
+
+	if proposal.Mutations.SchemaChange != nil && len(proposal.Mutations.Schema) == 0 &&
+		len(proposal.Mutations.Types) == 0 && len(proposal.Mutations.Edges) == 0 {
+		return recordChange()
 	}
 
 	if proposal.Mutations.StartTs == 0 {
@@ -457,7 +476,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				return err
 			}
 		}
-		return nil
+		return recordChange()
 	}
 
 	// Scheduler tracks tasks at subject, predicate level, so doing
@@ -478,7 +497,10 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				return err
 			}
 			span.AddEvent("Deleting predicate")
-			return posting.DeletePredicate(ctx, edge.Attr, proposal.StartTs)
+			if err := posting.DeletePredicate(ctx, edge.Attr, proposal.StartTs); err != nil {
+				return err
+			}
+			return recordChange()
 		}
 		// Don't derive schema when doing deletion.
 		if edge.Op == pb.DirectedEdge_DEL {
@@ -537,6 +559,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	}
 	// Discard the posting lists from cache to release memory at the end.
 	defer txn.Update()
+	trackStrictNodes(txn, m)
 
 	process := func(edges []*pb.DirectedEdge) error {
 		var retries int
@@ -1019,6 +1042,12 @@ func (n *node) commitOrAbort(pkey uint64, delta *pb.OracleDelta) error {
 	for _, status := range delta.Txns {
 		txn := posting.Oracle().GetTxn(status.StartTs)
 		txn.UpdateCachedKeys(status.CommitTs)
+		if status.CommitTs != 0 {
+			trackExpiringKeys(txn)
+		}
+		if status.CommitTs != 0 && n.AmLeader() {
+			enqueueEmbeddings(txn)
+		}
 	}
 
 	// Now advance Oracle(), so we can service waiting reads.
@@ -1098,6 +1127,8 @@ func (n *node) retrieveSnapshot(snap *pb.Snapshot) error {
 	if err := n.populateSnapshot(snap, pool); err != nil {
 		return errors.Wrapf(err, "cannot retrieve snapshot from peer")
 	}
+	// The data was replaced, so the keys with expiring values are loaded again.
+	expiringKeys.reset()
 	// Populate shard stores the streamed data directly into db, so we need to refresh
 	// schema for current group id
 	if err := schema.LoadFromDb(closer.Ctx()); err != nil {
@@ -2017,6 +2048,9 @@ func (n *node) InitAndStartNode() {
 		}
 	}
 	go n.processTabletSizes()
+	go n.processExpiredData()
+	go n.refreshEmbeddingTargets()
+	go n.sweepEmbeddings()
 	go n.processApplyCh()
 	go n.BatchAndSendMessages()
 	go n.monitorRaftMetrics()
@@ -0,0 +1,502 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// Vectors of predicates with an @embedding directive are computed by the
+// server. Whenever a txn that changes a source predicate commits, the leader
+// of the group serving it queues a job for every changed node. Jobs read the
+// latest value of the source, ask the embedder for its vector and write it in
+// a txn of their own, so vectors are eventually consistent with their source.
+// When @embedding is added to a predicate, the leader of its group queues a
+// job for every node that has the source predicate.
+//
+// The schema of a predicate is only kept by the group serving it, so the
+// leaders also read the @embedding predicates of the other groups every
+// embeddingTargetsInterval, for the sources served by their group.
+//
+// Jobs are only kept in memory, so they are lost when the leader restarts or
+// changes, or when they fail too many times. A new leader, and then the
+// leader every embeddingSweepInterval, queues a job for every node that has
+// the source predicate of an @embedding predicate of its group but no vector.
+
+const (
+	embeddingWorkers         = 4
+	embeddingMaxRetries      = 5
+	embeddingTimeout         = time.Minute
+	embeddingTargetsInterval = 10 * time.Second
+	embeddingSweepInterval   = 10 * time.Minute
+)
+
+// remoteEmbeddings holds the @embedding predicates served by the other groups.
+var remoteEmbeddings embeddingTargets
+
+type embeddingTargets struct {
+	sync.RWMutex
+	// specs maps the predicates to their @embedding directive.
+	specs map[string]*pb.EmbeddingSpec
+	// sources maps the source predicates to the predicates computed from them.
+	sources map[string][]string
+}
+
+func (e *embeddingTargets) set(specs map[string]*pb.EmbeddingSpec) {
+	sources := make(map[string][]string)
+	for target, spec := range specs {
+		source := x.NamespaceAttr(x.ParseNamespace(target), spec.Source)
+		sources[source] = append(sources[source], target)
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.specs, e.sources = specs, sources
+}
+
+func (e *embeddingTargets) spec(target string) *pb.EmbeddingSpec {
+	e.RLock()
+	defer e.RUnlock()
+	return e.specs[target]
+}
+
+// targets returns the map of the source predicates to the predicates computed
+// from them. The returned map is never modified.
+func (e *embeddingTargets) targets() map[string][]string {
+	e.RLock()
+	defer e.RUnlock()
+	return e.sources
+}
+
+// refreshEmbeddingTargets reads the @embedding predicates served by the other
+// groups while this node is the leader of its group.
+func (n *node) refreshEmbeddingTargets() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(embeddingTargetsInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if !n.AmLeader() {
+				continue
+			}
+			if err := readRemoteEmbeddings(n.closer.Ctx()); err != nil {
+				glog.Errorf("Unable to read the embeddings of the other groups: %v", err)
+			}
+		}
+	}
+}
+
+// sweepEmbeddings queues the nodes missing a vector when this node becomes the
+// leader of its group, and then every embeddingSweepInterval.
+func (n *node) sweepEmbeddings() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(embeddingTargetsInterval)
+	defer tick.Stop()
+
+	var last time.Time
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if !n.AmLeader() {
+				last = time.Time{}
+				continue
+			}
+			if time.Since(last) < embeddingSweepInterval {
+				continue
+			}
+			last = time.Now()
+			for _, target := range schema.State().Embeddings() {
+				if err := queueMissingEmbeddings(n.closer.Ctx(), target); err != nil {
+					glog.Errorf("Unable to sweep the embeddings of %s: %v",
+						x.ParseAttr(target), err)
+				}
+			}
+		}
+	}
+}
+
+// queueMissingEmbeddings queues a job for every node that has a value for the
+// source predicate of target but no vector.
+func queueMissingEmbeddings(ctx context.Context, target string) error {
+	ctx, cancel := context.WithTimeout(ctx, embeddingTimeout)
+	defer cancel()
+
+	spec := embeddingSpec(ctx, target)
+	if spec == nil {
+		return nil
+	}
+	source := x.NamespaceAttr(x.ParseNamespace(target), spec.Source)
+	readTs := State.GetTimestamp(true)
+	sources, err := nodesWith(ctx, source, readTs)
+	if err != nil {
+		return err
+	}
+	vectors, err := nodesWith(ctx, target, readTs)
+	if err != nil {
+		return err
+	}
+
+	missing := algo.Difference(sources, vectors).GetUids()
+	for _, uid := range missing {
+		embeddings.enqueue(embeddingJob{target: target, uid: uid})
+	}
+	if len(missing) > 0 {
+		glog.Infof("Queued %d nodes missing the embeddings of %s", len(missing),
+			x.ParseAttr(target))
+	}
+	return nil
+}
+
+func readRemoteEmbeddings(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, embeddingTargetsInterval)
+	defer cancel()
+
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Fields: []string{"embedding"}})
+	if err != nil {
+		return err
+	}
+	specs := make(map[string]*pb.EmbeddingSpec)
+	for _, node := range nodes {
+		if node.Embedding == nil {
+			continue
+		}
+		if gid, err := groups().BelongsToReadOnly(node.Predicate, 0); err != nil ||
+			groups().ServesGroup(gid) {
+			continue
+		}
+		specs[node.Predicate] = node.Embedding
+	}
+	remoteEmbeddings.set(specs)
+	return nil
+}
+
+// embeddingSpec returns the @embedding directive of target, if it has one.
+func embeddingSpec(ctx context.Context, target string) *pb.EmbeddingSpec {
+	if gid, err := groups().BelongsToReadOnly(target, 0); err == nil && !groups().ServesGroup(gid) {
+		return remoteEmbeddings.spec(target)
+	}
+	su, _ := schema.State().Get(ctx, target)
+	return su.GetEmbedding()
+}
+
+// embeddingJob asks for the vector of uid in target to be computed again.
+type embeddingJob struct {
+	target string
+	uid    uint64
+}
+
+type embeddingJobState int
+
+const (
+	embeddingQueued embeddingJobState = iota
+	embeddingRunning
+	// embeddingRerun marks a running job whose source changed in the meantime.
+	embeddingRerun
+)
+
+type embeddingQueue struct {
+	sync.Mutex
+	cond   *sync.Cond
+	jobs   []embeddingJob
+	state  map[embeddingJob]embeddingJobState
+	start  sync.Once
+	client *http.Client
+}
+
+var embeddings = newEmbeddingQueue()
+
+func newEmbeddingQueue() *embeddingQueue {
+	q := &embeddingQueue{
+		state:  make(map[embeddingJob]embeddingJobState),
+		client: &http.Client{Timeout: embeddingTimeout},
+	}
+	q.cond = sync.NewCond(q)
+	return q
+}
+
+// enqueueEmbeddings queues a job for every embedding computed from a data key
+// changed by the txn.
+func enqueueEmbeddings(txn *posting.Txn) {
+	remote := remoteEmbeddings.targets()
+	if !schema.State().HasEmbeddings() && len(remote) == 0 {
+		return
+	}
+	txn.IterateDataKeys(func(pk x.ParsedKey) {
+		for _, target := range schema.State().EmbeddingTargets(pk.Attr) {
+			embeddings.enqueue(embeddingJob{target: target, uid: pk.Uid})
+		}
+		for _, target := range remote[pk.Attr] {
+			embeddings.enqueue(embeddingJob{target: target, uid: pk.Uid})
+		}
+	})
+}
+
+// backfillEmbeddings queues a job for every node that has a value for the
+// source predicate of target.
+func backfillEmbeddings(target string, spec *pb.EmbeddingSpec) {
+	source := x.NamespaceAttr(x.ParseNamespace(target), spec.Source)
+	if gid, err := groups().BelongsToReadOnly(source, 0); err == nil && gid != 0 &&
+		!groups().ServesGroup(gid) {
+		// The leader of the group serving the source learns about target within
+		// embeddingTargetsInterval. The nodes changed until then are covered by
+		// waiting for it.
+		time.Sleep(2 * embeddingTargetsInterval)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), embeddingTimeout)
+	defer cancel()
+
+	uids, err := nodesWith(ctx, source, State.GetTimestamp(true))
+	if err != nil {
+		glog.Errorf("Unable to backfill embeddings of %s: %v", x.ParseAttr(target), err)
+		return
+	}
+	for _, uid := range uids.GetUids() {
+		embeddings.enqueue(embeddingJob{target: target, uid: uid})
+	}
+	glog.Infof("Queued %d nodes to backfill the embeddings of %s", len(uids.GetUids()),
+		x.ParseAttr(target))
+}
+
+// nodesWith returns the nodes that have a value for attr at readTs.
+func nodesWith(ctx context.Context, attr string, readTs uint64) (*pb.List, error) {
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    attr,
+		SrcFunc: &pb.SrcFunction{Name: "has"},
+		ReadTs:  readTs,
+		First:   math.MaxInt32,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return algo.MergeSorted(res.GetUidMatrix()), nil
+}
+
+func (q *embeddingQueue) enqueue(job embeddingJob) {
+	q.start.Do(func() {
+		for range embeddingWorkers {
+			go q.worker()
+		}
+	})
+
+	q.Lock()
+	defer q.Unlock()
+	state, ok := q.state[job]
+	switch {
+	case !ok:
+		q.state[job] = embeddingQueued
+		q.jobs = append(q.jobs, job)
+		q.cond.Signal()
+	case state == embeddingRunning:
+		q.state[job] = embeddingRerun
+	}
+}
+
+func (q *embeddingQueue) next() embeddingJob {
+	q.Lock()
+	defer q.Unlock()
+	for len(q.jobs) == 0 {
+		q.cond.Wait()
+	}
+	job := q.jobs[0]
+	q.jobs = q.jobs[1:]
+	q.state[job] = embeddingRunning
+	return job
+}
+
+func (q *embeddingQueue) done(job embeddingJob) {
+	q.Lock()
+	defer q.Unlock()
+	if q.state[job] == embeddingRerun {
+		q.state[job] = embeddingQueued
+		q.jobs = append(q.jobs, job)
+		q.cond.Signal()
+		return
+	}
+	delete(q.state, job)
+}
+
+func (q *embeddingQueue) worker() {
+	for {
+		job := q.next()
+		if err := q.process(job); err != nil {
+			glog.Errorf("Unable to compute embedding of %s for uid %#x: %v",
+				x.ParseAttr(job.target), job.uid, err)
+		}
+		q.done(job)
+	}
+}
+
+func (q *embeddingQueue) process(job embeddingJob) error {
+	ctx, cancel := context.WithTimeout(context.Background(), embeddingTimeout)
+	defer cancel()
+
+	spec := embeddingSpec(ctx, job.target)
+	if spec == nil {
+		// The @embedding directive was removed in the meantime.
+		return nil
+	}
+	source := x.NamespaceAttr(x.ParseNamespace(job.target), spec.Source)
+	text, err := readEmbeddingSource(ctx, source, job.uid)
+	if err != nil {
+		return err
+	}
+
+	edge := &pb.DirectedEdge{Entity: job.uid, Attr: job.target}
+	if text == "" {
+		edge.Op = pb.DirectedEdge_DEL
+		edge.Value = []byte(x.Star)
+	} else {
+		vec, err := q.embedWithRetries(ctx, spec, text)
+		if err != nil {
+			return err
+		}
+		edge.Op = pb.DirectedEdge_SET
+		edge.Value = types.FloatArrayAsBytes(vec)
+		edge.ValueType = pb.Posting_VFLOAT
+	}
+
+	tctx, err := MutateOverNetwork(ctx, &pb.Mutations{
+		Edges:   []*pb.DirectedEdge{edge},
+		StartTs: State.GetTimestamp(false),
+	})
+	if err != nil {
+		tctx.Aborted = true
+		_, _ = CommitOverNetwork(ctx, tctx)
+		return err
+	}
+	_, err = CommitOverNetwork(ctx, tctx)
+	return err
+}
+
+// readEmbeddingSource returns the latest value of source for uid as a string.
+// The values of a list are joined by new lines.
+func readEmbeddingSource(ctx context.Context, source string, uid uint64) (string, error) {
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    source,
+		UidList: &pb.List{Uids: []uint64{uid}},
+		ReadTs:  State.GetTimestamp(true),
+	})
+	if err != nil {
+		return "", err
+	}
+	var texts []string
+	for _, vl := range res.GetValueMatrix() {
+		for _, tv := range vl.GetValues() {
+			if bytes.Equal(tv.Val, x.Nilbyte) {
+				continue
+			}
+			val, err := types.Convert(types.Val{Tid: types.BinaryID, Value: tv.Val},
+				types.TypeID(tv.ValType))
+			if err != nil {
+				return "", err
+			}
+			str := types.ValueForType(types.StringID)
+			if err := types.Marshal(val, &str); err != nil {
+				return "", err
+			}
+			if s := str.Value.(string); s != "" {
+				texts = append(texts, s)
+			}
+		}
+	}
+	return strings.Join(texts, "\n"), nil
+}
+
+func (q *embeddingQueue) embedWithRetries(ctx context.Context, spec *pb.EmbeddingSpec,
+	text string) ([]float32, error) {
+	wait := 100 * time.Millisecond
+	for attempt := 1; ; attempt++ {
+		vec, retry, err := q.embed(ctx, spec, text)
+		if err == nil || !retry || attempt == embeddingMaxRetries {
+			return vec, err
+		}
+		glog.V(2).Infof("Retrying embedding request to %s after error: %v", spec.Url, err)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		wait *= 2
+	}
+}
+
+type embeddingRequest struct {
+	Input string `json:"input"`
+	Model string `json:"model,omitempty"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// embed asks the OpenAI compatible endpoint of spec for the vector of text. The
+// returned bool is true if the request failed with an error worth retrying.
+func (q *embeddingQueue) embed(ctx context.Context, spec *pb.EmbeddingSpec,
+	text string) ([]float32, bool, error) {
+	body, err := json.Marshal(embeddingRequest{Input: text, Model: spec.Model})
+	if err != nil {
+		return nil, false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, spec.Url, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if spec.ApiKeyEnv != "" {
+		req.Header.Set("Authorization", "Bearer "+os.Getenv(spec.ApiKeyEnv))
+	}
+
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return nil, retry, fmt.Errorf("embedder returned status %d: %s", resp.StatusCode,
+			strings.TrimSpace(string(data)))
+	}
+
+	var out embeddingResponse
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, false, errors.Wrap(err, "while decoding the embedder response")
+	}
+	if len(out.Data) == 0 || len(out.Data[0].Embedding) == 0 {
+		return nil, false, errors.New("embedder returned no embedding")
+	}
+	return out.Data[0].Embedding, false, nil
+}
@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+// mockEmbedder is an OpenAI compatible embeddings endpoint that fails with
+// status fail the first failures times it is called, and then embeds the input
+// as a vector holding its length.
+type mockEmbedder struct {
+	*httptest.Server
+	calls atomic.Int32
+
+	sync.Mutex
+	// err is the first problem found with the requests, checked by the test once
+	// they are done, as the handler doesn't run on the test goroutine.
+	err error
+}
+
+func newMockEmbedder(t *testing.T, failures int32, fail int) *mockEmbedder {
+	m := &mockEmbedder{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.calls.Add(1) <= failures {
+			http.Error(w, "try again", fail)
+			return
+		}
+		var req embeddingRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err != nil:
+		case r.Header.Get("Authorization") != "Bearer secret":
+			err = fmt.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		case req.Model != "small":
+			err = fmt.Errorf("unexpected model %q", req.Model)
+		}
+		if err != nil {
+			m.fail(err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"index": 0, "embedding": []float32{float32(len(req.Input)), 1}},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			m.fail(err)
+		}
+	}))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockEmbedder) fail(err error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.err == nil {
+		m.err = err
+	}
+}
+
+func (m *mockEmbedder) requireNoError(t *testing.T) {
+	m.Lock()
+	defer m.Unlock()
+	require.NoError(t, m.err)
+}
+
+func TestEmbedWithRetries(t *testing.T) {
+	t.Setenv("EMBEDDER_KEY", "secret")
+	srv := newMockEmbedder(t, 2, http.StatusServiceUnavailable)
+	spec := &pb.EmbeddingSpec{Url: srv.URL, Model: "small", ApiKeyEnv: "EMBEDDER_KEY"}
+
+	vec, err := newEmbeddingQueue().embedWithRetries(context.Background(), spec, "hello")
+	srv.requireNoError(t)
+	require.NoError(t, err)
+	require.Equal(t, []float32{5, 1}, vec)
+	require.Equal(t, int32(3), srv.calls.Load())
+}
+
+func TestEmbedNoRetryOnBadRequest(t *testing.T) {
+	srv := newMockEmbedder(t, 1, http.StatusBadRequest)
+	spec := &pb.EmbeddingSpec{Url: srv.URL, Model: "small"}
+
+	_, err := newEmbeddingQueue().embedWithRetries(context.Background(), spec, "hello")
+	srv.requireNoError(t)
+	require.ErrorContains(t, err, "status 400")
+	require.Equal(t, int32(1), srv.calls.Load())
+}
+
+func TestEmbeddingQueueDedup(t *testing.T) {
+	q := newEmbeddingQueue()
+	// Don't start the workers, the test plays their part.
+	q.start.Do(func() {})
+
+	job := embeddingJob{target: "vec", uid: 1}
+	q.enqueue(job)
+	q.enqueue(job)
+	require.Len(t, q.jobs, 1)
+
+	require.Equal(t, job, q.next())
+	// The source changed again while the job was running, so it runs once more.
+	q.enqueue(job)
+	require.Empty(t, q.jobs)
+	q.done(job)
+	require.Equal(t, []embeddingJob{job}, q.jobs)
+
+	require.Equal(t, job, q.next())
+	q.done(job)
+	require.Empty(t, q.jobs)
+	require.Empty(t, q.state)
+}
@@ -13,6 +13,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"net/url"
 	"os"
@@ -32,6 +33,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/enc"
 	"github.com/hypermodeinc/dgraph/v25/posting"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
 	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
 	"github.com/hypermodeinc/dgraph/v25/types"
 	"github.com/hypermodeinc/dgraph/v25/types/facets"
@@ -58,6 +60,16 @@ var exportFormats = map[string]exportFormat{
 		pre:  "",
 		post: "",
 	},
+	// The nodes are written to a Parquet file per type by exportParquet.
+	"parquet": {
+		ext: ".parquet",
+	},
+	// The start of the document, holding the @context of the predicates, is written by
+	// jsonldHeader.
+	"jsonld": {
+		ext:  ".jsonld",
+		post: "\n]\n}\n",
+	},
 }
 
 type exporter struct {
@@ -82,6 +94,10 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.PasswordID: "xs:password",
 	types.BigFloatID: "xs:decimal",
 	types.VFloatID:   "xs:[]float32",
+	types.DurationID: "xs:duration",
+	types.UUIDID:     "xs:uuid",
+	types.IPID:       "xs:ip",
+	types.JSONID:     "xs:json",
 }
 
 // UIDs like 0x1 look weird but 64-bit ones like 0x0000000000000001 are too long.
@@ -192,7 +208,8 @@ func (e *exporter) toJSON() (*bpb.KVList, error) {
 				return nil
 			}
 
-			if !val.Tid.IsNumber() {
+			// JSON documents are written as they are, to be loaded back as documents.
+			if !val.Tid.IsNumber() && val.Tid != types.JSONID {
 				str = escapedString(str)
 			}
 
@@ -307,6 +324,9 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 		x.Check2(buf.WriteString(" @index("))
 		x.Check2(buf.WriteString(strings.Join(update.GetTokenizer(), ",")))
 		x.Check2(buf.WriteRune(')'))
+		if conds := update.GetWhere(); len(conds) > 0 {
+			x.Check2(buf.WriteString(formatWhereSchema(conds)))
+		}
 
 	case len(update.GetIndexSpecs()) != 0:
 		str := formatVectorSchema(update)
@@ -325,6 +345,21 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	if update.GetUnique() {
 		x.Check2(buf.WriteString(" @unique"))
 	}
+	if spec := update.GetEmbedding(); spec != nil {
+		x.Check2(buf.WriteString(formatEmbeddingSchema(spec)))
+	}
+	if spec := update.GetCheck(); spec != nil {
+		x.Check2(buf.WriteString(formatCheckSchema(spec)))
+	}
+	if names := update.GetFacetIndex(); len(names) > 0 {
+		x.Check2(buf.WriteString(" @facetindex(" + strings.Join(names, ",") + ")"))
+	}
+	if ttl := update.GetTtl(); ttl > 0 {
+		x.Check2(buf.WriteString(" @ttl(" + (time.Duration(ttl) * time.Second).String() + ")"))
+	}
+	if update.GetStrict() {
+		x.Check2(buf.WriteString(" @strict"))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	//TODO(Naman): We don't need the version anymore.
 	return &bpb.KV{
@@ -361,10 +396,70 @@ func formatVectorSchema(schema *pb.SchemaUpdate) string {
 	return buf.String()
 }
 
-func toType(attr string, update pb.TypeUpdate) *bpb.KV {
+func formatEmbeddingSchema(spec *pb.EmbeddingSpec) string {
+	opts := []string{
+		fmt.Sprintf(`source:"%s"`, spec.Source),
+		fmt.Sprintf(`url:"%s"`, spec.Url),
+	}
+	if spec.Model != "" {
+		opts = append(opts, fmt.Sprintf(`model:"%s"`, spec.Model))
+	}
+	if spec.ApiKeyEnv != "" {
+		opts = append(opts, fmt.Sprintf(`api_key_env:"%s"`, spec.ApiKeyEnv))
+	}
+	return " @embedding(" + strings.Join(opts, ",") + ")"
+}
+
+func formatCheckSchema(spec *pb.CheckSpec) string {
+	var opts []string
+	if spec.Min != "" {
+		opts = append(opts, fmt.Sprintf(`min:"%s"`, spec.Min))
+	}
+	if spec.Max != "" {
+		opts = append(opts, fmt.Sprintf(`max:"%s"`, spec.Max))
+	}
+	if spec.MaxLength > 0 {
+		opts = append(opts, fmt.Sprintf(`maxlen:"%d"`, spec.MaxLength))
+	}
+	if spec.Regex != "" {
+		opts = append(opts, fmt.Sprintf(`regex:"%s"`, spec.Regex))
+	}
+	if len(spec.Allowed) > 0 {
+		opts = append(opts, fmt.Sprintf(`enum:"%s"`, strings.Join(spec.Allowed, ",")))
+	}
+	if spec.Dims > 0 {
+		opts = append(opts, fmt.Sprintf(`dims:"%d"`, spec.Dims))
+	}
+	return " @check(" + strings.Join(opts, ",") + ")"
+}
+
+func formatWhereSchema(conds []*pb.IndexCondition) string {
+	opts := make([]string, len(conds))
+	for i, cond := range conds {
+		opts[i] = fmt.Sprintf(`%s:"%s"`, cond.Op, cond.Value)
+	}
+	return " @where(" + strings.Join(opts, ",") + ")"
+}
+
+func toType(attr string, update *pb.TypeUpdate) *bpb.KV {
 	var buf bytes.Buffer
 	ns, attr := x.ParseNamespaceAttr(attr)
-	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s> {\n", ns, attr)))
+	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s>", ns, attr)))
+	for _, key := range update.UniqueKeys {
+		preds := make([]string, len(key.Predicates))
+		for i, pred := range key.Predicates {
+			preds[i] = x.ParseAttr(pred)
+		}
+		x.Check2(buf.WriteString(" @unique(" + strings.Join(preds, ",") + ")"))
+	}
+	for _, index := range update.CompositeIndexes {
+		preds := make([]string, len(index.Predicates))
+		for i, pred := range index.Predicates {
+			preds[i] = x.ParseAttr(pred)
+		}
+		x.Check2(buf.WriteString(" @index(" + strings.Join(preds, ",") + ")"))
+	}
+	x.Check2(buf.WriteString(" {\n"))
 	for _, field := range update.Fields {
 		x.Check2(buf.WriteString(fieldToString(field)))
 	}
@@ -391,6 +486,9 @@ func fieldToString(update *pb.SchemaUpdate) string {
 	} else {
 		x.Check2(builder.WriteString(predicate))
 	}
+	if update.GetNonNullable() {
+		x.Check2(builder.WriteString("!"))
+	}
 	x.Check2(builder.WriteString("\n"))
 	return builder.String()
 }
@@ -398,11 +496,13 @@ func fieldToString(update *pb.SchemaUpdate) string {
 type ExportWriter struct {
 	fd            *os.File
 	bw            *bufio.Writer
+	ew            io.Writer
 	gw            *gzip.Writer
 	relativePath  string
 	hasDataBefore bool
 }
 
+// open creates the file. Files whose path ends with .gz are compressed.
 func (writer *ExportWriter) open(fpath string) error {
 	var err error
 	writer.fd, err = os.Create(fpath)
@@ -410,20 +510,33 @@ func (writer *ExportWriter) open(fpath string) error {
 		return err
 	}
 	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
-	w, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
+	writer.ew, err = enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
 	if err != nil {
 		return err
 	}
-	writer.gw, err = gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if !strings.HasSuffix(fpath, ".gz") {
+		return nil
+	}
+	writer.gw, err = gzip.NewWriterLevel(writer.ew, gzip.BestSpeed)
 	return err
 }
 
-func (writer *ExportWriter) Close() error {
-	if err := writer.gw.Flush(); err != nil {
-		return err
+// Write writes to the file, compressing the data if the file is compressed.
+func (writer *ExportWriter) Write(p []byte) (int, error) {
+	if writer.gw != nil {
+		return writer.gw.Write(p)
 	}
-	if err := writer.gw.Close(); err != nil {
-		return err
+	return writer.ew.Write(p)
+}
+
+func (writer *ExportWriter) Close() error {
+	if writer.gw != nil {
+		if err := writer.gw.Flush(); err != nil {
+			return err
+		}
+		if err := writer.gw.Close(); err != nil {
+			return err
+		}
 	}
 	if err := writer.bw.Flush(); err != nil {
 		return err
@@ -486,19 +599,12 @@ func (l *localExportStorage) OpenFile(fileName string) (*ExportWriter, error) {
 }
 
 func (l *localExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
-	if err := w.DataWriter.Close(); err != nil {
-		return nil, err
-	}
-	if err := w.SchemaWriter.Close(); err != nil {
-		return nil, err
-	}
-	if err := w.GqlSchemaWriter.Close(); err != nil {
-		return nil, err
-	}
-	files := ExportedFiles{
-		w.DataWriter.relativePath,
-		w.SchemaWriter.relativePath,
-		w.GqlSchemaWriter.relativePath,
+	var files ExportedFiles
+	for _, writer := range w.all() {
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		files = append(files, writer.relativePath)
 	}
 	return files, nil
 }
@@ -563,8 +669,12 @@ func (r *remoteExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
 		filePath := filepath.Join(r.les.destination, f)
 		// FIXME: tejas [06/2020] - We could probably stream these results, but it's easier to copy for now
 		glog.Infof("Uploading from %s to %s\n", filePath, d)
+		contentType := "application/gzip"
+		if !strings.HasSuffix(f, ".gz") {
+			contentType = "application/octet-stream"
+		}
 		_, err := r.mc.FPutObject(context.Background(), r.bucket, d, filePath, minio.PutObjectOptions{
-			ContentType: "application/gzip",
+			ContentType: contentType,
 		})
 		if err != nil {
 			return nil, err
@@ -618,6 +728,9 @@ func ToExportKvList(pk x.ParsedKey, pl *posting.List, in *pb.ExportRequest) (*bp
 	case e.attr == "dgraph.graphql.xid":
 	case e.attr == "dgraph.drop.op":
 	case e.attr == "dgraph.graphql.p_query":
+	// The combined values of composite unique keys and indexes are rebuilt when the data
+	// is loaded.
+	case schema.IsTuplePredicate(pk.Attr):
 
 	case pk.IsData() && e.attr == "dgraph.graphql.schema":
 		// Export the graphql schema.
@@ -685,6 +798,11 @@ func ToExportKvList(pk x.ParsedKey, pl *posting.List, in *pb.ExportRequest) (*bp
 			return e.toJSON()
 		case "rdf":
 			return e.toRDF()
+		case "jsonld":
+			return e.toJSONLD(in.Namespace == math.MaxUint64)
+		case "parquet":
+			// The nodes are exported by exportParquet.
+			return emptyList, nil
 		default:
 			glog.Fatalf("Invalid export format found: %s", in.Format)
 		}
@@ -704,11 +822,11 @@ func WriteExport(writers *Writers, kv *bpb.KV, format string) error {
 
 	var dataSeparator []byte
 	switch format {
-	case "json":
+	case "json", "jsonld":
 		dataSeparator = []byte(",\n")
-	case "rdf":
+	case "rdf", "parquet":
 		// The separator for RDF should be empty since the toRDF function already
-		// adds newline to each RDF entry.
+		// adds newline to each RDF entry. No data is written in the Parquet format.
 	default:
 		glog.Fatalf("Invalid export format found: %s", format)
 	}
@@ -742,9 +860,22 @@ func WriteExport(writers *Writers, kv *bpb.KV, format string) error {
 }
 
 type Writers struct {
+	// DataWriter is nil in the Parquet format, whose data is written by TypeWriters.
 	DataWriter      *ExportWriter
 	SchemaWriter    *ExportWriter
 	GqlSchemaWriter *ExportWriter
+	TypeWriters     []*ExportWriter
+}
+
+// all returns the writers which have been opened.
+func (w *Writers) all() []*ExportWriter {
+	var all []*ExportWriter
+	for _, writer := range []*ExportWriter{w.DataWriter, w.SchemaWriter, w.GqlSchemaWriter} {
+		if writer != nil {
+			all = append(all, writer)
+		}
+	}
+	return append(all, w.TypeWriters...)
 }
 
 func InitWriters(s ExportStorage, in *pb.ExportRequest) (*Writers, error) {
@@ -755,8 +886,10 @@ func InitWriters(s ExportStorage, in *pb.ExportRequest) (*Writers, error) {
 	}
 
 	var err error
-	if w.DataWriter, err = s.OpenFile(fileName(xfmt.ext + ".gz")); err != nil {
-		return w, err
+	if in.Format != "parquet" {
+		if w.DataWriter, err = s.OpenFile(fileName(xfmt.ext + ".gz")); err != nil {
+			return w, err
+		}
 	}
 	if w.SchemaWriter, err = s.OpenFile(fileName(".schema.gz")); err != nil {
 		return w, err
@@ -888,6 +1021,11 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			var kv *bpb.KV
 			switch prefix {
 			case x.ByteSchema:
+				// These are created along with the types declaring composite unique keys
+				// and indexes.
+				if schema.IsTuplePredicate(pk.Attr) {
+					continue
+				}
 				kv, err = SchemaExportKv(pk.Attr, val, skipZero)
 				if err != nil {
 					// Let's not propagate this error. We just log this and continue onwards.
@@ -918,14 +1056,29 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 	if _, err = writers.GqlSchemaWriter.gw.Write([]byte(exportFormats["json"].pre)); err != nil {
 		return nil, err
 	}
-	if _, err = writers.DataWriter.gw.Write([]byte(xfmt.pre)); err != nil {
-		return nil, err
+	pre := xfmt.pre
+	if in.Format == "jsonld" {
+		if pre, err = jsonldHeader(in, db, skipZero); err != nil {
+			return nil, err
+		}
+	}
+	if writers.DataWriter != nil {
+		if _, err = writers.DataWriter.gw.Write([]byte(pre)); err != nil {
+			return nil, err
+		}
 	}
 	if err := stream.Orchestrate(ctx); err != nil {
 		return nil, err
 	}
-	if _, err = writers.DataWriter.gw.Write([]byte(xfmt.post)); err != nil {
-		return nil, err
+	if writers.DataWriter != nil {
+		if _, err = writers.DataWriter.gw.Write([]byte(xfmt.post)); err != nil {
+			return nil, err
+		}
+	}
+	if in.Format == "parquet" {
+		if err := exportParquet(ctx, in, db, exportStorage, writers, skipZero); err != nil {
+			return nil, err
+		}
 	}
 	if _, err = writers.GqlSchemaWriter.gw.Write([]byte(exportFormats["json"].post)); err != nil {
 		return nil, err
@@ -963,7 +1116,7 @@ func TypeExportKv(attr string, val []byte) (*bpb.KV, error) {
 	if err := proto.Unmarshal(val, &update); err != nil {
 		return nil, err
 	}
-	return toType(attr, update), nil
+	return toType(attr, &update), nil
 }
 
 // Export request is used to trigger exports for the request list of groups.
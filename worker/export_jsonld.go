@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// jsonldTypes are the datatypes of the values in the @context of the JSON-LD exports. The other
+// types, which aren't in XML Schema, are written as in the RDF exports.
+var jsonldTypes = map[types.TypeID]string{
+	types.IntID:      "xsd:integer",
+	types.FloatID:    "xsd:double",
+	types.BoolID:     "xsd:boolean",
+	types.DateTimeID: "xsd:dateTime",
+	types.UidID:      "@id",
+	types.JSONID:     "@json",
+}
+
+// jsonldHeader returns the start of the JSON-LD export, up to the start of its @graph. The
+// @context maps each predicate served by the group to itself, with the type of its values, so that
+// they are read back with their type. The types of the nodes are their @type.
+func jsonldHeader(in *pb.ExportRequest, db *badger.DB, skipZero bool) (string, error) {
+	txn := db.NewTransactionAt(in.ReadTs, false)
+	defer txn.Discard()
+
+	ctx := map[string]any{"xsd": "http://www.w3.org/2001/XMLSchema#"}
+	err := iteratePrefix(txn, in, x.ByteSchema, func(attr string, val []byte) error {
+		name := x.ParseAttr(attr)
+		if schema.IsTuplePredicate(attr) || name == "dgraph.type" ||
+			strings.HasPrefix(name, "dgraph.graphql.") {
+			return nil
+		}
+		if !skipZero {
+			if servesTablet, err := groups().ServesTablet(attr); err != nil || !servesTablet {
+				return nil
+			}
+		}
+		var su pb.SchemaUpdate
+		if err := proto.Unmarshal(val, &su); err != nil {
+			return err
+		}
+		term := map[string]any{"@id": name}
+		tid := types.TypeID(su.ValueType)
+		if typ, ok := jsonldTypes[tid]; ok {
+			term["@type"] = typ
+		} else if typ, ok := rdfTypeMap[tid]; ok && tid != types.StringID {
+			term["@type"] = typ
+		}
+		if su.List {
+			term["@container"] = "@set"
+		}
+		// The namespaces may have different types for the same predicate.
+		if prev, ok := ctx[name].(map[string]any); ok && prev["@type"] != term["@type"] {
+			delete(prev, "@type")
+			return nil
+		}
+		ctx[name] = term
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.MarshalIndent(ctx, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling the JSON-LD context")
+	}
+	return fmt.Sprintf("{\n\"@context\": %s,\n\"@graph\": [\n", b), nil
+}
+
+// toJSONLD writes each posting as a node object, which JSON-LD processors merge by their @id. The
+// values with a language tag are value objects. The facets aren't exported, as JSON-LD has no
+// place for them. The namespace of the nodes is written like in the JSON exports when all the
+// namespaces are exported.
+func (e *exporter) toJSONLD(namespaces bool) (*bpb.KVList, error) {
+	bp := new(bytes.Buffer)
+	nodeStart := fmt.Sprintf(`  {"@id":`+uidFmtStrJson, e.uid)
+	if namespaces {
+		nodeStart += fmt.Sprintf(`,"namespace":"%#x"`, e.namespace)
+	}
+
+	continuing := false
+	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+		if p.PostingType == pb.Posting_REF {
+			if continuing {
+				fmt.Fprint(bp, ",\n")
+			}
+			continuing = true
+			fmt.Fprintf(bp, `%s,"%s":{"@id":`+uidFmtStrJson+"}}", nodeStart, e.attr, p.Uid)
+			return nil
+		}
+
+		val := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+		str, err := valToStr(val)
+		if err != nil {
+			glog.Errorf("Ignoring error: %+v\n", err)
+			return nil
+		}
+		if !val.Tid.IsNumber() && val.Tid != types.BoolID && val.Tid != types.JSONID {
+			str = escapedString(str)
+		}
+
+		if continuing {
+			fmt.Fprint(bp, ",\n")
+		}
+		continuing = true
+		switch {
+		case e.attr == "dgraph.type":
+			fmt.Fprintf(bp, `%s,"@type":%s}`, nodeStart, str)
+		case p.PostingType == pb.Posting_VALUE_LANG:
+			fmt.Fprintf(bp, `%s,"%s":{"@value":%s,"@language":%s}}`, nodeStart, e.attr, str,
+				escapedString(string(p.LangTag)))
+		default:
+			fmt.Fprintf(bp, `%s,"%s":%s}`, nodeStart, e.attr, str)
+		}
+		return nil
+	})
+
+	kv := &bpb.KV{
+		Value:   bp.Bytes(),
+		Version: 1,
+	}
+	return listWrap(kv), err
+}
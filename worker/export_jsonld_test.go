@@ -0,0 +1,120 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/chunker"
+	"github.com/hypermodeinc/dgraph/v25/codec"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestExportJSONLD(t *testing.T) {
+	db, err := badger.OpenManaged(badger.DefaultOptions("").WithInMemory(true).WithLogger(nil))
+	require.NoError(t, err)
+	defer db.Close()
+
+	attr := x.AttrInRootNamespace
+	binary := func(tid types.TypeID, v interface{}) []byte {
+		out := types.Val{Tid: types.BinaryID}
+		require.NoError(t, types.Marshal(types.Val{Tid: tid, Value: v}, &out))
+		return out.Value.([]byte)
+	}
+	value := func(typ pb.Posting_ValType, val []byte) *pb.PostingList {
+		p := &pb.Posting{Uid: math.MaxUint64, Value: val, ValType: typ,
+			PostingType: pb.Posting_VALUE}
+		return &pb.PostingList{Pack: codec.Encode([]uint64{p.Uid}, 256),
+			Postings: []*pb.Posting{p}}
+	}
+
+	txn := db.NewTransactionAt(math.MaxUint64, true)
+	set := func(key []byte, msg proto.Message) {
+		val, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		require.NoError(t, txn.SetEntry(badger.NewEntry(key, val).
+			WithMeta(posting.BitCompletePosting)))
+	}
+	for _, su := range []*pb.SchemaUpdate{
+		{Predicate: attr("dgraph.type"), ValueType: pb.Posting_STRING, List: true},
+		{Predicate: attr("name"), ValueType: pb.Posting_STRING},
+		{Predicate: attr("age"), ValueType: pb.Posting_INT},
+		{Predicate: attr("friend"), ValueType: pb.Posting_UID, List: true},
+	} {
+		set(x.SchemaKey(su.Predicate), su)
+	}
+	set(x.DataKey(attr("dgraph.type"), 1), value(pb.Posting_STRING, []byte("Person")))
+	set(x.DataKey(attr("name"), 1), value(pb.Posting_STRING, []byte("Alice")))
+	set(x.DataKey(attr("age"), 1), value(pb.Posting_INT, binary(types.IntID, int64(30))))
+	set(x.DataKey(attr("friend"), 1), &pb.PostingList{Pack: codec.Encode([]uint64{2}, 256)})
+	lang := &pb.Posting{Uid: 5, Value: []byte("Robert"), PostingType: pb.Posting_VALUE_LANG,
+		LangTag: []byte("fr")}
+	set(x.DataKey(attr("name"), 2), &pb.PostingList{Pack: codec.Encode([]uint64{5}, 256),
+		Postings: []*pb.Posting{lang}})
+	require.NoError(t, txn.CommitAt(5, nil))
+
+	dir := t.TempDir()
+	files, err := exportInternal(context.Background(), &pb.ExportRequest{ReadTs: 10, GroupId: 1,
+		Namespace: 0, Format: "jsonld", Destination: dir}, db, true)
+	require.NoError(t, err)
+	require.Equal(t, "g01.jsonld.gz", filepath.Base(files[0]))
+
+	f, err := os.Open(filepath.Join(dir, files[0]))
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(gz)
+	require.NoError(t, err)
+
+	var doc struct {
+		Context map[string]any   `json:"@context"`
+		Graph   []map[string]any `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	require.Equal(t, map[string]any{
+		"xsd":    "http://www.w3.org/2001/XMLSchema#",
+		"name":   map[string]any{"@id": "name"},
+		"age":    map[string]any{"@id": "age", "@type": "xsd:integer"},
+		"friend": map[string]any{"@id": "friend", "@type": "@id", "@container": "@set"},
+	}, doc.Context)
+	require.Len(t, doc.Graph, 5)
+
+	// The export is read back with the types and the language tags of the values.
+	ck := chunker.NewChunker(chunker.JsonldFormat, 10)
+	chunk, err := ck.Chunk(bufio.NewReader(bytes.NewReader(data)))
+	require.Equal(t, io.EOF, err)
+	require.NoError(t, ck.Parse(chunk))
+	ck.NQuads().Flush()
+	require.ElementsMatch(t, []*api.NQuad{
+		{Subject: "0x1", Predicate: "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: "Person"}}},
+		{Subject: "0x1", Predicate: "name",
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: "Alice"}}},
+		{Subject: "0x1", Predicate: "age",
+			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 30}}},
+		{Subject: "0x1", Predicate: "friend", ObjectId: "0x2"},
+		{Subject: "0x2", Predicate: "name", Lang: "fr",
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: "Robert"}}},
+	}, <-ck.NQuads().Ch())
+}
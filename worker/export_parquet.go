@@ -0,0 +1,302 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/golang/glog"
+	"github.com/parquet-go/parquet-go"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// parquetBatchSize is the number of rows written to the Parquet files at once.
+const parquetBatchSize = 1000
+
+// exportParquet writes the nodes of each type to a Parquet file, named after the group, the
+// namespace and the type. Each row has the uid of the node, as in the other formats, followed by
+// a column for each field of the type served by the group. The files of the groups for a type
+// can be joined on the uid. Lists and edges are repeated columns and the values with a language
+// tag aren't exported.
+func exportParquet(ctx context.Context, in *pb.ExportRequest, db *badger.DB, s ExportStorage,
+	writers *Writers, skipZero bool) error {
+
+	txn := db.NewTransactionAt(in.ReadTs, false)
+	defer txn.Discard()
+
+	preds := make(map[string]*pb.SchemaUpdate)
+	err := iteratePrefix(txn, in, x.ByteSchema, func(attr string, val []byte) error {
+		if schema.IsTuplePredicate(attr) {
+			return nil
+		}
+		if !skipZero {
+			if servesTablet, err := groups().ServesTablet(attr); err != nil || !servesTablet {
+				return nil
+			}
+		}
+		var su pb.SchemaUpdate
+		if err := proto.Unmarshal(val, &su); err != nil {
+			return err
+		}
+		preds[attr] = &su
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var typs []*pb.TypeUpdate
+	err = iteratePrefix(txn, in, x.ByteType, func(attr string, val []byte) error {
+		var tu pb.TypeUpdate
+		if err := proto.Unmarshal(val, &tu); err != nil {
+			return err
+		}
+		tu.TypeName = attr
+		typs = append(typs, &tu)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, typ := range typs {
+		var fields []*pb.SchemaUpdate
+		for _, field := range typ.Fields {
+			if su, ok := preds[field.Predicate]; ok {
+				fields = append(fields, su)
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		if err := exportParquetType(ctx, in, txn, s, writers, typ.TypeName, fields,
+			skipZero); err != nil {
+			return errors.Wrapf(err, "while exporting the nodes of type %s",
+				x.ParseAttr(typ.TypeName))
+		}
+	}
+	return nil
+}
+
+// iteratePrefix calls f with the attribute and the value of the schema or type keys of the
+// exported namespaces.
+func iteratePrefix(txn *badger.Txn, in *pb.ExportRequest, prefix byte,
+	f func(attr string, val []byte) error) error {
+
+	iopts := badger.DefaultIteratorOptions
+	iopts.Prefix = []byte{prefix}
+	if in.Namespace != math.MaxUint64 {
+		iopts.Prefix = append(iopts.Prefix, x.NamespaceToBytes(in.Namespace)...)
+	}
+	itr := txn.NewIterator(iopts)
+	defer itr.Close()
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.IsDeletedOrExpired() {
+			continue
+		}
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return err
+		}
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		if err := f(pk.Attr, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportParquetType(ctx context.Context, in *pb.ExportRequest, txn *badger.Txn,
+	s ExportStorage, writers *Writers, typeName string, fields []*pb.SchemaUpdate,
+	skipZero bool) error {
+
+	ns, name := x.ParseNamespaceAttr(typeName)
+	uids, err := typeUids(ctx, in, txn, ns, name, skipZero)
+	if err != nil {
+		return err
+	}
+
+	group := parquet.Group{"uid": parquet.String()}
+	for _, su := range fields {
+		group[x.ParseAttr(su.Predicate)] = parquetNode(su)
+	}
+	w, err := s.OpenFile(fmt.Sprintf("g%02d.%#x.%s.parquet", in.GroupId, ns, name))
+	if err != nil {
+		return err
+	}
+	writers.TypeWriters = append(writers.TypeWriters, w)
+	pw := parquet.NewGenericWriter[map[string]any](w, parquet.NewSchema(name, group))
+
+	rows := make([]map[string]any, 0, parquetBatchSize)
+	flush := func() error {
+		if _, err := pw.Write(rows); err != nil {
+			return err
+		}
+		rows = rows[:0]
+		return nil
+	}
+	for _, uid := range uids {
+		row, err := parquetRow(txn, in.ReadTs, uid, fields)
+		if err != nil {
+			return err
+		}
+		if rows = append(rows, row); len(rows) == parquetBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	glog.Infof("Exported %d nodes of type %s to Parquet", len(uids), name)
+	return pw.Close()
+}
+
+// typeUids returns the nodes of the type, read from the exported DB if the cluster can't be
+// reached.
+func typeUids(ctx context.Context, in *pb.ExportRequest, txn *badger.Txn, ns uint64,
+	name string, skipZero bool) ([]uint64, error) {
+
+	attr := x.NamespaceAttr(ns, "dgraph.type")
+	if !skipZero {
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    attr,
+			SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{name}},
+			ReadTs:  in.ReadTs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.UidMatrix) == 0 {
+			return nil, nil
+		}
+		return res.UidMatrix[0].Uids, nil
+	}
+
+	tokenizer, _ := tok.GetTokenizer("exact")
+	tokens, err := tok.BuildTokens(name, tokenizer)
+	if err != nil {
+		return nil, err
+	}
+	pl, err := readPostingList(txn, x.IndexKey(attr, tokens[0]))
+	if err != nil || pl == nil {
+		return nil, err
+	}
+	list, err := pl.Uids(posting.ListOptions{ReadTs: in.ReadTs})
+	if err != nil {
+		return nil, err
+	}
+	return list.Uids, nil
+}
+
+func readPostingList(txn *badger.Txn, key []byte) (*posting.List, error) {
+	iopts := badger.DefaultIteratorOptions
+	iopts.AllVersions = true
+	iopts.PrefetchValues = false
+	itr := txn.NewKeyIterator(key, iopts)
+	defer itr.Close()
+	itr.Seek(key)
+	if !itr.Valid() {
+		return nil, nil
+	}
+	return posting.ReadPostingList(key, itr)
+}
+
+// parquetNode returns the column of the predicate. Edges are written as uids, and the values
+// which don't have a Parquet type are written as strings.
+func parquetNode(su *pb.SchemaUpdate) parquet.Node {
+	var node parquet.Node
+	switch types.TypeID(su.ValueType) {
+	case types.IntID:
+		node = parquet.Int(64)
+	case types.FloatID:
+		node = parquet.Leaf(parquet.DoubleType)
+	case types.BoolID:
+		node = parquet.Leaf(parquet.BooleanType)
+	case types.DateTimeID:
+		node = parquet.Timestamp(parquet.Microsecond)
+	default:
+		node = parquet.String()
+	}
+	if su.List || su.ValueType == pb.Posting_UID {
+		return parquet.Repeated(node)
+	}
+	return parquet.Optional(node)
+}
+
+func parquetRow(txn *badger.Txn, readTs, uid uint64,
+	fields []*pb.SchemaUpdate) (map[string]any, error) {
+
+	row := map[string]any{"uid": fmt.Sprintf("%#x", uid)}
+	for _, su := range fields {
+		pl, err := readPostingList(txn, x.DataKey(su.Predicate, uid))
+		if err != nil {
+			return nil, err
+		}
+		if pl == nil {
+			continue
+		}
+		var vals []any
+		err = pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+			switch p.PostingType {
+			case pb.Posting_REF:
+				vals = append(vals, fmt.Sprintf("%#x", p.Uid))
+				return nil
+			case pb.Posting_VALUE_LANG:
+				return nil
+			}
+			val, err := parquetValue(su, types.Val{Tid: types.TypeID(p.ValType), Value: p.Value})
+			if err != nil {
+				glog.Errorf("Ignoring error: %+v\n", err)
+				return nil
+			}
+			vals = append(vals, val)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) == 0 {
+			continue
+		}
+		name := x.ParseAttr(su.Predicate)
+		if su.List || su.ValueType == pb.Posting_UID {
+			row[name] = vals
+		} else {
+			row[name] = vals[0]
+		}
+	}
+	return row, nil
+}
+
+// parquetValue converts a value to the Go type of the column of the predicate.
+func parquetValue(su *pb.SchemaUpdate, val types.Val) (any, error) {
+	switch tid := types.TypeID(su.ValueType); tid {
+	case types.IntID, types.FloatID, types.BoolID, types.DateTimeID:
+		v, err := types.Convert(val, tid)
+		if err != nil {
+			return nil, err
+		}
+		return v.Value, nil
+	}
+	return valToStr(val)
+}
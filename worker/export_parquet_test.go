@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/codec"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestExportParquet(t *testing.T) {
+	db, err := badger.OpenManaged(badger.DefaultOptions("").WithInMemory(true).WithLogger(nil))
+	require.NoError(t, err)
+	defer db.Close()
+
+	attr := x.AttrInRootNamespace
+	born := time.Date(1990, 5, 2, 15, 4, 5, 0, time.UTC)
+	binary := func(tid types.TypeID, v interface{}) []byte {
+		out := types.Val{Tid: types.BinaryID}
+		require.NoError(t, types.Marshal(types.Val{Tid: tid, Value: v}, &out))
+		return out.Value.([]byte)
+	}
+	value := func(uid uint64, typ pb.Posting_ValType, val []byte) *pb.Posting {
+		return &pb.Posting{Uid: uid, Value: val, ValType: typ, PostingType: pb.Posting_VALUE}
+	}
+	// values returns a rolled up list of the postings, whose uids are packed too.
+	values := func(postings ...*pb.Posting) *pb.PostingList {
+		var uids []uint64
+		for _, p := range postings {
+			uids = append(uids, p.Uid)
+		}
+		return &pb.PostingList{Pack: codec.Encode(uids, 256), Postings: postings}
+	}
+
+	txn := db.NewTransactionAt(math.MaxUint64, true)
+	set := func(key []byte, msg proto.Message, meta byte) {
+		val, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		require.NoError(t, txn.SetEntry(badger.NewEntry(key, val).WithMeta(meta)))
+	}
+	preds := []*pb.SchemaUpdate{
+		{Predicate: attr("name"), ValueType: pb.Posting_STRING},
+		{Predicate: attr("age"), ValueType: pb.Posting_INT},
+		{Predicate: attr("born"), ValueType: pb.Posting_DATETIME},
+		{Predicate: attr("tags"), ValueType: pb.Posting_STRING, List: true},
+		{Predicate: attr("friend"), ValueType: pb.Posting_UID, List: true},
+	}
+	person := &pb.TypeUpdate{TypeName: attr("Person")}
+	for _, su := range preds {
+		set(x.SchemaKey(su.Predicate), su, 0)
+		person.Fields = append(person.Fields, &pb.SchemaUpdate{Predicate: su.Predicate})
+	}
+	set(x.TypeKey(person.TypeName), person, 0)
+
+	tokenizer, _ := tok.GetTokenizer("exact")
+	tokens, err := tok.BuildTokens("Person", tokenizer)
+	require.NoError(t, err)
+	set(x.IndexKey(attr("dgraph.type"), tokens[0]),
+		&pb.PostingList{Pack: codec.Encode([]uint64{1, 2}, 256)}, posting.BitCompletePosting)
+
+	set(x.DataKey(attr("name"), 1), values(
+		value(math.MaxUint64, pb.Posting_STRING, []byte("Alice"))), posting.BitCompletePosting)
+	set(x.DataKey(attr("age"), 1), values(
+		value(math.MaxUint64, pb.Posting_INT, binary(types.IntID, int64(30)))),
+		posting.BitCompletePosting)
+	set(x.DataKey(attr("born"), 1), values(
+		value(math.MaxUint64, pb.Posting_DATETIME, binary(types.DateTimeID, born))),
+		posting.BitCompletePosting)
+	set(x.DataKey(attr("tags"), 1), values(
+		value(1, pb.Posting_STRING, []byte("a")), value(2, pb.Posting_STRING, []byte("b"))),
+		posting.BitCompletePosting)
+	set(x.DataKey(attr("friend"), 1), &pb.PostingList{Pack: codec.Encode([]uint64{2}, 256)},
+		posting.BitCompletePosting)
+	// The values with a language tag aren't exported.
+	set(x.DataKey(attr("name"), 2), values(
+		&pb.Posting{Uid: 5, Value: []byte("Robert"), PostingType: pb.Posting_VALUE_LANG,
+			LangTag: []byte("fr")},
+		value(math.MaxUint64, pb.Posting_STRING, []byte("Bob"))), posting.BitCompletePosting)
+	require.NoError(t, txn.CommitAt(5, nil))
+
+	dir := t.TempDir()
+	files, err := exportInternal(context.Background(), &pb.ExportRequest{ReadTs: 10, GroupId: 1,
+		Namespace: math.MaxUint64, Format: "parquet", Destination: dir}, db, true)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, "g01.0x0.Person.parquet", filepath.Base(files[2]))
+
+	b, err := os.ReadFile(filepath.Join(dir, files[2]))
+	require.NoError(t, err)
+	f, err := parquet.OpenFile(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	r := parquet.NewReader(f)
+	var rows []map[string]any
+	for {
+		row := make(map[string]any)
+		if err := r.Read(&row); err == io.EOF {
+			break
+		} else {
+			require.NoError(t, err)
+		}
+		rows = append(rows, row)
+	}
+	require.Equal(t, []map[string]any{
+		{"uid": "0x1", "name": "Alice", "age": int64(30), "born": born.UnixMicro(),
+			"tags": []any{"a", "b"}, "friend": []any{"0x2"}},
+		{"uid": "0x2", "name": "Bob", "age": nil, "born": nil, "tags": []any{}, "friend": []any{}},
+	}, rows)
+}
@@ -0,0 +1,252 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// facetValTypes are the types a value given to the facet function is converted to. A facet
+// can hold a value of any of them, so the index is looked up for each type the value converts
+// to, the same way facet filters compare values.
+var facetValTypes = []api.Facet_ValType{
+	api.Facet_INT, api.Facet_FLOAT, api.Facet_BOOL, api.Facet_DATETIME, api.Facet_STRING,
+}
+
+// facetTokenRange is a range of tokens of a facet index. An empty hi means the range goes up
+// to the last token with the prefix.
+type facetTokenRange struct {
+	prefix         string
+	lo, hi         string
+	loIncl, hiIncl bool
+}
+
+func (r facetTokenRange) contains(token string) bool {
+	if !strings.HasPrefix(token, r.prefix) {
+		return false
+	}
+	if c := strings.Compare(token, r.lo); c < 0 || (c == 0 && !r.loIncl) {
+		return false
+	}
+	if r.hi == "" {
+		return true
+	}
+	c := strings.Compare(token, r.hi)
+	return c < 0 || (c == 0 && r.hiIncl)
+}
+
+func (r facetTokenRange) isPoint() bool {
+	return r.lo == r.hi && r.loIncl && r.hiIncl
+}
+
+// facetQuery holds the arguments of the facet function,
+// facet(predicate, facet, comparison, values...).
+type facetQuery struct {
+	facet  string
+	ranges []facetTokenRange
+}
+
+func (fq *facetQuery) matches(fs []*api.Facet) (bool, error) {
+	for _, f := range fs {
+		if f.Key != fq.facet {
+			continue
+		}
+		token, err := posting.FacetIndexToken(f)
+		if err != nil {
+			return false, err
+		}
+		for _, r := range fq.ranges {
+			if r.contains(token) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func parseFacetQuery(ctx context.Context, q *pb.Query) (*facetQuery, error) {
+	args := q.SrcFunc.Args
+	if len(args) < 3 {
+		return nil, errors.Errorf("Function facet expects a facet, a comparison and a value, got %d"+
+			" arguments", len(args))
+	}
+	fq := &facetQuery{facet: args[0]}
+	op, vals := strings.ToLower(args[1]), args[2:]
+	switch {
+	case op == eq:
+	case op == between && len(vals) == 2:
+	case (op == "le" || op == "lt" || op == "ge" || op == "gt") && len(vals) == 1:
+	default:
+		return nil, errors.Errorf("Invalid comparison %s with %d values in function facet",
+			args[1], len(vals))
+	}
+	if !slices.Contains(schema.State().FacetIndexes(ctx, q.Attr), fq.facet) {
+		return nil, errors.Errorf("Facet %s of predicate %s is not indexed", fq.facet,
+			x.ParseAttr(q.Attr))
+	}
+
+	for _, vt := range facetValTypes {
+		tokens := make([]string, 0, len(vals))
+		for _, val := range vals {
+			token, err := facetValueToken(fq.facet, val, vt)
+			if err != nil {
+				break
+			}
+			tokens = append(tokens, token)
+		}
+		if len(tokens) != len(vals) {
+			// The value can't be held by a facet of this type.
+			continue
+		}
+
+		prefix := posting.FacetIndexPrefix(fq.facet, vt)
+		switch op {
+		case eq:
+			for _, token := range tokens {
+				fq.ranges = append(fq.ranges, facetTokenRange{
+					prefix: prefix, lo: token, hi: token, loIncl: true, hiIncl: true})
+			}
+		case between:
+			fq.ranges = append(fq.ranges, facetTokenRange{
+				prefix: prefix, lo: tokens[0], hi: tokens[1], loIncl: true, hiIncl: true})
+		case "le", "lt":
+			fq.ranges = append(fq.ranges, facetTokenRange{
+				prefix: prefix, lo: prefix, hi: tokens[0], loIncl: true, hiIncl: op == "le"})
+		case "ge", "gt":
+			fq.ranges = append(fq.ranges, facetTokenRange{
+				prefix: prefix, lo: tokens[0], loIncl: op == "ge"})
+		}
+	}
+	return fq, nil
+}
+
+func facetValueToken(facet, val string, vt api.Facet_ValType) (string, error) {
+	tid, err := facets.TypeIDFor(&api.Facet{ValType: vt})
+	if err != nil {
+		return "", err
+	}
+	v, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(val)}, tid)
+	if err != nil {
+		return "", err
+	}
+	f, err := facets.ToBinary(facet, v.Value, vt)
+	if err != nil {
+		return "", err
+	}
+	return posting.FacetIndexToken(f)
+}
+
+// facetIndexTokens returns the tokens in the facet index of the predicate that fall within
+// the range.
+func facetIndexTokens(readTs uint64, attr string, r facetTokenRange) ([]string, error) {
+	if r.isPoint() {
+		return []string{r.lo}, nil
+	}
+
+	// Like for inequality functions, the index keys written by the ongoing transaction
+	// aren't seen here.
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.IndexKey(attr, r.prefix)
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	var tokens []string
+	for itr.Seek(x.IndexKey(attr, r.lo)); itr.Valid(); itr.Next() {
+		pk, err := x.Parse(itr.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		if r.hi != "" && pk.Term > r.hi {
+			break
+		}
+		if r.contains(pk.Term) {
+			tokens = append(tokens, pk.Term)
+		}
+	}
+	return tokens, nil
+}
+
+// handleFacetFunction finds the subjects having an edge of the predicate with a facet value
+// matching the function using the facet index. For a reverse predicate, it finds the objects
+// of those edges instead.
+func (qs *queryState) handleFacetFunction(ctx context.Context, q *pb.Query, out *pb.Result,
+	fq *facetQuery) error {
+
+	var lists []*pb.List
+	for _, r := range fq.ranges {
+		tokens, err := facetIndexTokens(q.ReadTs, q.Attr, r)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			pl, err := qs.cache.Get(x.IndexKey(q.Attr, token))
+			if err != nil {
+				return err
+			}
+			uids, err := pl.Uids(posting.ListOptions{ReadTs: q.ReadTs})
+			if err != nil {
+				return err
+			}
+			lists = append(lists, uids)
+		}
+	}
+	result := algo.MergeSorted(lists)
+
+	if q.Reverse {
+		objects := make(map[uint64]struct{})
+		for _, uid := range result.Uids {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			pl, err := qs.cache.Get(x.DataKey(q.Attr, uid))
+			if err != nil {
+				return err
+			}
+			err = pl.Iterate(q.ReadTs, 0, func(p *pb.Posting) error {
+				ok, err := fq.matches(p.Facets)
+				if ok {
+					objects[p.Uid] = struct{}{}
+				}
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+		result = &pb.List{Uids: make([]uint64, 0, len(objects))}
+		for uid := range objects {
+			result.Uids = append(result.Uids, uid)
+		}
+		sort.Slice(result.Uids, func(i, j int) bool { return result.Uids[i] < result.Uids[j] })
+	}
+
+	if q.UidList != nil {
+		filtered := &pb.List{}
+		algo.IntersectWith(result, q.UidList, filtered)
+		result = filtered
+	}
+	out.UidMatrix = append(out.UidMatrix, result)
+	return nil
+}
@@ -0,0 +1,31 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
+)
+
+func TestFacetValueToken(t *testing.T) {
+	// The values of the facet function get the tokens of the facets holding the same values.
+	for val, arg := range map[string]string{
+		"true": "true", "12": "12", "1.5": "1.5", "2019-01-01T00:00:00": "2019-01-01",
+		`"abc"`: "abc",
+	} {
+		f, err := facets.FacetFor("since", val)
+		require.NoError(t, err)
+		want, err := posting.FacetIndexToken(f)
+		require.NoError(t, err)
+		got, err := facetValueToken("since", arg, f.ValType)
+		require.NoError(t, err)
+		require.Equal(t, want, got, arg)
+	}
+}
@@ -216,6 +216,10 @@ func (g *groupi) applyInitialSchema() {
 	}
 
 	for _, s := range initialSchema {
+		// Keep the strict mode of the namespace, which is stored on dgraph.type.
+		if curr, ok := schema.State().Get(ctx, s.Predicate); ok && curr.GetStrict() {
+			s.Strict = true
+		}
 		if gid, err := g.BelongsToReadOnly(s.Predicate, 0); err != nil {
 			glog.Errorf("Error getting tablet for predicate %s. Will force schema proposal.",
 				s.Predicate)
@@ -173,7 +173,8 @@ Loop:
 	return nil
 }
 
-func (ps *pubSub) runLocalSubscriber(ctx context.Context, stream pb.Worker_StreamExtSnapshotServer) error {
+func (ps *pubSub) runLocalSubscriber(ctx context.Context, stream pb.Worker_StreamExtSnapshotServer,
+	incremental bool) error {
 	defer func() {
 		glog.Infof("[import] local subscriber stopped")
 	}()
@@ -182,10 +183,18 @@ func (ps *pubSub) runLocalSubscriber(ctx context.Context, stream pb.Worker_Strea
 	defer ps.unsubscribe(buffer) // ensure publisher won't block on us if we exit
 	glog.Infof("[import:flush] flushing external snapshot in badger db")
 
-	sw := pstore.NewStreamWriter()
-	defer sw.Cancel()
-	if err := sw.Prepare(); err != nil {
-		return err
+	// An incremental snapshot is written on top of the existing data, while the other ones
+	// replace it.
+	var writer badgerWriter
+	if incremental {
+		writer = pstore.NewManagedWriteBatch()
+	} else {
+		sw := pstore.NewStreamWriter()
+		defer sw.Cancel()
+		if err := sw.Prepare(); err != nil {
+			return err
+		}
+		writer = sw
 	}
 
 Loop:
@@ -209,13 +218,13 @@ Loop:
 			}
 
 			buf := z.NewBufferSlice(kvs.Data)
-			if err := sw.Write(buf); err != nil {
+			if err := writer.Write(buf); err != nil {
 				return err
 			}
 		}
 	}
 
-	if err := sw.Flush(); err != nil {
+	if err := writer.Flush(); err != nil {
 		return err
 	}
 
@@ -278,9 +287,11 @@ func InStream(stream api.Dgraph_StreamExtSnapshotServer) error {
 	}
 
 	groupId := req.GroupId
+	incremental := x.IsIncrementalSnapshot(stream.Context())
 	if groupId == groups().Node.gid {
-		glog.Infof("[import] streaming external snapshot to current group [%v]", groupId)
-		return streamInGroup(stream, true)
+		glog.Infof("[import] streaming external snapshot to current group [%v], incremental [%v]",
+			groupId, incremental)
+		return streamInGroup(stream, true, incremental)
 	}
 
 	glog.Infof("[import] streaming external snapshot to other group [%v]", groupId)
@@ -292,7 +303,11 @@ func InStream(stream api.Dgraph_StreamExtSnapshotServer) error {
 
 	con := pl.Get()
 	c := pb.NewWorkerClient(con)
-	alphaStream, err := c.StreamExtSnapshot(stream.Context())
+	ctx := stream.Context()
+	if incremental {
+		ctx = x.AttachIncrementalSnapshot(ctx)
+	}
+	alphaStream, err := c.StreamExtSnapshot(ctx)
 	if err != nil {
 		glog.Errorf("[import] failed to establish stream with leader: %v", err)
 		return fmt.Errorf("failed to establish stream with leader: %v", err)
@@ -412,7 +427,7 @@ func (w *grpcWorker) StreamExtSnapshot(stream pb.Worker_StreamExtSnapshotServer)
 	}
 
 	glog.Infof("[import] received forward flag: %v", forwardReq.Forward)
-	return streamInGroup(stream, forwardReq.Forward)
+	return streamInGroup(stream, forwardReq.Forward, x.IsIncrementalSnapshot(stream.Context()))
 }
 
 // postStreamProcessing handles the post-stream processing of data received from the buffer into the local BadgerDB.
@@ -444,6 +459,7 @@ func postStreamProcessing(ctx context.Context) error {
 //
 // Parameters:
 // - stream: The gRPC stream server for receiving streaming data
+// - incremental: Indicates if the data is added to the existing data instead of replacing it
 // - forward: Indicates if this node is forwarding data to other nodes
 //   - true: This node is the group leader and will forward data to group members
 //   - false: This node is a follower receiving forwarded data and storing locally
@@ -460,7 +476,7 @@ func postStreamProcessing(ctx context.Context) error {
 // Returns:
 // - nil: If streaming completes successfully
 // - error: If there's an issue receiving data or if majority consensus isn't achieved (for leader)
-func streamInGroup(stream api.Dgraph_StreamExtSnapshotServer, forward bool) error {
+func streamInGroup(stream api.Dgraph_StreamExtSnapshotServer, forward, incremental bool) error {
 	node := groups().Node
 	glog.Infof("[import] got stream, forwarding in group [%v]", forward)
 
@@ -472,7 +488,7 @@ func streamInGroup(stream api.Dgraph_StreamExtSnapshotServer, forward bool) erro
 	for _, member := range groups().state.Groups[node.gid].Members {
 		if member.Addr == node.MyAddr {
 			eg.Go(func() error {
-				if err := ps.runLocalSubscriber(errGCtx, stream); err != nil {
+				if err := ps.runLocalSubscriber(errGCtx, stream, incremental); err != nil {
 					glog.Errorf("[import:flush] failed to run local subscriber: %v", err)
 					updateNodeStatus(&ps.RWMutex, successfulNodes, member.Addr, false)
 					return err
@@ -503,7 +519,11 @@ func streamInGroup(stream api.Dgraph_StreamExtSnapshotServer, forward bool) erro
 				}
 
 				c := pb.NewWorkerClient(pl.Get())
-				peerStream, err := c.StreamExtSnapshot(errGCtx)
+				peerCtx := errGCtx
+				if incremental {
+					peerCtx = x.AttachIncrementalSnapshot(peerCtx)
+				}
+				peerStream, err := c.StreamExtSnapshot(peerCtx)
 				if err != nil {
 					updateNodeStatus(&ps.RWMutex, successfulNodes, member.Addr, false)
 					glog.Errorf("failed to establish stream with peer %v: %v", member.Addr, err)
@@ -0,0 +1,131 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/algo"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// ipQuery holds the argument of the CIDR containment functions. ip_within(predicate, network)
+// finds the addresses and networks lying within the network, and ip_contains(predicate, ip) finds
+// the networks containing the address or network.
+type ipQuery struct {
+	within bool
+	ip     netip.Prefix
+}
+
+func parseIPQuery(ctx context.Context, q *pb.Query, fname string) (*ipQuery, error) {
+	if err := ensureArgsCount(q.SrcFunc, 1); err != nil {
+		return nil, err
+	}
+	attr := x.ParseAttr(q.Attr)
+	if typ, err := schema.State().TypeOf(q.Attr); err != nil || typ != types.IPID {
+		return nil, errors.Errorf("Function %s requires predicate %s to be of type ip", fname, attr)
+	}
+	if !schema.State().HasTokenizer(ctx, tok.IdentIP, q.Attr) {
+		return nil, errors.Errorf("Function %s requires an ip index on predicate %s", fname, attr)
+	}
+	ip, err := types.ParseIP(q.SrcFunc.Args[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid argument of function %s", fname)
+	}
+	return &ipQuery{within: fname == "ip_within", ip: ip}, nil
+}
+
+func ipToken(ip netip.Prefix) string {
+	return string([]byte{tok.IdentIP}) + string(types.IPToBinary(ip))
+}
+
+// ipIndexTokens returns the tokens of the ip index of the predicate matching the query.
+func ipIndexTokens(readTs uint64, attr string, iq *ipQuery) ([]string, error) {
+	if !iq.within {
+		// A network contains the address or the network if it is one of the networks having
+		// the same first bits, which are few enough to be looked up one by one.
+		tokens := make([]string, 0, iq.ip.Bits()+1)
+		for bits := 0; bits <= iq.ip.Bits(); bits++ {
+			tokens = append(tokens, ipToken(netip.PrefixFrom(iq.ip.Addr(), bits).Masked()))
+		}
+		return tokens, nil
+	}
+
+	// The addresses and networks within the network have their tokens between the ones of the
+	// network itself and of its last address.
+	lo, hi := ipToken(iq.ip), ipToken(types.IPLast(iq.ip))
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.IndexKey(attr, lo[:2])
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	var tokens []string
+	for itr.Seek(x.IndexKey(attr, lo)); itr.Valid(); itr.Next() {
+		pk, err := x.Parse(itr.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		if pk.Term > hi {
+			break
+		}
+		ip, err := types.IPFromBinary([]byte(pk.Term[1:]))
+		if err != nil {
+			return nil, err
+		}
+		if types.IPWithin(ip, iq.ip) {
+			tokens = append(tokens, pk.Term)
+		}
+	}
+	return tokens, nil
+}
+
+// handleIPFunction finds the subjects having a value of the predicate matching the CIDR
+// containment function using the ip index.
+func (qs *queryState) handleIPFunction(ctx context.Context, q *pb.Query, out *pb.Result,
+	iq *ipQuery) error {
+
+	tokens, err := ipIndexTokens(q.ReadTs, q.Attr, iq)
+	if err != nil {
+		return err
+	}
+	lists := make([]*pb.List, 0, len(tokens))
+	for _, token := range tokens {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pl, err := qs.cache.Get(x.IndexKey(q.Attr, token))
+		if err != nil {
+			return err
+		}
+		uids, err := pl.Uids(posting.ListOptions{ReadTs: q.ReadTs})
+		if err != nil {
+			return err
+		}
+		lists = append(lists, uids)
+	}
+	result := algo.MergeSorted(lists)
+
+	if q.UidList != nil {
+		filtered := &pb.List{}
+		algo.IntersectWith(result, q.UidList, filtered)
+		result = filtered
+	}
+	out.UidMatrix = append(out.UidMatrix, result)
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// jsonPathQuery holds the arguments of json_path(predicate, path, value). Without a value, the
+// function finds the documents having a value at the path. With a value, the value at the path
+// must be equal to it, or be an array containing it.
+type jsonPathQuery struct {
+	path []string
+	// val is the canonical encoding of the value, if any.
+	val    *string
+	scalar bool
+}
+
+func parseJSONPathQuery(q *pb.Query) (*jsonPathQuery, error) {
+	args := q.SrcFunc.Args
+	if len(args) != 1 && len(args) != 2 {
+		return nil, errors.Errorf("Function json_path expects a path and an optional value, got %d"+
+			" arguments", len(args))
+	}
+	if typ, err := schema.State().TypeOf(q.Attr); err != nil || typ != types.JSONID {
+		return nil, errors.Errorf("Function json_path requires predicate %s to be of type json",
+			x.ParseAttr(q.Attr))
+	}
+	path, err := types.ParseJSONPath(args[0])
+	if err != nil {
+		return nil, err
+	}
+	jq := &jsonPathQuery{path: path}
+	if len(args) == 2 {
+		val, scalar := types.JSONQueryValue(args[1])
+		jq.val, jq.scalar = &val, scalar
+	}
+	return jq, nil
+}
+
+// indexToken returns the token of the path index under which the matching documents are found.
+// When the value isn't a scalar, the documents having a value at the path are found instead, and
+// their values must be checked.
+func (jq *jsonPathQuery) indexToken() (string, bool) {
+	if jq.val != nil && jq.scalar {
+		return string([]byte{tok.IdentJSONPath}) + types.JSONPathValueToken(jq.path, *jq.val), false
+	}
+	return string([]byte{tok.IdentJSONPath}) + types.JSONPathToken(jq.path), jq.val != nil
+}
+
+func (jq *jsonPathQuery) matches(pl *posting.List, readTs uint64) (bool, error) {
+	var found bool
+	err := pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+		if p.ValType != pb.Posting_JSON {
+			return nil
+		}
+		ok, err := types.JSONPathMatch(string(p.Value), jq.path, jq.val)
+		if ok {
+			found = true
+			return posting.ErrStopIteration
+		}
+		return err
+	})
+	return found, err
+}
+
+// jsonPathSubjects returns the subjects having a value of the predicate.
+func jsonPathSubjects(readTs uint64, attr string) (*pb.List, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	pk := x.ParsedKey{Attr: attr}
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = pk.DataPrefix()
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	out := &pb.List{}
+	for it.Rewind(); it.Valid(); it.Next() {
+		pk, err := x.Parse(it.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		if n := len(out.Uids); n == 0 || out.Uids[n-1] != pk.Uid {
+			out.Uids = append(out.Uids, pk.Uid)
+		}
+	}
+	return out, nil
+}
+
+// handleJSONPathFunction finds the subjects having a JSON document matching the json_path
+// function. The path index is used if the predicate has one. Otherwise the documents of the
+// subjects being filtered, or of all the subjects at the root of a query, are read.
+func (qs *queryState) handleJSONPathFunction(ctx context.Context, q *pb.Query, out *pb.Result,
+	jq *jsonPathQuery) error {
+
+	var candidates *pb.List
+	switch {
+	case schema.State().HasTokenizer(ctx, tok.IdentJSONPath, q.Attr):
+		token, check := jq.indexToken()
+		pl, err := qs.cache.Get(x.IndexKey(q.Attr, token))
+		if err != nil {
+			return err
+		}
+		uids, err := pl.Uids(posting.ListOptions{ReadTs: q.ReadTs, Intersect: q.UidList})
+		if err != nil {
+			return err
+		}
+		if !check {
+			out.UidMatrix = append(out.UidMatrix, uids)
+			return nil
+		}
+		candidates = uids
+	case q.UidList != nil:
+		candidates = q.UidList
+	default:
+		uids, err := jsonPathSubjects(q.ReadTs, q.Attr)
+		if err != nil {
+			return err
+		}
+		candidates = uids
+	}
+
+	result := &pb.List{}
+	for _, uid := range candidates.Uids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pl, err := qs.cache.Get(x.DataKey(q.Attr, uid))
+		if err != nil {
+			return err
+		}
+		ok, err := jq.matches(pl, q.ReadTs)
+		if err != nil {
+			return err
+		}
+		if ok {
+			result.Uids = append(result.Uids, uid)
+		}
+	}
+	out.UidMatrix = append(out.UidMatrix, result)
+	return nil
+}
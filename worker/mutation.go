@@ -8,11 +8,15 @@ package worker
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -92,8 +96,8 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	case len(edge.Lang) == 0 && !isList:
 		// Scalar Predicates, without lang
 		getFn = txn.GetScalarList
-	case len(edge.Lang) > 0 || su.GetCount():
-		// Language or Count Index
+	case len(edge.Lang) > 0 || su.GetCount() || len(su.GetFacetIndex()) > 0:
+		// Language, Count or Facet Index. The facet index needs the facets of the other edges.
 		getFn = txn.Get
 	case edge.Op == pb.DirectedEdge_DEL:
 		// Covers various delete cases to keep things simple.
@@ -273,6 +277,11 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		} else if err := updateSchema(su, rebuild.StartTs); err != nil {
 			return err
 		}
+
+		if su.Embedding != nil && !proto.Equal(su.Embedding, old.GetEmbedding()) &&
+			gr.Node.AmLeader() {
+			go backfillEmbeddings(su.Predicate, su.Embedding)
+		}
 	}
 
 	return nil
@@ -331,7 +340,11 @@ func createSchema(attr string, typ types.TypeID, hint pb.Metadata_HintType, ts u
 func runTypeMutation(ctx context.Context, update *pb.TypeUpdate, ts uint64) error {
 	current := proto.Clone(update).(*pb.TypeUpdate)
 	schema.State().SetType(update.TypeName, current)
-	return updateType(update.TypeName, update, ts)
+	if err := updateType(update.TypeName, update, ts); err != nil {
+		return err
+	}
+	buildCompositeIndexes(current)
+	return nil
 }
 
 // We commit schema to disk in blocking way, should be ok because this happens
@@ -503,6 +516,67 @@ func validateSchemaForUnique(prevSchema pb.SchemaUpdate, currentSchema *pb.Schem
 	return errors.Errorf("@unique directive not supported on [%v] type predicate", currentSchema.ValueType.String())
 }
 
+// checkRegexps caches the compiled regular expressions of the @check directives.
+var checkRegexps sync.Map
+
+// checkValue verifies that the value of the edge satisfies the @check directive of
+// its predicate, if any.
+func checkValue(edge *pb.DirectedEdge, check *pb.CheckSpec, val types.Val) error {
+	if check == nil {
+		return nil
+	}
+	fail := func(format string, args ...interface{}) error {
+		return errors.Errorf("Value of nquad <%#x> <%s> %q violates @check: %s", edge.Entity,
+			x.ParseAttr(edge.Attr), fmt.Sprintf("%v", val.Value), fmt.Sprintf(format, args...))
+	}
+
+	if check.Min != "" {
+		lo, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(check.Min)}, val.Tid)
+		if err != nil {
+			return err
+		}
+		if types.CompareVals("lt", val, lo) {
+			return fail("less than min %s", check.Min)
+		}
+	}
+	if check.Max != "" {
+		hi, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(check.Max)}, val.Tid)
+		if err != nil {
+			return err
+		}
+		if types.CompareVals("gt", val, hi) {
+			return fail("greater than max %s", check.Max)
+		}
+	}
+
+	if str, ok := val.Value.(string); ok {
+		if check.MaxLength > 0 && int64(utf8.RuneCountInString(str)) > check.MaxLength {
+			return fail("longer than maxlen %d", check.MaxLength)
+		}
+		if check.Regex != "" {
+			re, ok := checkRegexps.Load(check.Regex)
+			if !ok {
+				compiled, err := regexp.Compile(check.Regex)
+				if err != nil {
+					return err
+				}
+				re, _ = checkRegexps.LoadOrStore(check.Regex, compiled)
+			}
+			if !re.(*regexp.Regexp).MatchString(str) {
+				return fail("does not match regex %q", check.Regex)
+			}
+		}
+		if len(check.Allowed) > 0 && !slices.Contains(check.Allowed, str) {
+			return fail("not one of enum [%s]", strings.Join(check.Allowed, ","))
+		}
+	}
+
+	if vec, ok := val.Value.([]float32); ok && check.Dims > 0 && int64(len(vec)) != check.Dims {
+		return fail("has %d dimensions instead of dims %d", len(vec), check.Dims)
+	}
+	return nil
+}
+
 // ValidateAndConvert checks compatibility or converts to the schema type if the storage type is
 // specified. If no storage type is specified then it converts to the schema type.
 func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
@@ -547,7 +621,14 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 
 	// The suggested storage type matches the schema, OK! (Nothing to do ...)
 	case storageType == schemaType && schemaType != types.DefaultID:
-		return nil
+		if su.GetCheck() == nil {
+			return nil
+		}
+		val, err := types.Convert(types.Val{Tid: storageType, Value: edge.Value}, schemaType)
+		if err != nil {
+			return err
+		}
+		return checkValue(edge, su.GetCheck(), val)
 
 	// We accept the storage type iff we don't have a schema type and a storage type is specified.
 	case schemaType == types.DefaultID:
@@ -565,6 +646,9 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	if dst, err = types.Convert(src, schemaType); err != nil {
 		return err
 	}
+	if err := checkValue(edge, su.GetCheck(), dst); err != nil {
+		return err
+	}
 
 	// convert to schema type
 	b := types.ValueForType(types.BinaryID)
@@ -742,6 +826,25 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 		}
 	}
 
+	// So is the history of the schema.
+	if src.SchemaChange != nil {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.SchemaChange = src.SchemaChange
+		}
+	}
+
+	// The nodes touched in strict namespaces are kept by the groups the edges go to.
+	for _, mu := range mm {
+		if len(mu.Edges) > 0 {
+			mu.StrictNodes = src.StrictNodes
+		}
+	}
+
 	return mm, nil
 }
 
@@ -760,6 +863,17 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	if err := verifyTypes(ctx, m); err != nil {
 		return tctx, err
 	}
+	touched, err := verifyStrict(ctx, m)
+	if err != nil {
+		return tctx, err
+	}
+	m.StrictNodes = strictNodes(touched)
+	if err := addTupleIndexEdges(ctx, m); err != nil {
+		return tctx, err
+	}
+	if err := setMutationExpiry(ctx, m); err != nil {
+		return tctx, err
+	}
 	mutationMap, err := populateMutationMap(m)
 	if err != nil {
 		return tctx, err
@@ -832,8 +946,22 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 		return errors.Wrapf(err, "cannot retrieve predicate information")
 	}
 	schemaSet := make(map[string]struct{})
+	listSet := make(map[string]struct{})
+	typeSet := make(map[string]types.TypeID)
 	for _, schemaNode := range schemas {
 		schemaSet[schemaNode.Predicate] = struct{}{}
+		if schemaNode.List {
+			listSet[schemaNode.Predicate] = struct{}{}
+		}
+		if tid, ok := types.TypeForName(schemaNode.Type); ok {
+			typeSet[schemaNode.Predicate] = tid
+		}
+	}
+	for _, schemaUpdate := range m.Schema {
+		if schemaUpdate.List {
+			listSet[schemaUpdate.Predicate] = struct{}{}
+		}
+		typeSet[schemaUpdate.Predicate] = types.TypeID(schemaUpdate.ValueType)
 	}
 
 	for _, t := range m.Types {
@@ -854,6 +982,30 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 					field.Predicate, t.TypeName)
 			}
 		}
+
+		// The combined values of composite unique keys are built from single values.
+		for _, key := range t.UniqueKeys {
+			for _, pred := range key.Predicates {
+				if _, ok := listSet[pred]; ok {
+					return errors.Errorf("Field %s of list type cannot be part of @unique in type %s",
+						x.ParseAttr(pred), x.ParseAttr(t.TypeName))
+				}
+			}
+		}
+
+		// The components of composite indexes are tokenized from single values.
+		for _, index := range t.CompositeIndexes {
+			for _, pred := range index.Predicates {
+				if _, ok := listSet[pred]; ok {
+					return errors.Errorf("Field %s of list type cannot be part of @index in type %s",
+						x.ParseAttr(pred), x.ParseAttr(t.TypeName))
+				}
+				if tid, ok := typeSet[pred]; ok && !posting.IsCompositeIndexType(tid) {
+					return errors.Errorf("Field %s of type %s cannot be part of @index in type %s",
+						x.ParseAttr(pred), tid.Name(), x.ParseAttr(t.TypeName))
+				}
+			}
+		}
 	}
 
 	return nil
@@ -895,6 +1047,20 @@ func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error)
 		clientDiscard = true
 	}
 
+	// Transactions that touched nodes in strict namespaces are aborted if the nodes
+	// are left without their required fields.
+	var strictErr error
+	if !tc.Aborted {
+		touched, err := fetchStrictNodes(ctx, tc.StartTs)
+		if err == nil && len(touched) > 0 {
+			err = verifyRequiredFields(ctx, tc.StartTs, touched)
+		}
+		if err != nil {
+			strictErr = err
+			tc.Aborted = true
+		}
+	}
+
 	pl := groups().Leader(0)
 	if pl == nil {
 		return 0, conn.ErrNoConnection
@@ -921,6 +1087,9 @@ func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error)
 			// The server aborted the txn (not the client)
 			ostats.Record(ctx, x.TxnAborts.M(1))
 		}
+		if strictErr != nil {
+			return 0, strictErr
+		}
 		return 0, dgo.ErrAborted
 	}
 	ostats.Record(ctx, x.TxnCommits.M(1))
@@ -946,6 +1115,7 @@ func (w *grpcWorker) proposeAndWait(ctx context.Context, txnCtx *api.TxnContext,
 		return err
 	}
 
+	setSchemaExpiry(m)
 	node := groups().Node
 	err := node.proposeAndWait(ctx, &pb.Proposal{Mutations: m})
 	// When we are filling txn context, we don't need to update latest delta if the transaction has failed.
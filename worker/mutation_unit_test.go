@@ -201,6 +201,61 @@ func TestValidateEdgeTypeError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestValidateEdgeCheck(t *testing.T) {
+	intVal := func(i int64) []byte {
+		b := types.ValueForType(types.BinaryID)
+		require.NoError(t, types.Marshal(types.Val{Tid: types.IntID, Value: i}, &b))
+		return b.Value.([]byte)
+	}
+	age := &pb.SchemaUpdate{
+		ValueType: pb.Posting_INT,
+		Check:     &pb.CheckSpec{Min: "0", Max: "150"},
+	}
+	name := &pb.SchemaUpdate{
+		ValueType: pb.Posting_STRING,
+		Check:     &pb.CheckSpec{MaxLength: 5, Regex: "^[a-z]+$"},
+	}
+	color := &pb.SchemaUpdate{
+		ValueType: pb.Posting_STRING,
+		Check:     &pb.CheckSpec{Allowed: []string{"red", "green"}},
+	}
+	vec := &pb.SchemaUpdate{
+		ValueType: pb.Posting_VFLOAT,
+		Check:     &pb.CheckSpec{Dims: 3},
+	}
+
+	tests := []struct {
+		edge *pb.DirectedEdge
+		su   *pb.SchemaUpdate
+		err  string
+	}{
+		{edge: &pb.DirectedEdge{Value: []byte("30")}, su: age},
+		{edge: &pb.DirectedEdge{Value: []byte("200")}, su: age, err: "greater than max 150"},
+		{edge: &pb.DirectedEdge{Value: intVal(-1), ValueType: pb.Posting_INT}, su: age,
+			err: "less than min 0"},
+		{edge: &pb.DirectedEdge{Value: []byte("alice")}, su: name},
+		{edge: &pb.DirectedEdge{Value: []byte("alexandra")}, su: name, err: "longer than maxlen 5"},
+		{edge: &pb.DirectedEdge{Value: []byte("Bob"), ValueType: pb.Posting_STRING}, su: name,
+			err: `does not match regex "^[a-z]+$"`},
+		{edge: &pb.DirectedEdge{Value: []byte("red")}, su: color},
+		{edge: &pb.DirectedEdge{Value: []byte("blue")}, su: color, err: "not one of enum [red,green]"},
+		{edge: &pb.DirectedEdge{Value: []byte("[1, 2, 3]")}, su: vec},
+		{edge: &pb.DirectedEdge{Value: []byte("[1, 2]")}, su: vec,
+			err: "has 2 dimensions instead of dims 3"},
+	}
+	for _, tc := range tests {
+		tc.edge.Entity = 0x1
+		tc.edge.Attr = x.AttrInRootNamespace("pred")
+		err := ValidateAndConvert(tc.edge, tc.su)
+		if tc.err == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.ErrorContains(t, err, "Value of nquad <0x1> <pred>")
+		require.ErrorContains(t, err, tc.err)
+	}
+}
+
 func TestTypeSanityCheck(t *testing.T) {
 	// Empty field name check.
 	typeDef := &pb.TypeUpdate{
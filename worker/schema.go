@@ -7,6 +7,7 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -46,7 +47,8 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert", "unique",
-			"lang", "noconflict", "vector_specs"}
+			"lang", "noconflict", "vector_specs", "embedding", "strict", "check", "where",
+			"facet_index", "ttl"}
 	}
 
 	myGid := groups().groupId()
@@ -110,6 +112,20 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.NoConflict = pred.GetNoConflict()
 		case "vector_specs":
 			schemaNode.IndexSpecs = pred.GetIndexSpecs()
+		case "embedding":
+			schemaNode.Embedding = pred.GetEmbedding()
+		case "strict":
+			schemaNode.Strict = pred.GetStrict()
+		case "check":
+			schemaNode.Check = pred.GetCheck()
+		case "where":
+			schemaNode.Where = pred.GetWhere()
+		case "facet_index":
+			schemaNode.FacetIndex = pred.GetFacetIndex()
+		case "ttl":
+			if ttl := pred.GetTtl(); ttl > 0 {
+				schemaNode.Ttl = (time.Duration(ttl) * time.Second).String()
+			}
 		default:
 			//pass
 		}
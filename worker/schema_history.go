@@ -0,0 +1,283 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// The history of the schema of a namespace is kept as a list of changes, each stored under a
+// schema history key with the version of the schema resulting from it. The changes are sent to
+// all the groups, so that any alpha can serve the history.
+
+// NewSchemaChange returns the change of the schema made by setting the predicates and types, and
+// by dropping the predicates and types named in dropPreds and dropTypes. It returns nil if the
+// schema stays the same.
+func NewSchemaChange(ctx context.Context, preds []*pb.SchemaUpdate, typs []*pb.TypeUpdate,
+	dropPreds, dropTypes []string) (*pb.SchemaChange, error) {
+
+	attrs := slices.Clone(dropPreds)
+	for _, su := range preds {
+		attrs = append(attrs, su.Predicate)
+	}
+	current, err := clusterSchema(ctx, attrs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the schema of the cluster")
+	}
+
+	change := &pb.SchemaChange{}
+	var names []string
+	for _, su := range preds {
+		old, ok := current[su.Predicate]
+		if ok && proto.Equal(old, su) {
+			continue
+		}
+		if ok {
+			change.OldPreds = append(change.OldPreds, old)
+		}
+		change.NewPreds = append(change.NewPreds, su)
+		names = append(names, su.Predicate)
+	}
+	for _, pred := range dropPreds {
+		if old, ok := current[pred]; ok {
+			change.OldPreds = append(change.OldPreds, old)
+			names = append(names, pred)
+		}
+	}
+	for _, tu := range typs {
+		old, ok := schema.State().GetType(tu.TypeName)
+		if ok && proto.Equal(&old, tu) {
+			continue
+		}
+		if ok {
+			change.OldTypes = append(change.OldTypes, &old)
+		}
+		change.NewTypes = append(change.NewTypes, tu)
+		names = append(names, tu.TypeName)
+	}
+	for _, typ := range dropTypes {
+		if old, ok := schema.State().GetType(typ); ok {
+			change.OldTypes = append(change.OldTypes, &old)
+			names = append(names, typ)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+	change.Namespace = x.ParseNamespace(names[0])
+	return change, nil
+}
+
+// clusterSchema returns the schema of the predicates in the cluster. The schema of a predicate is
+// only kept by the group serving it, so the predicates served by the other groups are read from
+// them. The predicates not in the cluster are left out.
+func clusterSchema(ctx context.Context, preds []string) (map[string]*pb.SchemaUpdate, error) {
+	current := make(map[string]*pb.SchemaUpdate)
+	var remote []string
+	for _, pred := range preds {
+		gid, err := groups().BelongsToReadOnly(pred, 0)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case gid == 0:
+		case groups().ServesGroup(gid):
+			if su, ok := schema.State().Get(ctx, pred); ok {
+				current[pred] = &su
+			}
+		default:
+			remote = append(remote, pred)
+		}
+	}
+	if len(remote) == 0 {
+		return current, nil
+	}
+
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Predicates: remote})
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		su, err := schemaUpdate(node)
+		if err != nil {
+			return nil, err
+		}
+		current[node.Predicate] = su
+	}
+	return current, nil
+}
+
+// schemaUpdate returns the schema of a predicate read from another group, as set by an alter
+// operation.
+func schemaUpdate(node *pb.SchemaNode) (*pb.SchemaUpdate, error) {
+	tid, ok := types.TypeForName(node.Type)
+	if !ok {
+		return nil, errors.Errorf("predicate %q has an unknown type %q",
+			x.ParseAttr(node.Predicate), node.Type)
+	}
+	su := &pb.SchemaUpdate{
+		Predicate:  node.Predicate,
+		ValueType:  tid.Enum(),
+		Count:      node.Count,
+		List:       node.List,
+		Upsert:     node.Upsert,
+		Lang:       node.Lang,
+		Unique:     node.Unique,
+		NoConflict: node.NoConflict,
+		IndexSpecs: node.IndexSpecs,
+		Embedding:  node.Embedding,
+		Strict:     node.Strict,
+		Check:      node.Check,
+		Where:      node.Where,
+		FacetIndex: node.FacetIndex,
+	}
+	switch {
+	case node.Reverse:
+		su.Directive = pb.SchemaUpdate_REVERSE
+	case len(node.IndexSpecs) > 0:
+		// The tokenizers of vector predicates are the names of their vector indexes.
+		su.Directive = pb.SchemaUpdate_INDEX
+	case node.Index:
+		su.Directive = pb.SchemaUpdate_INDEX
+		su.Tokenizer = node.Tokenizer
+	}
+	if node.Ttl != "" {
+		ttl, err := time.ParseDuration(node.Ttl)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading the ttl of predicate %q",
+				x.ParseAttr(node.Predicate))
+		}
+		su.Ttl = int64(ttl / time.Second)
+	}
+	return su, nil
+}
+
+// writeSchemaChange adds the change to the history of the schema of its namespace.
+func writeSchemaChange(change *pb.SchemaChange) error {
+	txn := pstore.NewTransactionAt(change.Version, true)
+	defer txn.Discard()
+	data, err := proto.Marshal(change)
+	x.Check(err)
+	e := &badger.Entry{
+		Key:      x.SchemaHistoryKey(change.Namespace, change.Version),
+		Value:    data,
+		UserMeta: posting.BitSchemaPosting,
+	}
+	if err := txn.SetEntry(e); err != nil {
+		return err
+	}
+	return txn.CommitAt(change.Version, nil)
+}
+
+// GetSchemaHistory returns the changes of the schema of the namespace, oldest first.
+func GetSchemaHistory(ns uint64) ([]*pb.SchemaChange, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.Prefix = x.SchemaHistoryPrefix(ns)
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	var history []*pb.SchemaChange
+	for it.Rewind(); it.Valid(); it.Next() {
+		change := &pb.SchemaChange{}
+		if err := it.Item().Value(func(val []byte) error {
+			return proto.Unmarshal(val, change)
+		}); err != nil {
+			return nil, err
+		}
+		history = append(history, change)
+	}
+	return history, nil
+}
+
+// SchemaRollback returns the definitions of the predicates and types at the given version of the
+// schema, for the ones changed after it. The types created after the version are returned in
+// dropTypes. The predicates created after it aren't returned, as dropping them would delete their
+// data.
+func SchemaRollback(history []*pb.SchemaChange, version uint64) (preds []*pb.SchemaUpdate,
+	typs []*pb.TypeUpdate, dropTypes []string, err error) {
+
+	found := false
+	seenPreds := make(map[string]struct{})
+	seenTypes := make(map[string]struct{})
+	for _, change := range history {
+		if change.Version == version {
+			found = true
+		}
+		if change.Version <= version {
+			continue
+		}
+
+		// The first change of a predicate or type after the version holds its definition at
+		// the version.
+		oldPreds := make(map[string]*pb.SchemaUpdate)
+		for _, su := range change.OldPreds {
+			oldPreds[su.Predicate] = su
+		}
+		for _, su := range slices.Concat(change.OldPreds, change.NewPreds) {
+			if _, ok := seenPreds[su.Predicate]; ok {
+				continue
+			}
+			seenPreds[su.Predicate] = struct{}{}
+			if old, ok := oldPreds[su.Predicate]; ok {
+				preds = append(preds, old)
+			}
+		}
+
+		oldTypes := make(map[string]*pb.TypeUpdate)
+		for _, tu := range change.OldTypes {
+			oldTypes[tu.TypeName] = tu
+		}
+		for _, tu := range slices.Concat(change.OldTypes, change.NewTypes) {
+			if _, ok := seenTypes[tu.TypeName]; ok {
+				continue
+			}
+			seenTypes[tu.TypeName] = struct{}{}
+			if old, ok := oldTypes[tu.TypeName]; ok {
+				typs = append(typs, old)
+			} else {
+				dropTypes = append(dropTypes, tu.TypeName)
+			}
+		}
+	}
+	if !found {
+		return nil, nil, nil, errors.Errorf("Schema version %d not found in the history", version)
+	}
+	return preds, typs, dropTypes, nil
+}
+
+// PredicateDefinition returns the definition of the predicate in the schema language.
+func PredicateDefinition(su *pb.SchemaUpdate) string {
+	return trimNamespace(toSchema(su.Predicate, su).Value)
+}
+
+// TypeDefinition returns the definition of the type in the schema language.
+func TypeDefinition(tu *pb.TypeUpdate) string {
+	return trimNamespace(toType(tu.TypeName, tu).Value)
+}
+
+// trimNamespace removes the namespace written before the exported definitions.
+func trimNamespace(def []byte) string {
+	_, rest, _ := strings.Cut(string(def), " ")
+	return strings.TrimSpace(rest)
+}
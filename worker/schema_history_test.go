@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestSchemaRollback(t *testing.T) {
+	name := x.AttrInRootNamespace("name")
+	age := x.AttrInRootNamespace("age")
+	person := x.AttrInRootNamespace("Person")
+	pet := x.AttrInRootNamespace("Pet")
+
+	name1 := &pb.SchemaUpdate{Predicate: name, ValueType: pb.Posting_STRING}
+	name2 := &pb.SchemaUpdate{Predicate: name, ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}}
+	name3 := &pb.SchemaUpdate{Predicate: name, ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"term"}}
+	age1 := &pb.SchemaUpdate{Predicate: age, ValueType: pb.Posting_INT}
+	person1 := &pb.TypeUpdate{TypeName: person, Fields: []*pb.SchemaUpdate{{Predicate: name}}}
+	person2 := &pb.TypeUpdate{TypeName: person,
+		Fields: []*pb.SchemaUpdate{{Predicate: name}, {Predicate: age}}}
+	pet1 := &pb.TypeUpdate{TypeName: pet, Fields: []*pb.SchemaUpdate{{Predicate: name}}}
+
+	history := []*pb.SchemaChange{
+		{Version: 10, NewPreds: []*pb.SchemaUpdate{name1}, NewTypes: []*pb.TypeUpdate{person1}},
+		{Version: 20, OldPreds: []*pb.SchemaUpdate{name1}, NewPreds: []*pb.SchemaUpdate{name2, age1},
+			OldTypes: []*pb.TypeUpdate{person1}, NewTypes: []*pb.TypeUpdate{person2}},
+		{Version: 30, OldPreds: []*pb.SchemaUpdate{name2}, NewPreds: []*pb.SchemaUpdate{name3},
+			NewTypes: []*pb.TypeUpdate{pet1}},
+		{Version: 40, OldPreds: []*pb.SchemaUpdate{age1}},
+	}
+
+	preds, typs, dropTypes, err := SchemaRollback(history, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.SchemaUpdate{name1}, preds)
+	require.Equal(t, []*pb.TypeUpdate{person1}, typs)
+	require.Equal(t, []string{pet}, dropTypes)
+
+	// The predicate dropped after the version is created again.
+	preds, typs, dropTypes, err = SchemaRollback(history, 30)
+	require.NoError(t, err)
+	require.Equal(t, []*pb.SchemaUpdate{age1}, preds)
+	require.Empty(t, typs)
+	require.Empty(t, dropTypes)
+
+	preds, typs, dropTypes, err = SchemaRollback(history, 40)
+	require.NoError(t, err)
+	require.Empty(t, preds)
+	require.Empty(t, typs)
+	require.Empty(t, dropTypes)
+
+	_, _, _, err = SchemaRollback(history, 25)
+	require.ErrorContains(t, err, "Schema version 25 not found in the history")
+}
+
+func TestSchemaDefinitions(t *testing.T) {
+	su := &pb.SchemaUpdate{Predicate: x.NamespaceAttr(2, "name"), ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"exact"}, Upsert: true}
+	require.Equal(t, "<name>:string @index(exact) @upsert .", PredicateDefinition(su))
+
+	tu := &pb.TypeUpdate{TypeName: x.NamespaceAttr(2, "Person"),
+		Fields: []*pb.SchemaUpdate{{Predicate: x.NamespaceAttr(2, "name")}}}
+	require.Equal(t, "type <Person> {\n\tname\n}", TypeDefinition(tu))
+}
+
+func TestSchemaUpdateFromNode(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		name: string @index(exact, term) @lang @upsert .
+		friend: [uid] @reverse @count .
+		session: string @ttl(1h) .
+		vec: float32vector @index(hnsw(metric:"euclidean")) .
+	`), 1))
+	fields := []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
+		"unique", "lang", "noconflict", "vector_specs", "embedding", "strict", "check", "where",
+		"facet_index", "ttl"}
+	for _, pred := range []string{"name", "friend", "session", "vec"} {
+		attr := x.AttrInRootNamespace(pred)
+		su, err := schemaUpdate(populateSchema(attr, fields))
+		require.NoError(t, err)
+		want, ok := schema.State().Get(context.Background(), attr)
+		require.True(t, ok)
+		require.True(t, proto.Equal(&want, su), "%s: %v != %v", pred, &want, su)
+	}
+}
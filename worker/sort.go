@@ -203,6 +203,10 @@ func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 	if !schema.State().IsIndexed(ctx, order.Attr) {
 		return resultWithError(errors.Errorf("Attribute %s is not indexed.", order.Attr))
 	}
+	// The values left out of a partial index would be missing from the sorted results.
+	if len(schema.State().IndexConditions(ctx, order.Attr)) > 0 {
+		return resultWithError(errors.Errorf("Attribute %s has a partial index.", order.Attr))
+	}
 
 	tokenizers := schema.State().Tokenizer(ctx, order.Attr)
 	var tokenizer tok.Tokenizer
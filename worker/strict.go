@@ -0,0 +1,358 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// strictNodes returns the touched nodes in the form they are sent along the mutations.
+func strictNodes(touched map[uint64]map[uint64]struct{}) []*pb.StrictNodes {
+	var out []*pb.StrictNodes
+	for ns, uids := range touched {
+		nodes := &pb.StrictNodes{Namespace: ns, Uids: make([]uint64, 0, len(uids))}
+		for uid := range uids {
+			nodes.Uids = append(nodes.Uids, uid)
+		}
+		out = append(out, nodes)
+	}
+	return out
+}
+
+// trackStrictNodes keeps the nodes touched by the mutation in strict namespaces with its txn.
+func trackStrictNodes(txn *posting.Txn, m *pb.Mutations) {
+	for _, nodes := range m.StrictNodes {
+		txn.AddStrictNodes(nodes.Namespace, nodes.Uids)
+	}
+}
+
+// fetchStrictNodes returns the nodes touched in strict namespaces by the pending txn. They are
+// kept with the txn by the groups its mutations went to, and every group is asked so that they
+// don't depend on the transaction context sent back by the client.
+func fetchStrictNodes(ctx context.Context, startTs uint64) (map[uint64]map[uint64]struct{},
+	error) {
+
+	type nodesErr struct {
+		nodes []*pb.StrictNodes
+		err   error
+	}
+	var gids []uint32
+	for _, gid := range groups().KnownGroups() {
+		if gid != 0 {
+			gids = append(gids, gid)
+		}
+	}
+	ch := make(chan nodesErr, len(gids))
+	req := &pb.StrictNodesRequest{StartTs: startTs}
+	for _, gid := range gids {
+		go func(gid uint32) {
+			// The groups are asked the same way the mutations were sent to them.
+			if groups().ServesGroup(gid) {
+				ch <- nodesErr{nodes: getStrictNodes(req).GetNodes()}
+				return
+			}
+			pl := groups().Leader(gid)
+			if pl == nil {
+				ch <- nodesErr{err: conn.ErrNoConnection}
+				return
+			}
+			c := pb.NewWorkerClient(pl.Get())
+			resp, err := c.StrictNodes(ctx, req)
+			ch <- nodesErr{nodes: resp.GetNodes(), err: err}
+		}(gid)
+	}
+
+	touched := make(map[uint64]map[uint64]struct{})
+	for range gids {
+		select {
+		case r := <-ch:
+			if r.err != nil {
+				return nil, errors.Wrapf(r.err, "cannot retrieve the nodes touched in strict mode")
+			}
+			for _, nodes := range r.nodes {
+				if touched[nodes.Namespace] == nil {
+					touched[nodes.Namespace] = make(map[uint64]struct{})
+				}
+				for _, uid := range nodes.Uids {
+					touched[nodes.Namespace][uid] = struct{}{}
+				}
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return touched, nil
+}
+
+// StrictNodes returns the nodes touched in strict namespaces by the pending txn, as kept by
+// this group.
+func (w *grpcWorker) StrictNodes(ctx context.Context, req *pb.StrictNodesRequest) (
+	*pb.StrictNodesResponse, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return getStrictNodes(req), nil
+}
+
+func getStrictNodes(req *pb.StrictNodesRequest) *pb.StrictNodesResponse {
+	touched := make(map[uint64]map[uint64]struct{})
+	posting.Oracle().GetTxn(req.StartTs).IterateStrictNodes(
+		func(ns uint64, uids map[uint64]struct{}) {
+			touched[ns] = maps.Clone(uids)
+		})
+	return &pb.StrictNodesResponse{Nodes: strictNodes(touched)}
+}
+
+// isStrict returns whether the namespace is in strict mode.
+func isStrict(ctx context.Context, ns uint64) (bool, error) {
+	attr := x.NamespaceAttr(ns, "dgraph.type")
+	if gid, err := groups().BelongsToReadOnly(attr, 0); err == nil && groups().ServesGroup(gid) {
+		return schema.State().IsStrict(ns), nil
+	}
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: []string{attr},
+		Fields:     []string{"strict"},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "cannot retrieve strict mode of namespace %#x", ns)
+	}
+	return len(nodes) > 0 && nodes[0].Strict, nil
+}
+
+// verifyStrict validates the edges of the mutation that belong to strict namespaces against
+// the types of the nodes they touch. It rejects the mutation if it sets a predicate that is not
+// declared in the types of the node, or deletes all the values of a required field. It returns
+// the nodes touched in each strict namespace.
+func verifyStrict(ctx context.Context, m *pb.Mutations) (map[uint64]map[uint64]struct{}, error) {
+	edgesByNs := make(map[uint64][]*pb.DirectedEdge)
+	for _, edge := range m.Edges {
+		ns := x.ParseNamespace(edge.Attr)
+		edgesByNs[ns] = append(edgesByNs[ns], edge)
+	}
+
+	touched := make(map[uint64]map[uint64]struct{})
+	for ns, edges := range edgesByNs {
+		strict, err := isStrict(ctx, ns)
+		if err != nil {
+			return nil, err
+		}
+		if !strict {
+			continue
+		}
+		nodes, err := verifyStrictEdges(ctx, ns, edges, m.StartTs)
+		if err != nil {
+			return nil, err
+		}
+		touched[ns] = nodes
+	}
+	return touched, nil
+}
+
+func verifyStrictEdges(ctx context.Context, ns uint64, edges []*pb.DirectedEdge,
+	startTs uint64) (map[uint64]struct{}, error) {
+
+	nodes := make(map[uint64]struct{})
+	for _, edge := range edges {
+		nodes[edge.Entity] = struct{}{}
+	}
+	nodeTypes, err := fetchNodeTypes(ctx, ns, nodes, startTs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the predicates against the types the nodes will have once the mutation
+	// is applied.
+	applyTypeEdges(ns, edges, nodeTypes)
+
+	set := make(map[uint64]map[string]struct{})
+	for _, edge := range edges {
+		if edge.Op != pb.DirectedEdge_SET {
+			continue
+		}
+		if set[edge.Entity] == nil {
+			set[edge.Entity] = make(map[string]struct{})
+		}
+		set[edge.Entity][edge.Attr] = struct{}{}
+	}
+
+	for _, edge := range edges {
+		if x.IsReservedPredicate(edge.Attr) {
+			continue
+		}
+		fields := typeFields(ns, nodeTypes[edge.Entity])
+		field, declared := fields[edge.Attr]
+		switch {
+		case edge.Op == pb.DirectedEdge_SET && !declared:
+			if len(nodeTypes[edge.Entity]) == 0 {
+				return nil, errors.Errorf("strict mode: cannot set predicate %s of node %#x"+
+					" as the node has no type", x.ParseAttr(edge.Attr), edge.Entity)
+			}
+			return nil, errors.Errorf("strict mode: predicate %s is not declared in the"+
+				" types [%s] of node %#x", x.ParseAttr(edge.Attr),
+				strings.Join(sortedKeys(nodeTypes[edge.Entity]), ", "), edge.Entity)
+		case edge.Op == pb.DirectedEdge_DEL && declared && field.NonNullable &&
+			bytes.Equal(edge.Value, []byte(x.Star)):
+			if _, ok := set[edge.Entity][edge.Attr]; !ok {
+				return nil, errors.Errorf("strict mode: cannot delete required predicate %s"+
+					" of node %#x", x.ParseAttr(edge.Attr), edge.Entity)
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// verifyRequiredFields verifies that the nodes touched by the transaction have all the
+// required fields of their types.
+func verifyRequiredFields(ctx context.Context, startTs uint64,
+	touched map[uint64]map[uint64]struct{}) error {
+
+	for ns, nodes := range touched {
+		nodeTypes, err := fetchNodeTypes(ctx, ns, nodes, startTs)
+		if err != nil {
+			return err
+		}
+
+		required := make(map[string][]uint64)
+		for uid, typs := range nodeTypes {
+			for pred, field := range typeFields(ns, typs) {
+				if field.NonNullable {
+					required[pred] = append(required[pred], uid)
+				}
+			}
+		}
+
+		preds := sortedKeys(required)
+		for _, pred := range preds {
+			uids := required[pred]
+			sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+			res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+				Attr:      pred,
+				UidList:   &pb.List{Uids: uids},
+				ReadTs:    startTs,
+				ExpandAll: true,
+			})
+			if err != nil {
+				return err
+			}
+			for i, uid := range uids {
+				if hasValue(res, i) {
+					continue
+				}
+				return errors.Errorf("strict mode: node %#x is missing required predicate %s"+
+					" of types [%s]", uid, x.ParseAttr(pred),
+					strings.Join(sortedKeys(nodeTypes[uid]), ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// fetchNodeTypes returns the types of the given nodes as seen by the transaction.
+func fetchNodeTypes(ctx context.Context, ns uint64, nodes map[uint64]struct{},
+	startTs uint64) (map[uint64]map[string]struct{}, error) {
+
+	uids := make([]uint64, 0, len(nodes))
+	for uid := range nodes {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    x.NamespaceAttr(ns, "dgraph.type"),
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  startTs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve the types of the nodes")
+	}
+
+	nodeTypes := make(map[uint64]map[string]struct{}, len(uids))
+	for i, uid := range uids {
+		typs := make(map[string]struct{})
+		if i < len(res.ValueMatrix) {
+			for _, v := range res.ValueMatrix[i].Values {
+				if len(v.Val) > 0 {
+					typs[string(v.Val)] = struct{}{}
+				}
+			}
+		}
+		nodeTypes[uid] = typs
+	}
+	return nodeTypes, nil
+}
+
+// applyTypeEdges applies the changes done to dgraph.type by the edges to the node types.
+func applyTypeEdges(ns uint64, edges []*pb.DirectedEdge, nodeTypes map[uint64]map[string]struct{}) {
+	typeAttr := x.NamespaceAttr(ns, "dgraph.type")
+	for _, edge := range edges {
+		if edge.Attr != typeAttr {
+			continue
+		}
+		typs := nodeTypes[edge.Entity]
+		switch {
+		case edge.Op == pb.DirectedEdge_SET:
+			typs[string(edge.Value)] = struct{}{}
+		case bytes.Equal(edge.Value, []byte(x.Star)):
+			clear(typs)
+		default:
+			delete(typs, string(edge.Value))
+		}
+	}
+}
+
+// typeFields returns the fields of the given types keyed by predicate.
+func typeFields(ns uint64, typs map[string]struct{}) map[string]*pb.SchemaUpdate {
+	fields := make(map[string]*pb.SchemaUpdate)
+	for name := range typs {
+		typ, ok := schema.State().GetType(x.NamespaceAttr(ns, name))
+		if !ok {
+			continue
+		}
+		for _, field := range typ.Fields {
+			// A field is required if any of the types of the node requires it.
+			if prev, ok := fields[field.Predicate]; ok && prev.NonNullable {
+				continue
+			}
+			fields[field.Predicate] = field
+		}
+	}
+	return fields
+}
+
+func hasValue(res *pb.Result, i int) bool {
+	if i < len(res.UidMatrix) && len(res.UidMatrix[i].Uids) > 0 {
+		return true
+	}
+	if i < len(res.ValueMatrix) {
+		for _, v := range res.ValueMatrix[i].Values {
+			if len(v.Val) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,37 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func TestTrackStrictNodes(t *testing.T) {
+	txn := posting.NewTxn(10)
+	trackStrictNodes(txn, &pb.Mutations{StrictNodes: strictNodes(map[uint64]map[uint64]struct{}{
+		0:    {1: {}, 0x2710: {}},
+		0x10: {5: {}},
+	})})
+	// The nodes of the later mutations of the txn add up.
+	trackStrictNodes(txn, &pb.Mutations{StrictNodes: strictNodes(map[uint64]map[uint64]struct{}{
+		0: {1: {}, 3: {}},
+	})})
+	trackStrictNodes(txn, &pb.Mutations{})
+
+	got := make(map[uint64]map[uint64]struct{})
+	txn.IterateStrictNodes(func(ns uint64, uids map[uint64]struct{}) {
+		got[ns] = uids
+	})
+	require.Equal(t, map[uint64]map[uint64]struct{}{
+		0:    {1: {}, 3: {}, 0x2710: {}},
+		0x10: {5: {}},
+	}, got)
+}
@@ -18,6 +18,7 @@ import (
 	cindex "github.com/google/codesearch/index"
 	cregexp "github.com/google/codesearch/regexp"
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -217,6 +218,9 @@ const (
 	customIndexFn
 	matchFn
 	similarToFn
+	facetFn
+	ipFn
+	jsonPathFn
 	standardFn = 100
 )
 
@@ -263,6 +267,12 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "facet":
+		return facetFn, f
+	case "ip_within", "ip_contains":
+		return ipFn, f
+	case "json_path":
+		return jsonPathFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -368,12 +378,15 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 			return err
 		}
 		var nnUids []uint64
-		if srcFn.vectorInfo != nil {
-			nnUids, err = indexer.Search(ctx, qc, srcFn.vectorInfo,
-				int(numNeighbors), index.AcceptAll[float32])
-		} else {
-			nnUids, err = indexer.SearchWithUid(ctx, qc, srcFn.vectorUid,
-				int(numNeighbors), index.AcceptAll[float32])
+		switch {
+		case schema.State().IsList(args.q.Attr):
+			nnUids, err = qs.similarToChunks(ctx, args.q, srcFn, cspec, int(numNeighbors))
+		case srcFn.vectorInfo != nil:
+			nnUids, err = indexer.SearchWithOptions(ctx, qc, srcFn.vectorInfo,
+				int(numNeighbors), index.AcceptAll[float32], srcFn.vectorOpts.search)
+		default:
+			nnUids, err = indexer.SearchWithUidAndOptions(ctx, qc, srcFn.vectorUid,
+				int(numNeighbors), index.AcceptAll[float32], srcFn.vectorOpts.search)
 		}
 
 		if err != nil && !strings.Contains(err.Error(), hnsw.EmptyHNSWTreeError+": "+badger.ErrKeyNotFound.Error()) {
@@ -1065,6 +1078,11 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	if needsIndex(srcFn.fnType, q.UidList) && !schema.State().IsIndexed(ctx, q.Attr) {
 		return nil, errors.Errorf("Predicate %s is not indexed", x.ParseAttr(q.Attr))
 	}
+	if srcFn.fnType != compareAttrFn && needsIndex(srcFn.fnType, q.UidList) &&
+		len(schema.State().IndexConditions(ctx, q.Attr)) > 0 {
+		return nil, errors.Errorf("Predicate %s has a partial index, which can only be used by"+
+			" comparison functions", x.ParseAttr(q.Attr))
+	}
 
 	if len(q.Langs) > 0 && !schema.State().HasLang(attr) {
 		return nil, errors.Errorf("Language tags can only be used with predicates of string type"+
@@ -1103,6 +1121,30 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		opts.Intersect = q.UidList
 	}
 
+	if srcFn.fnType == facetFn {
+		span.AddEvent("handleFacetFunction")
+		if err := qs.handleFacetFunction(ctx, q, out, srcFn.facetQuery); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	if srcFn.fnType == ipFn {
+		span.AddEvent("handleIPFunction")
+		if err := qs.handleIPFunction(ctx, q, out, srcFn.ipQuery); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	if srcFn.fnType == jsonPathFn {
+		span.AddEvent("handleJSONPathFunction")
+		if err := qs.handleJSONPathFunction(ctx, q, out, srcFn.jsonPathQuery); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
 	args := funcArgs{q, gid, srcFn, out}
 	needsValPostings, err := srcFn.needsValuePostings(typ)
 	if err != nil {
@@ -1158,7 +1200,12 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	}
 
 	// If geo filter, do value check for correctness.
-	if srcFn.geoQuery != nil {
+	if srcFn.geoQuery != nil && srcFn.geoQuery.Nearest() > 0 {
+		span.AddEvent("handleNearestFunction")
+		if err := qs.handleNearestFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	} else if srcFn.geoQuery != nil {
 		span.AddEvent("handleGeoFunction")
 		if err := qs.filterGeoFunction(ctx, args); err != nil {
 			return nil, err
@@ -1654,6 +1701,137 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 	return nil
 }
 
+// nearestStartRadius is the radius in metres of the first ring of S2 cells looked up by nearest.
+const nearestStartRadius = 1000
+
+// handleNearestFunction finds the nodes whose value is closest to the point of a nearest query.
+// At root, the geo index is looked up for the cells within a radius of the point, and the radius
+// is grown until enough nodes are found within it. As a filter, the distance to every given node
+// is computed.
+func (qs *queryState) handleNearestFunction(ctx context.Context, arg funcArgs) error {
+	span := trace.SpanFromContext(ctx)
+	stop := x.SpanTimer(span, "handleNearestFunction")
+	defer stop()
+
+	gq := arg.srcFn.geoQuery
+	k := gq.Nearest()
+	dists := make(map[uint64]types.Length)
+	checked := make(map[uint64]struct{})
+	addCandidate := func(uid uint64) error {
+		if _, ok := checked[uid]; ok {
+			return nil
+		}
+		checked[uid] = struct{}{}
+		d, ok, err := qs.geoDistance(arg.q, uid, gq)
+		if err != nil {
+			return err
+		}
+		if ok {
+			dists[uid] = d
+		}
+		return nil
+	}
+
+	if uids := arg.q.UidList.GetUids(); len(uids) > 0 {
+		for _, uid := range uids {
+			if err := addCandidate(uid); err != nil {
+				return err
+			}
+		}
+	} else {
+		// The index cells and the nodes already read for a smaller radius are not read again.
+		read := make(map[string]struct{})
+		for radius := float64(nearestStartRadius); ; radius *= 4 {
+			toks, more, err := gq.NearTokens(radius)
+			if err != nil {
+				return err
+			}
+			tok.EncodeGeoTokens(toks)
+			for _, t := range toks {
+				if _, ok := read[t]; ok {
+					continue
+				}
+				read[t] = struct{}{}
+				pl, err := qs.cache.GetUids(x.IndexKey(arg.q.Attr, t))
+				if err != nil {
+					return err
+				}
+				ul, err := pl.Uids(posting.ListOptions{ReadTs: arg.q.ReadTs})
+				if err != nil {
+					return err
+				}
+				for _, uid := range ul.Uids {
+					if err := addCandidate(uid); err != nil {
+						return err
+					}
+				}
+			}
+
+			// Every node within radius has been found, so we are done once there are
+			// enough of them.
+			var within int
+			for _, d := range dists {
+				if float64(d) <= radius {
+					within++
+				}
+			}
+			if within >= k || !more {
+				break
+			}
+		}
+	}
+
+	uids := make([]uint64, 0, len(dists))
+	for uid := range dists {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		di, dj := dists[uids[i]], dists[uids[j]]
+		if di != dj {
+			return di < dj
+		}
+		return uids[i] < uids[j]
+	})
+	if len(uids) > k {
+		uids = uids[:k]
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	span.AddEvent("Nearest result", trace.WithAttributes(
+		attribute.Int("uid_count", len(uids))))
+	arg.out.UidMatrix = []*pb.List{{Uids: uids}}
+	return nil
+}
+
+// geoDistance returns the shortest distance from the point of gq to any of the values of the
+// geo predicate of q for uid. The returned bool is false if uid has no geo value.
+func (qs *queryState) geoDistance(q *pb.Query, uid uint64,
+	gq *types.GeoQueryData) (types.Length, bool, error) {
+	pl, err := qs.cache.Get(x.DataKey(q.Attr, uid))
+	if err != nil {
+		return 0, false, err
+	}
+	var dist types.Length
+	var found bool
+	err = pl.Iterate(q.ReadTs, 0, func(p *pb.Posting) error {
+		if types.TypeID(p.ValType) != types.GeoID {
+			return nil
+		}
+		val, err := types.Convert(types.Val{Tid: types.BinaryID, Value: p.Value}, types.GeoID)
+		if err != nil {
+			return nil
+		}
+		d, err := gq.Distance(val.Value.(geom.T))
+		if err != nil {
+			return nil
+		}
+		if !found || d < dist {
+			dist, found = d, true
+		}
+		return nil
+	})
+	return dist, found, err
+}
+
 // TODO: This function is really slow when there are a lot of UIDs to filter, for e.g. when used in
 // `has(name)`. We could potentially have a query level cache, which can be used to speed things up
 // a bit. Or, try to reduce the number of UIDs which make it here.
@@ -1792,6 +1970,10 @@ type functionContext struct {
 	atype          types.TypeID
 	vectorInfo     []float32
 	vectorUid      uint64
+	vectorOpts     similarToOptions
+	facetQuery     *facetQuery
+	ipQuery        *ipQuery
+	jsonPathQuery  *jsonPathQuery
 }
 
 const (
@@ -1876,6 +2058,94 @@ func planForEqFilter(fc *functionContext, pred string, uidlist []uint64) {
 	fc.n = len(fc.tokens)
 }
 
+// impliesIndexConditions returns whether all the values matched by the comparison function
+// satisfy the conditions of the partial index of the predicate.
+func impliesIndexConditions(attr, fname string, args []string,
+	conds []*pb.IndexCondition) (bool, error) {
+
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil {
+		return false, err
+	}
+	vals := make([]types.Val, len(args))
+	for i, arg := range args {
+		if vals[i], err = types.Convert(types.Val{Tid: types.StringID, Value: []byte(arg)},
+			typ); err != nil {
+			return false, err
+		}
+	}
+
+	// The values matched by the function form the ranges [lo, hi], with open ends if nil and
+	// exclusive ends for lt and gt.
+	type valRange struct {
+		lo, hi         *types.Val
+		loExcl, hiExcl bool
+	}
+	var ranges []valRange
+	switch fname {
+	case eq:
+		for i := range vals {
+			ranges = append(ranges, valRange{lo: &vals[i], hi: &vals[i]})
+		}
+	case between:
+		ranges = append(ranges, valRange{lo: &vals[0], hi: &vals[1]})
+	case "ge", "gt":
+		ranges = append(ranges, valRange{lo: &vals[0], loExcl: fname == "gt"})
+	case "le", "lt":
+		ranges = append(ranges, valRange{hi: &vals[0], hiExcl: fname == "lt"})
+	default:
+		return false, nil
+	}
+
+	// above and below return whether all the values of the range are above or below cv.
+	above := func(r valRange, cv types.Val, orEqual bool) bool {
+		if r.lo == nil {
+			return false
+		}
+		if types.CompareVals("gt", *r.lo, cv) {
+			return true
+		}
+		return types.CompareVals("eq", *r.lo, cv) && (orEqual || r.loExcl)
+	}
+	below := func(r valRange, cv types.Val, orEqual bool) bool {
+		if r.hi == nil {
+			return false
+		}
+		if types.CompareVals("lt", *r.hi, cv) {
+			return true
+		}
+		return types.CompareVals("eq", *r.hi, cv) && (orEqual || r.hiExcl)
+	}
+
+	for _, cond := range conds {
+		cv, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(cond.Value)}, typ)
+		if err != nil {
+			return false, err
+		}
+		for _, r := range ranges {
+			var ok bool
+			switch cond.Op {
+			case "eq":
+				ok = above(r, cv, true) && below(r, cv, true)
+			case "ne":
+				ok = above(r, cv, false) || below(r, cv, false)
+			case "ge":
+				ok = above(r, cv, true)
+			case "gt":
+				ok = above(r, cv, false)
+			case "le":
+				ok = below(r, cv, true)
+			case "lt":
+				ok = below(r, cv, false)
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 	fnType, f := parseFuncType(q.SrcFunc)
 	attr := q.Attr
@@ -1927,6 +2197,21 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			}
 		}
 
+		// A partial index only holds the values satisfying its conditions, hence it can only
+		// answer the functions whose matching values all satisfy them.
+		if conds := schema.State().IndexConditions(ctx, attr); isIndexedAttr && len(conds) > 0 {
+			implied, err := impliesIndexConditions(attr, fc.fname, args, conds)
+			if err != nil {
+				return nil, err
+			}
+			if !implied && q.UidList == nil {
+				return nil, errors.Errorf("Predicate %s has a partial index that %s(%s) doesn't"+
+					" fall within. Use the function as a filter instead.", x.ParseAttr(attr),
+					fc.fname, strings.Join(args, ", "))
+			}
+			isIndexedAttr = implied
+		}
+
 		var tokens []string
 		var ineqValues []types.Val
 		// eq can have multiple args.
@@ -2119,13 +2404,20 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		checkRoot(q, fc)
 	case similarToFn:
-		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
-			return nil, err
+		if len(q.SrcFunc.Args) != 3 {
+			if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+				return nil, err
+			}
 		}
 		fc.vectorInfo, fc.vectorUid, err = interpretVFloatOrUid(q.SrcFunc.Args[1])
 		if err != nil {
 			return nil, err
 		}
+		if len(q.SrcFunc.Args) == 3 {
+			if fc.vectorOpts, err = parseSimilarToOptions(q.SrcFunc.Args[2]); err != nil {
+				return nil, err
+			}
+		}
 	case uidInFn:
 		for _, arg := range q.SrcFunc.Args {
 			uidParsed, err := strconv.ParseUint(arg, 0, 64)
@@ -2145,6 +2437,21 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		if fc.isFuncAtRoot {
 			return nil, errors.Errorf("uid_in function not allowed at root")
 		}
+	case facetFn:
+		if fc.facetQuery, err = parseFacetQuery(ctx, q); err != nil {
+			return nil, err
+		}
+		checkRoot(q, fc)
+	case ipFn:
+		if fc.ipQuery, err = parseIPQuery(ctx, q, fc.fname); err != nil {
+			return nil, err
+		}
+		checkRoot(q, fc)
+	case jsonPathFn:
+		if fc.jsonPathQuery, err = parseJSONPathQuery(q); err != nil {
+			return nil, err
+		}
+		checkRoot(q, fc)
 	default:
 		return nil, errors.Errorf("FnType %d not handled in numFnAttrs.", fnType)
 	}
@@ -2163,6 +2470,49 @@ func interpretVFloatOrUid(val string) ([]float32, uint64, error) {
 	return nil, uid, errors.Errorf("Value %q is not a uid or vector", val)
 }
 
+// similarToOptions holds the options given as the last argument of similar_to.
+type similarToOptions struct {
+	search index.SearchOptions
+	// agg is how the similarity of the vectors of a node are combined when
+	// searching a list of vectors predicate, either "max" or "avg".
+	agg string
+}
+
+// parseSimilarToOptions parses the optional last argument of similar_to, a
+// comma separated list of key=value pairs, e.g. "ef=64,agg=avg".
+func parseSimilarToOptions(val string) (similarToOptions, error) {
+	var opts similarToOptions
+	for _, kv := range strings.Split(val, ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return opts, errors.Errorf("Invalid option %q in similar_to, expected key=value", kv)
+		}
+		switch strings.TrimSpace(k) {
+		case "ef":
+			ef, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil || ef <= 0 {
+				return opts, errors.Errorf("Invalid value %q for ef in similar_to,"+
+					" expected a positive integer", v)
+			}
+			opts.search.Ef = ef
+		case "agg":
+			switch agg := strings.TrimSpace(v); agg {
+			case aggMax, aggAvg:
+				opts.agg = agg
+			default:
+				return opts, errors.Errorf("Invalid value %q for agg in similar_to,"+
+					" expected %q or %q", v, aggMax, aggAvg)
+			}
+		default:
+			return opts, errors.Errorf("Unknown option %q in similar_to", strings.TrimSpace(k))
+		}
+	}
+	return opts, nil
+}
+
 // ServeTask is used to respond to a query.
 func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, error) {
 	ctx, span := otel.Tracer("").Start(ctx, "worker.ServeTask")
@@ -2441,7 +2791,47 @@ type countParams struct {
 	fn      string // function name
 }
 
+// evaluate finds the uids whose count of the predicate satisfies the count function. The count
+// index counts the postings that expired but aren't deleted by the expiry sweeper yet, so the
+// uids having such postings are recounted.
 func (qs *queryState) evaluate(cp countParams, out *pb.Result) error {
+	start := len(out.UidMatrix)
+	if err := qs.evaluateCountIndex(cp, out); err != nil {
+		return err
+	}
+	due := expiringKeys.dueUids(cp.attr, cp.reverse, uint64(time.Now().Unix()))
+	if len(due) == 0 {
+		return nil
+	}
+
+	recounted := &pb.List{}
+	for _, uid := range due {
+		key := x.DataKey(cp.attr, uid)
+		if cp.reverse {
+			key = x.ReverseKey(cp.attr, uid)
+		}
+		pl, err := qs.cache.Get(key)
+		if err != nil {
+			return err
+		}
+		count := int64(pl.Length(cp.readTs, 0))
+		var ok bool
+		if cp.fn == between {
+			ok = count >= cp.counts[0] && count <= cp.counts[1]
+		} else {
+			ok = evalCompare(cp.fn, count, cp.counts[0])
+		}
+		if count > 0 && ok {
+			recounted.Uids = append(recounted.Uids, uid)
+		}
+	}
+
+	indexed := algo.Difference(algo.MergeSorted(out.UidMatrix[start:]), &pb.List{Uids: due})
+	out.UidMatrix = append(out.UidMatrix[:start], algo.MergeSorted([]*pb.List{indexed, recounted}))
+	return nil
+}
+
+func (qs *queryState) evaluateCountIndex(cp countParams, out *pb.Result) error {
 	countl := cp.counts[0]
 	var counth int64
 	if cp.fn == between {
@@ -2629,7 +3019,8 @@ func (qs *queryState) handleHasFunction(ctx context.Context, q *pb.Query, out *p
 		isSortablePred = (sch.ValueType == pb.Posting_INT || sch.ValueType == pb.Posting_FLOAT || sch.ValueType == pb.Posting_DATETIME)
 	}
 
-	isIndexed := schema.State().IsIndexed(ctx, q.Attr)
+	isIndexed := schema.State().IsIndexed(ctx, q.Attr) &&
+		len(schema.State().IndexConditions(ctx, q.Attr)) == 0
 	if isIndexed && ok && isSortablePred && hasOrders {
 		var order *pb.Order
 		for _, i := range q.Order.Order {
@@ -0,0 +1,408 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	// expirySweepInterval is how often the leader of a group deletes the expired values of the
+	// predicates that the group serves.
+	expirySweepInterval = time.Minute
+	// maxExpiredEdges is the maximum number of expired edges deleted by a transaction of the
+	// expiry sweeper. The rest are deleted by the next transactions.
+	maxExpiredEdges = 10000
+)
+
+// setMutationExpiry sets the time at which the edges set by the mutation expire, when the
+// request gives a time-to-live.
+func setMutationExpiry(ctx context.Context, m *pb.Mutations) error {
+	ttl, err := x.ExtractTTL(ctx)
+	if err != nil || ttl == 0 {
+		return err
+	}
+	expiresAt := uint64(time.Now().Add(ttl).Unix())
+	for _, edge := range m.Edges {
+		if edge.Op != pb.DirectedEdge_DEL {
+			edge.ExpiresAt = expiresAt
+		}
+	}
+	return nil
+}
+
+// setSchemaExpiry sets the time at which the edges set to predicates having a time-to-live
+// expire, unless the request makes them expire earlier. This is done once before the mutation
+// is proposed, so that all the replicas write the same values.
+func setSchemaExpiry(m *pb.Mutations) {
+	now := time.Now()
+	for _, edge := range m.Edges {
+		if edge.Op == pb.DirectedEdge_DEL {
+			continue
+		}
+		ttl := schema.State().TTL(edge.Attr)
+		if ttl == 0 {
+			continue
+		}
+		expiresAt := uint64(now.Add(ttl).Unix())
+		if edge.ExpiresAt == 0 || expiresAt < edge.ExpiresAt {
+			edge.ExpiresAt = expiresAt
+		}
+	}
+}
+
+// expiringKeys tracks the data and reverse keys of the group holding postings that expire, so
+// that the expiry sweeper only reads those keys. It's filled on every replica as transactions
+// commit, so that a new leader has it, and by a scan of the data when the node starts.
+var expiringKeys = newExpiryTracker()
+
+type expiryTracker struct {
+	sync.Mutex
+	// keys maps the predicates to their keys holding postings that expire, by the earliest
+	// expiry of the postings of the key.
+	keys map[string]map[string]uint64
+	// loaded is set once the keys written before the node started are tracked.
+	loaded bool
+}
+
+func newExpiryTracker() *expiryTracker {
+	return &expiryTracker{keys: make(map[string]map[string]uint64)}
+}
+
+func (t *expiryTracker) add(key []byte, expiresAt uint64) {
+	pk, err := x.Parse(key)
+	if err != nil || !(pk.IsData() || pk.IsReverse()) {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	keys, ok := t.keys[pk.Attr]
+	if !ok {
+		keys = make(map[string]uint64)
+		t.keys[pk.Attr] = keys
+	}
+	if cur, ok := keys[string(key)]; !ok || expiresAt < cur {
+		keys[string(key)] = expiresAt
+	}
+}
+
+// due returns the keys of the predicate whose earliest posting expired by now, with its expiry.
+func (t *expiryTracker) due(attr string, now uint64) map[string]uint64 {
+	t.Lock()
+	defer t.Unlock()
+	out := make(map[string]uint64)
+	for key, expiresAt := range t.keys[attr] {
+		if expiresAt <= now {
+			out[key] = expiresAt
+		}
+	}
+	return out
+}
+
+// dueUids returns the sorted uids whose data or reverse keys of the predicate have postings that
+// expired by now.
+func (t *expiryTracker) dueUids(attr string, reverse bool, now uint64) []uint64 {
+	t.Lock()
+	defer t.Unlock()
+	var uids []uint64
+	for key, expiresAt := range t.keys[attr] {
+		if expiresAt > now {
+			continue
+		}
+		pk, err := x.Parse([]byte(key))
+		if err != nil || pk.IsReverse() != reverse {
+			continue
+		}
+		uids = append(uids, pk.Uid)
+	}
+	slices.Sort(uids)
+	return uids
+}
+
+// attrs returns the predicates having postings that expired by now.
+func (t *expiryTracker) attrs(now uint64) []string {
+	t.Lock()
+	defer t.Unlock()
+	var out []string
+	for attr, keys := range t.keys {
+		for _, expiresAt := range keys {
+			if expiresAt <= now {
+				out = append(out, attr)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// update sets the expiry of the key to next, after its postings that expired by due were
+// deleted. A key that got postings expiring earlier in the meantime keeps them.
+func (t *expiryTracker) update(attr, key string, due, next uint64) {
+	t.Lock()
+	defer t.Unlock()
+	keys := t.keys[attr]
+	cur, ok := keys[key]
+	switch {
+	case !ok:
+	case cur == due && next == 0:
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(t.keys, attr)
+		}
+	case cur == due || next < cur:
+		keys[key] = next
+	}
+}
+
+// forget stops tracking the keys of the predicate, once it's no longer served by the group.
+func (t *expiryTracker) forget(attr string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.keys, attr)
+}
+
+// reset stops tracking all the keys, so that they're loaded again from the data, when the data
+// is replaced by a snapshot.
+func (t *expiryTracker) reset() {
+	t.Lock()
+	defer t.Unlock()
+	t.keys = make(map[string]map[string]uint64)
+	t.loaded = false
+}
+
+func (t *expiryTracker) isLoaded() bool {
+	t.Lock()
+	defer t.Unlock()
+	return t.loaded
+}
+
+// trackExpiringKeys tracks the keys that got postings with an expiry from a committed txn.
+func trackExpiringKeys(txn *posting.Txn) {
+	txn.IterateExpiringKeys(expiringKeys.add)
+}
+
+// loadExpiringKeys tracks the keys holding postings that expire among the data written before
+// the node started, by reading all the data and reverse keys of the group once.
+func loadExpiringKeys(ctx context.Context) error {
+	// The node might not know the timestamps yet, so the latest data is read. The keys of the
+	// transactions committed after, or replayed from the Raft log, are tracked as they commit.
+	readTs := uint64(math.MaxUint64)
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.AllVersions = false
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	var count int
+	for it.Rewind(); it.Valid(); it.Next() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		item := it.Item()
+		pk, err := x.Parse(item.Key())
+		if err != nil || !(pk.IsData() || pk.IsReverse()) {
+			continue
+		}
+		key := item.KeyCopy(nil)
+		pl, err := posting.GetNoStore(key, readTs)
+		if err != nil {
+			return err
+		}
+		next, err := pl.NextExpiry(readTs)
+		if err != nil {
+			return err
+		}
+		if next != 0 {
+			expiringKeys.add(key, next)
+			count++
+		}
+	}
+
+	expiringKeys.Lock()
+	expiringKeys.loaded = true
+	expiringKeys.Unlock()
+	glog.Infof("Found %d keys with expiring values", count)
+	return nil
+}
+
+// processExpiredData periodically deletes the expired values from the predicates of the group.
+// Only the leader of the group does it, but every replica tracks the keys with expiring values.
+func (n *node) processExpiredData() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(expirySweepInterval)
+	defer tick.Stop()
+
+	if err := loadExpiringKeys(n.closer.Ctx()); err != nil {
+		glog.Errorf("Error while loading the keys with expiring values: %v", err)
+	}
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if !expiringKeys.isLoaded() {
+				if err := loadExpiringKeys(n.closer.Ctx()); err != nil {
+					glog.Errorf("Error while loading the keys with expiring values: %v", err)
+					continue
+				}
+			}
+			if !n.AmLeader() {
+				continue
+			}
+			for _, attr := range expiringKeys.attrs(uint64(time.Now().Unix())) {
+				err := deleteExpiredEdges(n.closer.Ctx(), attr)
+				switch {
+				case errors.Is(err, dgo.ErrAborted):
+					// The transaction conflicted with another one. It is retried by the next
+					// sweep.
+					glog.V(2).Infof("Deletion of the expired values of %s was aborted",
+						x.ParseAttr(attr))
+				case err != nil:
+					glog.Errorf("Error while deleting the expired values of %s: %v",
+						x.ParseAttr(attr), err)
+				}
+			}
+		}
+	}
+}
+
+// deleteExpiredEdges deletes the expired edges of the predicate in a transaction, like a
+// mutation would, so that its indexes, reverse edges and counts are updated too. Only the keys
+// tracked by expiringKeys are read.
+func deleteExpiredEdges(ctx context.Context, attr string) error {
+	ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return err
+	}
+	startTs := ts.StartId
+	if gid, err := groups().BelongsToReadOnly(attr, startTs); err != nil {
+		return err
+	} else if gid != groups().groupId() {
+		expiringKeys.forget(attr)
+		return nil
+	}
+	if err := posting.Oracle().WaitForTs(ctx, startTs); err != nil {
+		return err
+	}
+
+	now := uint64(time.Now().Unix())
+	due := expiringKeys.due(attr, now)
+	edges, swept, err := expiredEdges(due, startTs, now)
+	if err != nil {
+		return err
+	}
+
+	readTs := startTs
+	if len(edges) > 0 {
+		glog.V(2).Infof("Deleting %d expired edges of %s", len(edges), x.ParseAttr(attr))
+		m := &pb.Mutations{GroupId: groups().groupId(), StartTs: startTs, Edges: edges}
+		tctx := &api.TxnContext{StartTs: startTs}
+		if err := (&grpcWorker{}).proposeAndWait(ctx, tctx, m); err != nil {
+			tctx.Aborted = true
+			_, _ = CommitOverNetwork(ctx, tctx)
+			return err
+		}
+		if readTs, err = CommitOverNetwork(ctx, tctx); err != nil {
+			return err
+		}
+		if err := posting.Oracle().WaitForTs(ctx, readTs); err != nil {
+			return err
+		}
+	}
+
+	// Track the keys until their next postings expire.
+	for key := range swept {
+		pl, err := posting.GetNoStore([]byte(key), readTs)
+		if err != nil {
+			return err
+		}
+		next, err := pl.NextExpiry(readTs)
+		if err != nil {
+			return err
+		}
+		expiringKeys.update(attr, key, due[key], next)
+	}
+	return nil
+}
+
+// expiredEdges returns the deletion edges of the postings of the keys that have expired, up to
+// maxExpiredEdges, along with the keys whose expired postings are all deleted by them. The
+// postings of reverse keys are deleted along with the data edges.
+func expiredEdges(keys map[string]uint64, readTs, now uint64) ([]*pb.DirectedEdge,
+	map[string]struct{}, error) {
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	var edges []*pb.DirectedEdge
+	swept := make(map[string]struct{}, len(keys))
+	for _, key := range sorted {
+		pk, err := x.Parse([]byte(key))
+		if err != nil {
+			return nil, nil, err
+		}
+		if pk.IsReverse() {
+			swept[key] = struct{}{}
+			continue
+		}
+		pl, err := posting.GetNoStore([]byte(key), readTs)
+		if err != nil {
+			return nil, nil, err
+		}
+		posts, err := pl.ExpiredPostings(readTs, now)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(edges) > 0 && len(edges)+len(posts) > maxExpiredEdges {
+			// The rest are deleted by the next transactions.
+			break
+		}
+		for _, p := range posts {
+			edges = append(edges, expiredEdge(pk.Attr, pk.Uid, p))
+		}
+		swept[key] = struct{}{}
+	}
+	return edges, swept, nil
+}
+
+func expiredEdge(attr string, uid uint64, p *pb.Posting) *pb.DirectedEdge {
+	edge := &pb.DirectedEdge{
+		Entity: uid,
+		Attr:   attr,
+		Op:     pb.DirectedEdge_DEL,
+	}
+	if p.PostingType == pb.Posting_REF {
+		edge.ValueId = p.Uid
+		edge.ValueType = pb.Posting_UID
+		return edge
+	}
+	edge.Value = p.Value
+	edge.ValueType = p.ValType
+	edge.Lang = string(p.LangTag)
+	return edge
+}
@@ -0,0 +1,49 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestExpiryTracker(t *testing.T) {
+	attr := x.AttrInRootNamespace("session")
+	other := x.AttrInRootNamespace("token")
+	t1, t2, t3 := string(x.DataKey(attr, 1)), string(x.DataKey(attr, 2)), string(x.ReverseKey(attr, 3))
+
+	tr := newExpiryTracker()
+	tr.add([]byte(t1), 20)
+	tr.add([]byte(t1), 10)
+	tr.add([]byte(t1), 30)
+	tr.add([]byte(t2), 50)
+	tr.add([]byte(t3), 10)
+	tr.add(x.DataKey(other, 1), 100)
+	tr.add(x.IndexKey(attr, "abc"), 10)
+
+	require.Equal(t, []string{attr}, tr.attrs(15))
+	require.Empty(t, tr.attrs(5))
+	require.Equal(t, map[string]uint64{t1: 10, t3: 10}, tr.due(attr, 15))
+	require.Equal(t, []uint64{1, 2}, tr.dueUids(attr, false, 60))
+	require.Equal(t, []uint64{3}, tr.dueUids(attr, true, 60))
+
+	// A key keeps its earlier expiry when it got it while its expired postings were deleted.
+	tr.add([]byte(t1), 5)
+	tr.update(attr, t1, 10, 40)
+	tr.update(attr, t3, 10, 0)
+	require.Equal(t, map[string]uint64{t1: 5}, tr.due(attr, 15))
+	tr.update(attr, t1, 5, 40)
+	require.Equal(t, map[string]uint64{t1: 40, t2: 50}, tr.due(attr, 60))
+
+	tr.update(attr, t1, 40, 0)
+	tr.update(attr, t2, 50, 0)
+	require.Equal(t, []string{other}, tr.attrs(200))
+	tr.forget(other)
+	require.Empty(t, tr.attrs(200))
+}
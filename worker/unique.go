@@ -0,0 +1,648 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// tupleBackfillBatch is the number of nodes whose combined values are written by each
+// transaction of the backfill of a composite unique key or index.
+const tupleBackfillBatch = 1000
+
+// tupleBackfillRetries is the number of attempts of a batch of the backfill aborted by
+// conflicting transactions.
+const tupleBackfillRetries = 10
+
+// tupleIndex is a composite unique key or a composite index of a type. Both are maintained
+// through an internal predicate holding the combined values of the fields of the node.
+type tupleIndex struct {
+	typeName string
+	preds    []string
+	attr     string
+	unique   bool
+}
+
+// addTupleIndexEdges maintains the internal predicates of the composite unique keys and indexes
+// of the types of the nodes touched by the mutation, and rejects the mutation if it gives a node
+// the same combined values of a unique key as another node of the type. The internal predicates
+// of unique keys have the @upsert directive, so two concurrent transactions writing the same
+// combined values conflict.
+//
+// The nodes written before the key or index is declared are covered by backfillTupleIndex.
+func addTupleIndexEdges(ctx context.Context, m *pb.Mutations) error {
+	edgesByNs := make(map[uint64][]*pb.DirectedEdge)
+	for _, edge := range m.Edges {
+		ns := x.ParseNamespace(edge.Attr)
+		edgesByNs[ns] = append(edgesByNs[ns], edge)
+	}
+
+	for ns, edges := range edgesByNs {
+		indexes := tupleIndexes(ns)
+		if len(indexes) == 0 {
+			continue
+		}
+
+		// Only the nodes whose types or indexed fields change are affected.
+		relevant := map[string]struct{}{x.NamespaceAttr(ns, "dgraph.type"): {}}
+		for _, index := range indexes {
+			for _, pred := range index.preds {
+				relevant[pred] = struct{}{}
+			}
+		}
+		nodes := make(map[uint64]struct{})
+		for _, edge := range edges {
+			if _, ok := relevant[edge.Attr]; ok {
+				nodes[edge.Entity] = struct{}{}
+			}
+		}
+		if len(nodes) == 0 {
+			continue
+		}
+
+		before, err := fetchNodeTypes(ctx, ns, nodes, m.StartTs)
+		if err != nil {
+			return err
+		}
+		after := make(map[uint64]map[string]struct{}, len(before))
+		for uid, typs := range before {
+			after[uid] = maps.Clone(typs)
+		}
+		applyTypeEdges(ns, edges, after)
+
+		predTypes, err := fetchPredTypes(ctx, relevant)
+		if err != nil {
+			return err
+		}
+
+		for _, index := range indexes {
+			name := x.ParseAttr(index.typeName)
+			var uids []uint64
+			for uid := range nodes {
+				_, had := before[uid][name]
+				_, has := after[uid][name]
+				if had || has {
+					uids = append(uids, uid)
+				}
+			}
+			if len(uids) == 0 {
+				continue
+			}
+			sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+			indexEdges, err := tupleIndexEdges(ctx, index, uids, after, edges, predTypes,
+				m.StartTs)
+			if err != nil {
+				return err
+			}
+			m.Edges = append(m.Edges, indexEdges...)
+		}
+	}
+	return nil
+}
+
+// tupleIndexes returns the composite unique keys and indexes of the types of the namespace.
+func tupleIndexes(ns uint64) []tupleIndex {
+	var out []tupleIndex
+	for _, name := range schema.State().Types() {
+		if x.ParseNamespace(name) != ns {
+			continue
+		}
+		typ, ok := schema.State().GetType(name)
+		if !ok {
+			continue
+		}
+		for _, key := range typ.UniqueKeys {
+			out = append(out, uniqueKeyIndex(typ.TypeName, key))
+		}
+		for _, index := range typ.CompositeIndexes {
+			out = append(out, tupleIndex{
+				typeName: typ.TypeName,
+				preds:    index.Predicates,
+				attr:     schema.CompositeIndexPredicate(typ.TypeName, index),
+			})
+		}
+	}
+	return out
+}
+
+func uniqueKeyIndex(typeName string, key *pb.UniqueKey) tupleIndex {
+	return tupleIndex{
+		typeName: typeName,
+		preds:    key.Predicates,
+		attr:     schema.UniqueKeyPredicate(typeName, key),
+		unique:   true,
+	}
+}
+
+// VerifyUniqueKey returns an error if nodes of the type share the combined values of the fields
+// of the unique key, so that the key can't be declared.
+func VerifyUniqueKey(ctx context.Context, typeName string, key *pb.UniqueKey) error {
+	index := uniqueKeyIndex(typeName, key)
+	readTs := State.GetTimestamp(true)
+	uids, err := typeNodes(ctx, typeName, readTs)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]struct{}, len(uids))
+	for batch := range slices.Chunk(uids, tupleBackfillBatch) {
+		values, err := tupleValues(ctx, index, batch, nil, nil, readTs)
+		if err != nil {
+			return err
+		}
+		for _, uid := range batch {
+			value, err := tupleIndexValue(index, values[uid])
+			if err != nil {
+				return err
+			}
+			if value == "" {
+				continue
+			}
+			if _, ok := keys[value]; ok {
+				return existingDuplicatesError(index, values[uid])
+			}
+			keys[value] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// BackfillUniqueKey writes the combined values of the fields of the unique key for the nodes of
+// the type written before the key was declared.
+func BackfillUniqueKey(ctx context.Context, typeName string, key *pb.UniqueKey) error {
+	return backfillTupleIndex(ctx, uniqueKeyIndex(typeName, key))
+}
+
+// compositeBuilds holds the internal predicates of the composite indexes being built by this
+// node.
+var compositeBuilds = struct {
+	sync.Mutex
+	attrs map[string]struct{}
+}{attrs: make(map[string]struct{})}
+
+// buildCompositeIndexes builds in the background the composite indexes of the type marked as
+// building, if this node is the leader of the group serving their internal predicate. A build
+// interrupted by a restart is resumed when the type is altered again.
+func buildCompositeIndexes(typ *pb.TypeUpdate) {
+	for _, index := range typ.CompositeIndexes {
+		if !index.Building {
+			continue
+		}
+		attr := schema.CompositeIndexPredicate(typ.TypeName, index)
+		gid, err := groups().BelongsToReadOnly(attr, 0)
+		if err != nil || gid != groups().groupId() || !groups().Node.AmLeader() {
+			continue
+		}
+
+		compositeBuilds.Lock()
+		_, running := compositeBuilds.attrs[attr]
+		compositeBuilds.attrs[attr] = struct{}{}
+		compositeBuilds.Unlock()
+		if !running {
+			go buildCompositeIndex(typ.TypeName, index)
+		}
+	}
+}
+
+func buildCompositeIndex(typeName string, index *pb.CompositeIndex) {
+	attr := schema.CompositeIndexPredicate(typeName, index)
+	defer func() {
+		compositeBuilds.Lock()
+		delete(compositeBuilds.attrs, attr)
+		compositeBuilds.Unlock()
+	}()
+
+	ctx := context.Background()
+	glog.Infof("Building the composite index %s", x.ParseAttr(attr))
+	err := backfillTupleIndex(ctx, tupleIndex{typeName: typeName, preds: index.Predicates, attr: attr})
+	if err == nil {
+		err = markCompositeIndexBuilt(ctx, typeName, index)
+	}
+	if err != nil {
+		glog.Errorf("Unable to build the composite index %s: %v", x.ParseAttr(attr), err)
+		return
+	}
+	glog.Infof("Built the composite index %s", x.ParseAttr(attr))
+}
+
+// markCompositeIndexBuilt clears the building flag of the composite index in the type, so that
+// queries start using it.
+func markCompositeIndexBuilt(ctx context.Context, typeName string, index *pb.CompositeIndex) error {
+	current, ok := schema.State().GetType(typeName)
+	if !ok {
+		return nil
+	}
+	typ := proto.Clone(&current).(*pb.TypeUpdate)
+	var found bool
+	for _, other := range typ.CompositeIndexes {
+		if other.Building && slices.Equal(other.Predicates, index.Predicates) {
+			other.Building = false
+			found = true
+		}
+	}
+	if !found {
+		// The index was dropped in the meantime.
+		return nil
+	}
+	_, err := MutateOverNetwork(ctx, &pb.Mutations{
+		StartTs: State.GetTimestamp(false),
+		Types:   []*pb.TypeUpdate{typ},
+	})
+	return err
+}
+
+// backfillTupleIndex writes the internal predicate of the unique key or index for the nodes of
+// the type, in batches of tupleBackfillBatch nodes committed by transactions of their own. The
+// nodes written in the meantime are covered by addTupleIndexEdges, and a batch conflicting with
+// them is retried a few times.
+func backfillTupleIndex(ctx context.Context, index tupleIndex) error {
+	uids, err := typeNodes(ctx, index.typeName, State.GetTimestamp(true))
+	if err != nil {
+		return err
+	}
+	preds := make(map[string]struct{}, len(index.preds))
+	for _, pred := range index.preds {
+		preds[pred] = struct{}{}
+	}
+	predTypes, err := fetchPredTypes(ctx, preds)
+	if err != nil {
+		return err
+	}
+
+	for batch := range slices.Chunk(uids, tupleBackfillBatch) {
+		if err := backfillTupleBatchWithRetries(ctx, index, batch, predTypes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillTupleBatchWithRetries retries the batch, with an exponential backoff, while it's
+// aborted by conflicting transactions, up to tupleBackfillRetries attempts.
+func backfillTupleBatchWithRetries(ctx context.Context, index tupleIndex, uids []uint64,
+	predTypes map[string]types.TypeID) error {
+
+	wait := 10 * time.Millisecond
+	for attempt := 1; ; attempt++ {
+		err := backfillTupleBatch(ctx, index, uids, predTypes)
+		if !errors.Is(err, dgo.ErrAborted) {
+			return err
+		}
+		if attempt == tupleBackfillRetries {
+			return errors.Errorf("cannot backfill %s, the nodes of type %s kept changing in "+
+				"concurrent transactions. Please retry the Alter", x.ParseAttr(index.attr),
+				x.ParseAttr(index.typeName))
+		}
+		glog.V(2).Infof("Retrying the backfill of %s after a conflict", x.ParseAttr(index.attr))
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		wait *= 2
+	}
+}
+
+func backfillTupleBatch(ctx context.Context, index tupleIndex, uids []uint64,
+	predTypes map[string]types.TypeID) error {
+
+	startTs := State.GetTimestamp(false)
+	nodes := make(map[uint64]struct{}, len(uids))
+	for _, uid := range uids {
+		nodes[uid] = struct{}{}
+	}
+	nodeTypes, err := fetchNodeTypes(ctx, x.ParseNamespace(index.typeName), nodes, startTs)
+	if err != nil {
+		return err
+	}
+	edges, err := tupleIndexEdges(ctx, index, uids, nodeTypes, nil, predTypes, startTs)
+	if err != nil || len(edges) == 0 {
+		return err
+	}
+
+	tctx, err := MutateOverNetwork(ctx, &pb.Mutations{Edges: edges, StartTs: startTs})
+	if err != nil {
+		tctx.Aborted = true
+		_, _ = CommitOverNetwork(ctx, tctx)
+		return err
+	}
+	_, err = CommitOverNetwork(ctx, tctx)
+	return err
+}
+
+// typeNodes returns the nodes of the type.
+func typeNodes(ctx context.Context, typeName string, readTs uint64) ([]uint64, error) {
+	ns, name := x.ParseNamespaceAttr(typeName)
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    x.NamespaceAttr(ns, "dgraph.type"),
+		SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{name}},
+		ReadTs:  readTs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve the nodes of type %s", name)
+	}
+	if len(res.UidMatrix) == 0 {
+		return nil, nil
+	}
+	return res.UidMatrix[0].Uids, nil
+}
+
+// fetchPredTypes returns the schema types of the given predicates.
+func fetchPredTypes(ctx context.Context, preds map[string]struct{}) (map[string]types.TypeID,
+	error) {
+
+	req := &pb.SchemaRequest{Fields: []string{"type"}}
+	for pred := range preds {
+		req.Predicates = append(req.Predicates, pred)
+	}
+	nodes, err := GetSchemaOverNetwork(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve predicate information")
+	}
+	out := make(map[string]types.TypeID, len(nodes))
+	for _, node := range nodes {
+		if tid, ok := types.TypeForName(node.Type); ok {
+			out[node.Predicate] = tid
+		}
+	}
+	return out, nil
+}
+
+// tupleIndexEdges returns the edges that update the internal predicate of the unique key or
+// index for the given nodes of the type.
+func tupleIndexEdges(ctx context.Context, index tupleIndex, uids []uint64,
+	nodeTypes map[uint64]map[string]struct{}, edges []*pb.DirectedEdge,
+	predTypes map[string]types.TypeID, startTs uint64) ([]*pb.DirectedEdge, error) {
+
+	values, err := tupleValues(ctx, index, uids, edges, predTypes, startTs)
+	if err != nil {
+		return nil, err
+	}
+	olds, err := fetchKeyValues(ctx, index.attr, uids, startTs)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*pb.DirectedEdge
+	news := make(map[uint64]string, len(uids))
+	owners := make(map[string]uint64)
+	for _, uid := range uids {
+		var newKey string
+		if _, ok := nodeTypes[uid][x.ParseAttr(index.typeName)]; ok {
+			if newKey, err = tupleIndexValue(index, values[uid]); err != nil {
+				return nil, err
+			}
+		}
+		news[uid] = newKey
+
+		var old string
+		if val, ok := olds[uid]; ok {
+			old = string(val.Value.([]byte))
+		}
+		if newKey == old {
+			continue
+		}
+		if old != "" {
+			out = append(out, &pb.DirectedEdge{
+				Entity:    uid,
+				Attr:      index.attr,
+				Value:     []byte(old),
+				ValueType: pb.Posting_STRING,
+				Op:        pb.DirectedEdge_DEL,
+			})
+		}
+		if newKey == "" {
+			continue
+		}
+		if _, ok := owners[newKey]; ok && index.unique {
+			return nil, duplicateKeyError(index, values[uid])
+		}
+		owners[newKey] = uid
+		out = append(out, &pb.DirectedEdge{
+			Entity:    uid,
+			Attr:      index.attr,
+			Value:     []byte(newKey),
+			ValueType: pb.Posting_STRING,
+			Op:        pb.DirectedEdge_SET,
+		})
+	}
+	if !index.unique {
+		return out, nil
+	}
+
+	// Check the new combined values against the other nodes of the type. A node that is
+	// moving away from the combined values in this mutation doesn't count.
+	for newKey, uid := range owners {
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    index.attr,
+			SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{newKey}},
+			ReadTs:  startTs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.UidMatrix) == 0 {
+			continue
+		}
+		for _, other := range res.UidMatrix[0].Uids {
+			if other == uid {
+				continue
+			}
+			if next, ok := news[other]; ok && next != newKey {
+				continue
+			}
+			return nil, duplicateKeyError(index, values[uid])
+		}
+	}
+	return out, nil
+}
+
+// tupleValues returns the binary values of the fields of the unique key or index for the given
+// nodes, combining the stored values with the ones changed by the edges of the mutation.
+func tupleValues(ctx context.Context, index tupleIndex, uids []uint64, edges []*pb.DirectedEdge,
+	predTypes map[string]types.TypeID, startTs uint64) (map[uint64][]types.Val, error) {
+
+	values := make(map[uint64][]types.Val, len(uids))
+	for _, uid := range uids {
+		values[uid] = make([]types.Val, len(index.preds))
+	}
+	for i, pred := range index.preds {
+		stored, err := fetchKeyValues(ctx, pred, uids, startTs)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range edges {
+			if edge.Attr != pred {
+				continue
+			}
+			if _, ok := values[edge.Entity]; !ok {
+				continue
+			}
+			if edge.Op == pb.DirectedEdge_DEL && bytes.Equal(edge.Value, []byte(x.Star)) {
+				delete(stored, edge.Entity)
+				continue
+			}
+			val, err := edgeKeyValue(edge, predTypes[pred])
+			if err != nil {
+				return nil, err
+			}
+			prev, ok := stored[edge.Entity]
+			switch {
+			case edge.Op == pb.DirectedEdge_SET:
+				stored[edge.Entity] = val
+			case ok && prev.Tid == val.Tid && bytes.Equal(prev.Value.([]byte), val.Value.([]byte)):
+				delete(stored, edge.Entity)
+			}
+		}
+		for uid, val := range stored {
+			values[uid][i] = val
+		}
+	}
+	return values, nil
+}
+
+// tupleIndexValue returns the combined values of the fields stored in the internal predicate.
+// The combined values of a unique key are only stored when all the fields have a value, while
+// those of an index are stored for the fields up to the first one without a value.
+func tupleIndexValue(index tupleIndex, values []types.Val) (string, error) {
+	if index.unique {
+		strs := make([]string, len(values))
+		for i, val := range values {
+			if val.Value == nil {
+				return "", nil
+			}
+			str, err := keyString(val)
+			if err != nil {
+				return "", err
+			}
+			strs[i] = str
+		}
+		b, err := json.Marshal(strs)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+
+	return posting.CompositeIndexEntry(values)
+}
+
+// fetchKeyValues returns the binary values of the predicate for the given nodes.
+func fetchKeyValues(ctx context.Context, attr string, uids []uint64,
+	startTs uint64) (map[uint64]types.Val, error) {
+
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    attr,
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  startTs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[uint64]types.Val)
+	for i, uid := range uids {
+		if i < len(res.UidMatrix) && len(res.UidMatrix[i].Uids) > 0 {
+			b := types.ValueForType(types.BinaryID)
+			val := types.Val{Tid: types.UidID, Value: res.UidMatrix[i].Uids[0]}
+			if err := types.Marshal(val, &b); err != nil {
+				return nil, err
+			}
+			out[uid] = types.Val{Tid: types.UidID, Value: b.Value}
+			continue
+		}
+		if i >= len(res.ValueMatrix) || len(res.ValueMatrix[i].Values) == 0 {
+			continue
+		}
+		tv := res.ValueMatrix[i].Values[0]
+		if len(tv.Val) == 0 {
+			continue
+		}
+		out[uid] = types.Val{Tid: types.TypeID(tv.ValType), Value: tv.Val}
+	}
+	return out, nil
+}
+
+// edgeKeyValue returns the binary value of the edge, converting it to the schema type of the
+// predicate first.
+func edgeKeyValue(edge *pb.DirectedEdge, tid types.TypeID) (types.Val, error) {
+	val := types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value}
+	switch {
+	case edge.ValueId != 0:
+		val = types.Val{Tid: types.UidID, Value: edge.ValueId}
+	case tid != types.UndefinedID && tid != types.UidID:
+		var err error
+		if val, err = types.Convert(val, tid); err != nil {
+			return types.Val{}, err
+		}
+	default:
+		return val, nil
+	}
+	b := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(val, &b); err != nil {
+		return types.Val{}, err
+	}
+	return types.Val{Tid: val.Tid, Value: b.Value}, nil
+}
+
+// keyString returns the binary value in the form used in the combined values of unique keys.
+func keyString(val types.Val) (string, error) {
+	if val.Tid == types.UidID {
+		uid, err := types.Convert(val, types.UidID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%#x", uid.Value.(uint64)), nil
+	}
+	str, err := types.Convert(val, types.StringID)
+	if err != nil {
+		return "", err
+	}
+	return str.Value.(string), nil
+}
+
+func duplicateKeyError(index tupleIndex, values []types.Val) error {
+	strs, preds := keyStrings(index, values)
+	return errors.Errorf("could not insert duplicate values [%s] for predicates [%s] of type [%s]",
+		strs, preds, x.ParseAttr(index.typeName))
+}
+
+func existingDuplicatesError(index tupleIndex, values []types.Val) error {
+	strs, preds := keyStrings(index, values)
+	return errors.Errorf("there are duplicates [%s] in existing data for predicates [%s] of type "+
+		"[%s]", strs, preds, x.ParseAttr(index.typeName))
+}
+
+// keyStrings returns the values and the fields of the unique key, joined for error messages.
+func keyStrings(index tupleIndex, values []types.Val) (string, string) {
+	preds := make([]string, len(index.preds))
+	strs := make([]string, len(values))
+	for i, pred := range index.preds {
+		preds[i] = x.ParseAttr(pred)
+		strs[i], _ = keyString(values[i])
+	}
+	return strings.Join(strs, ", "), strings.Join(preds, ", ")
+}
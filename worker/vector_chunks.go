@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/tok/index"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	aggMax = "max"
+	aggAvg = "avg"
+
+	// Several chunks of the same node can be among the nearest ones, so we
+	// search for more chunks than the number of nodes asked for, and double
+	// that until we have enough nodes or up to maxChunkOversampling times.
+	chunkOversampling    = 4
+	maxChunkOversampling = 64
+)
+
+// similarToChunks runs similar_to over a list of vectors predicate. The index
+// returns the nearest chunks, i.e. single vectors of the list, which are then
+// mapped to the nodes owning them. Each node is scored by aggregating the
+// similarity of its chunks that were found, using the agg option.
+func (qs *queryState) similarToChunks(ctx context.Context, q *pb.Query, srcFn *functionContext,
+	cspec *tok.FactoryCreateSpec, k int) ([]uint64, error) {
+	if k <= 0 {
+		return []uint64{}, nil
+	}
+	indexer, err := cspec.CreateIndex(hnsw.ConcatStrings(q.Attr, hnsw.VecChunk))
+	if err != nil {
+		return nil, err
+	}
+	qc := hnsw.NewQueryCache(posting.NewViLocalCache(qs.cache), q.ReadTs)
+
+	queries := [][]float32{srcFn.vectorInfo}
+	if srcFn.vectorInfo == nil {
+		// Look for the nodes closest to any of the vectors of the given node.
+		pl, err := qs.cache.Get(x.DataKey(q.Attr, srcFn.vectorUid))
+		if err != nil {
+			return nil, err
+		}
+		vals, err := pl.AllValues(q.ReadTs)
+		if err != nil {
+			return nil, err
+		}
+		queries = queries[:0]
+		for _, val := range vals {
+			if data, ok := val.Value.([]byte); ok && len(data) > 0 {
+				queries = append(queries, types.BytesAsFloatArray(data))
+			}
+		}
+	}
+
+	type chunkScore struct {
+		owner uint64
+		score float64
+	}
+	var chunks map[uint64]chunkScore
+	for n := k * chunkOversampling; ; n *= 2 {
+		chunks = make(map[uint64]chunkScore)
+		exhausted := true
+		for _, query := range queries {
+			uids, err := indexer.SearchWithOptions(ctx, qc, query, n, index.AcceptAll[float32],
+				srcFn.vectorOpts.search)
+			if err != nil {
+				if strings.Contains(err.Error(), hnsw.EmptyHNSWTreeError+": "+
+					badger.ErrKeyNotFound.Error()) {
+					return []uint64{}, nil
+				}
+				return nil, err
+			}
+			if len(uids) == n {
+				exhausted = false
+			}
+			scores, err := indexer.SimilarityScores(ctx, qc, query, uids)
+			if err != nil {
+				return nil, err
+			}
+			for i, uid := range uids {
+				if math.IsInf(scores[i], -1) {
+					continue
+				}
+				if c, ok := chunks[uid]; ok {
+					// Keep the best score over all the query vectors.
+					c.score = max(c.score, scores[i])
+					chunks[uid] = c
+					continue
+				}
+				owner, err := posting.VectorChunkOwner(qs.cache, q.Attr, uid)
+				if err != nil {
+					return nil, err
+				}
+				if owner == 0 {
+					continue
+				}
+				chunks[uid] = chunkScore{owner: owner, score: scores[i]}
+			}
+		}
+
+		owners := make(map[uint64]struct{})
+		for _, c := range chunks {
+			owners[c.owner] = struct{}{}
+		}
+		if len(owners) >= k || exhausted || n >= k*maxChunkOversampling {
+			break
+		}
+	}
+
+	type nodeScore struct {
+		score float64
+		count int
+	}
+	nodes := make(map[uint64]*nodeScore)
+	for _, c := range chunks {
+		ns, ok := nodes[c.owner]
+		if !ok {
+			nodes[c.owner] = &nodeScore{score: c.score, count: 1}
+			continue
+		}
+		if srcFn.vectorOpts.agg == aggAvg {
+			ns.score += c.score
+		} else {
+			ns.score = max(ns.score, c.score)
+		}
+		ns.count++
+	}
+
+	result := make([]uint64, 0, len(nodes))
+	for uid, ns := range nodes {
+		if srcFn.vectorOpts.agg == aggAvg {
+			ns.score /= float64(ns.count)
+		}
+		result = append(result, uid)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		si, sj := nodes[result[i]].score, nodes[result[j]].score
+		if si != sj {
+			return si > sj
+		}
+		return result[i] < result[j]
+	})
+	if len(result) > k {
+		result = result[:k]
+	}
+	return result, nil
+}
@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// GetVectorIndexStatsOverNetwork collects the build progress and graph statistics of the
+// vector indexes in req from the groups serving the predicates.
+func GetVectorIndexStatsOverNetwork(ctx context.Context, req *pb.VectorIndexStatsRequest) (
+	[]*pb.VectorIndexStats, error) {
+
+	reqs := make(map[uint32]*pb.VectorIndexStatsRequest)
+	for _, attr := range req.Predicates {
+		gid, err := groups().BelongsToReadOnly(attr, 0)
+		if err != nil {
+			return nil, err
+		}
+		if gid == 0 {
+			continue
+		}
+		r := reqs[gid]
+		if r == nil {
+			r = &pb.VectorIndexStatsRequest{GroupId: gid, Namespace: req.Namespace}
+			reqs[gid] = r
+		}
+		r.Predicates = append(r.Predicates, attr)
+	}
+	if len(req.Predicates) == 0 {
+		for _, gid := range groups().KnownGroups() {
+			if gid == 0 {
+				continue
+			}
+			reqs[gid] = &pb.VectorIndexStatsRequest{GroupId: gid, Namespace: req.Namespace}
+		}
+	}
+
+	type statsErr struct {
+		stats []*pb.VectorIndexStats
+		err   error
+	}
+	ch := make(chan statsErr, len(reqs))
+	for gid, r := range reqs {
+		go func(gid uint32, r *pb.VectorIndexStatsRequest) {
+			if groups().ServesGroup(gid) {
+				resp, err := getVectorIndexStats(ctx, r)
+				ch <- statsErr{stats: resp.GetStats(), err: err}
+				return
+			}
+			pl := groups().Leader(gid)
+			if pl == nil {
+				ch <- statsErr{err: conn.ErrNoConnection}
+				return
+			}
+			c := pb.NewWorkerClient(pl.Get())
+			resp, err := c.VectorIndexStats(ctx, r)
+			ch <- statsErr{stats: resp.GetStats(), err: err}
+		}(gid, r)
+	}
+
+	var out []*pb.VectorIndexStats
+	for range reqs {
+		select {
+		case r := <-ch:
+			if r.err != nil {
+				return nil, r.err
+			}
+			out = append(out, r.stats...)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Predicate < out[j].Predicate })
+	return out, nil
+}
+
+// VectorIndexStats reports the vector index statistics of the predicates served by this group.
+func (w *grpcWorker) VectorIndexStats(ctx context.Context, req *pb.VectorIndexStatsRequest) (
+	*pb.VectorIndexStatsResponse, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if !groups().ServesGroup(req.GroupId) {
+		return nil, errors.Errorf("This server doesn't serve group id: %v", req.GroupId)
+	}
+	return getVectorIndexStats(ctx, req)
+}
+
+func getVectorIndexStats(ctx context.Context, req *pb.VectorIndexStatsRequest) (
+	*pb.VectorIndexStatsResponse, error) {
+	preds := req.Predicates
+	if len(preds) == 0 {
+		for _, attr := range schema.State().Predicates() {
+			if x.ParseNamespace(attr) == req.Namespace {
+				preds = append(preds, attr)
+			}
+		}
+	}
+
+	readTs := posting.Oracle().MaxAssigned()
+	resp := &pb.VectorIndexStatsResponse{}
+	for _, attr := range preds {
+		su, ok := schema.State().Get(ctx, attr)
+		if !ok || len(su.IndexSpecs) == 0 {
+			continue
+		}
+		stats := &pb.VectorIndexStats{
+			Predicate: attr,
+			Index:     su.IndexSpecs[0].Name,
+		}
+		if p, ok := posting.VectorIndexBuildStatus(attr); ok {
+			stats.Building = true
+			stats.VectorsProcessed = p.Processed()
+			stats.VectorsTotal = p.Total
+			stats.BuildStartedAt = p.StartedAt.Unix()
+		}
+
+		// The index of a list of vectors is built over its chunks.
+		indexAttr := attr
+		if su.List {
+			indexAttr = hnsw.ConcatStrings(attr, hnsw.VecChunk)
+		}
+		graph, err := posting.GetVectorIndexGraphStats(ctx, indexAttr, readTs)
+		if err != nil {
+			return nil, err
+		}
+		stats.NumVectors = graph.NumVectors
+		stats.OrphanedNodes = graph.Orphaned
+		for level := range graph.Nodes {
+			if graph.Nodes[level] == 0 {
+				continue
+			}
+			stats.Levels = append(stats.Levels, &pb.VectorIndexLevelStats{
+				Level: uint32(level),
+				Nodes: graph.Nodes[level],
+				Edges: graph.Edges[level],
+			})
+		}
+		resp.Stats = append(resp.Stats, stats)
+	}
+	return resp, nil
+}
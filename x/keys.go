@@ -37,6 +37,8 @@ const (
 	DefaultPrefix = byte(0x00)
 	ByteSchema    = byte(0x01)
 	ByteType      = byte(0x02)
+	// ByteSchemaHistory indicates the key stores a change of the schema of a namespace.
+	ByteSchemaHistory = byte(0x03)
 	// ByteSplit signals that the key stores an individual part of a multi-part list.
 	ByteSplit = byte(0x04)
 	// ByteUnused is a constant to specify keys which need to be discarded.
@@ -187,6 +189,27 @@ func TypeKey(attr string) []byte {
 	return key
 }
 
+// SchemaHistoryKey returns the key storing the change of the schema of the namespace
+// which resulted in the given version of the schema. The keys of a namespace are ordered
+// by version. The structure of a schema history key is as follows:
+//
+// byte 0: key type prefix (set to ByteSchemaHistory)
+// byte 1-8: namespace
+// byte 9-10: length of attr (set to 0)
+// next 8 bytes: version
+func SchemaHistoryKey(ns, version uint64) []byte {
+	key, prefixLen := generateKey(ByteSchemaHistory, NamespaceAttr(ns, ""), 8)
+	binary.BigEndian.PutUint64(key[prefixLen:], version)
+	return key
+}
+
+// SchemaHistoryPrefix returns the prefix of the schema history keys of the namespace.
+func SchemaHistoryPrefix(ns uint64) []byte {
+	key, prefixLen := generateKey(ByteSchemaHistory, NamespaceAttr(ns, ""), 0)
+	AssertTrue(len(key) == prefixLen)
+	return key
+}
+
 // DataKey generates a data key with the given attribute and UID.
 // The structure of a data key is as follows:
 //
@@ -353,6 +376,11 @@ func (p ParsedKey) IsType() bool {
 	return p.bytePrefix == ByteType
 }
 
+// IsSchemaHistory returns whether the key is a schema history key.
+func (p ParsedKey) IsSchemaHistory() bool {
+	return p.bytePrefix == ByteSchemaHistory
+}
+
 // IsOfType checks whether the key is of the given type.
 func (p ParsedKey) IsOfType(typ byte) bool {
 	switch typ {
@@ -554,7 +582,7 @@ func Parse(key []byte) (ParsedKey, error) {
 	k = k[sz:]
 
 	switch p.bytePrefix {
-	case ByteSchema, ByteType:
+	case ByteSchema, ByteType, ByteSchemaHistory:
 		return p, nil
 	default:
 	}
@@ -6,6 +6,7 @@
 package x
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -210,6 +211,20 @@ func TestTypeKey(t *testing.T) {
 	}
 }
 
+func TestSchemaHistoryKey(t *testing.T) {
+	key := SchemaHistoryKey(5, 100)
+	pk, err := Parse(key)
+	require.NoError(t, err)
+	require.True(t, pk.IsSchemaHistory())
+	require.Equal(t, uint64(5), ParseNamespace(pk.Attr))
+	require.True(t, bytes.HasPrefix(key, SchemaHistoryPrefix(5)))
+	require.False(t, bytes.HasPrefix(key, SchemaHistoryPrefix(0)))
+
+	// Later versions sort after earlier ones.
+	require.Equal(t, -1, bytes.Compare(SchemaHistoryKey(5, 99), key))
+	require.Equal(t, -1, bytes.Compare(key, SchemaHistoryKey(5, 256)))
+}
+
 func TestBadStartUid(t *testing.T) {
 	testKey := func(key []byte) {
 		key, err := SplitKey(key, 10)
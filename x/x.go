@@ -454,6 +454,58 @@ func AttachNamespace(ctx context.Context, namespace uint64) context.Context {
 	return metadata.NewIncomingContext(ctx, md)
 }
 
+// AttachTTL adds the time-to-live of the values written by a mutation to the metadata of the
+// context.
+func AttachTTL(ctx context.Context, ttl time.Duration) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("ttl", ttl.String())
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// ExtractTTL returns the time-to-live of the values written by a mutation, which is given by
+// the ttl key in the metadata of the context. It is zero if the values don't expire.
+func ExtractTTL(ctx context.Context) (time.Duration, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	vals := md.Get("ttl")
+	if len(vals) == 0 {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(vals[0])
+	if err != nil || ttl < time.Second {
+		return 0, errors.Errorf("Invalid ttl %q, it must be a duration of at least 1s", vals[0])
+	}
+	return ttl, nil
+}
+
+// AttachIncrementalSnapshot marks in the outgoing metadata of the context that the external
+// snapshot streamed with it is incremental, i.e. that its data is added to the existing data
+// instead of replacing it.
+func AttachIncrementalSnapshot(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("incremental-snapshot", "true")
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// IsIncrementalSnapshot returns whether the incoming metadata of the context marks the external
+// snapshot streamed with it as incremental.
+func IsIncrementalSnapshot(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	vals := md.Get("incremental-snapshot")
+	return len(vals) > 0 && vals[0] == "true"
+}
+
 // AttachJWTNamespaceOutgoing attaches the namespace in the JWT claims to the outgoing metadata of
 // the context.
 func AttachJWTNamespaceOutgoing(ctx context.Context) (context.Context, error) {
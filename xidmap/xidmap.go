@@ -49,9 +49,12 @@ type XidMap struct {
 	maxUidSeen uint64
 
 	// Optionally, these can be set to persist the mappings.
+	db     *badger.DB
 	writer *badger.WriteBatch
 	wg     sync.WaitGroup
 
+	// kvLock guards kvBuf and kvChan, which Sync replaces.
+	kvLock sync.Mutex
 	kvBuf  []kv
 	kvChan chan []kv
 }
@@ -103,12 +106,8 @@ func New(opts XidMapOptions) *XidMap {
 
 	if opts.DB != nil {
 		// If DB is provided, let's load up all the xid -> uid mappings in memory.
-		xm.writer = opts.DB.NewWriteBatch()
-
-		for range 16 {
-			xm.wg.Add(1)
-			go xm.dbWriter()
-		}
+		xm.db = opts.DB
+		xm.startWriters()
 
 		err := opts.DB.View(func(txn *badger.Txn) error {
 			var count int
@@ -226,23 +225,52 @@ func (m *XidMap) SetUid(xid string, uid uint64) {
 	sh.Lock()
 	defer sh.Unlock()
 	sh.tree.Set(farm.Fingerprint64([]byte(xid)), uid)
-	if m.writer != nil {
-		var uidBuf [8]byte
-		binary.BigEndian.PutUint64(uidBuf[:], uid)
-		m.kvBuf = append(m.kvBuf, kv{key: []byte(xid), value: uidBuf[:]})
-
-		if len(m.kvBuf) == 64 {
-			m.kvChan <- m.kvBuf
-			m.kvBuf = make([]kv, 0, 64)
-		}
+	if m.db != nil {
+		m.persist(xid, uid)
+	}
+}
+
+// persist queues the mapping to be written to the DB.
+func (m *XidMap) persist(xid string, uid uint64) {
+	var uidBuf [8]byte
+	binary.BigEndian.PutUint64(uidBuf[:], uid)
+
+	m.kvLock.Lock()
+	defer m.kvLock.Unlock()
+	m.kvBuf = append(m.kvBuf, kv{key: []byte(xid), value: uidBuf[:]})
+	if len(m.kvBuf) == 64 {
+		m.kvChan <- m.kvBuf
+		m.kvBuf = make([]kv, 0, 64)
 	}
 }
 
-func (m *XidMap) dbWriter() {
+// startWriters starts a new write batch, and the goroutines writing the queued mappings to it.
+func (m *XidMap) startWriters() {
+	m.writer = m.db.NewWriteBatch()
+	m.kvChan = make(chan []kv, 64)
+	for range 16 {
+		m.wg.Add(1)
+		go m.dbWriter(m.kvChan, m.writer)
+	}
+}
+
+// stopWriters writes the queued mappings, and waits for the writer goroutines to finish. It must
+// be called with kvLock held.
+func (m *XidMap) stopWriters() error {
+	if len(m.kvBuf) > 0 {
+		m.kvChan <- m.kvBuf
+		m.kvBuf = nil
+	}
+	close(m.kvChan)
+	m.wg.Wait()
+	return m.writer.Flush()
+}
+
+func (m *XidMap) dbWriter(kvChan <-chan []kv, writer *badger.WriteBatch) {
 	defer m.wg.Done()
-	for buf := range m.kvChan {
+	for buf := range kvChan {
 		for _, kv := range buf {
-			x.Panic(m.writer.Set(kv.key, kv.value))
+			x.Panic(writer.Set(kv.key, kv.value))
 		}
 	}
 }
@@ -270,15 +298,8 @@ func (m *XidMap) AssignUid(xid string) (uint64, bool) {
 	newUid := sh.assign(m.newRanges)
 	sh.tree.Set(farm.Fingerprint64([]byte(xid)), newUid)
 
-	if m.writer != nil {
-		var uidBuf [8]byte
-		binary.BigEndian.PutUint64(uidBuf[:], newUid)
-		m.kvBuf = append(m.kvBuf, kv{key: []byte(xid), value: uidBuf[:]})
-
-		if len(m.kvBuf) == 64 {
-			m.kvChan <- m.kvBuf
-			m.kvBuf = make([]kv, 0, 64)
-		}
+	if m.db != nil {
+		m.persist(xid, newUid)
 	}
 
 	return newUid, true
@@ -388,11 +409,22 @@ func (m *XidMap) Flush() error {
 		glog.Infof("Finished writing xid map to DB")
 	}()
 
-	if len(m.kvBuf) > 0 {
-		m.kvChan <- m.kvBuf
-	}
-	close(m.kvChan)
-	m.wg.Wait()
+	m.kvLock.Lock()
+	defer m.kvLock.Unlock()
+	return m.stopWriters()
+}
 
-	return m.writer.Flush()
+// Sync writes the mappings assigned so far to the DB, so that they aren't lost if the process
+// dies. Unlike Flush, the XidMap can still be used afterwards.
+func (m *XidMap) Sync() error {
+	if m.db == nil {
+		return nil
+	}
+	m.kvLock.Lock()
+	defer m.kvLock.Unlock()
+	if err := m.stopWriters(); err != nil {
+		return err
+	}
+	m.startWriters()
+	return nil
 }